@@ -31,10 +31,14 @@ Examples:
   build-images Build images
   deploy       Run your application on the cluster in the Deploy mode
   dev          Run your application on the cluster in the Dev mode
+  exec         Execute a command in a container of the component in the Dev mode
   init         Init bootstraps a new project
   logs         Show logs of all containers of the component
   registry     List all components from the Devfile registry
   run          Run a specific command in the Dev mode
+  status       Show the status of the inner loop of the component
+  switch       Switch to a profile defined in the Devfile
+  test         Execute the test command in the Dev mode
 
 `
 
@@ -43,6 +47,8 @@ Examples:
   create       Perform create operation (namespace)
   delete       Delete resources (component, namespace)
   describe     Describe resource (binding, component)
+  devfile      Manage the Devfile of the component (upgrade)
+  export       Export the component to be used without odo (devcontainer, k8s)
   list         List all components in the current namespace (binding, component, namespace, services)
   remove       Remove resources from devfile (binding)
   set          Perform set operation (namespace)
@@ -57,6 +63,7 @@ Examples:
   analyze      Detect devfile to use based on files present in current directory
   completion   Add odo completion support to your development environment
   preference   Modifies preference settings (add, remove, set, unset, view)
+  validate     Validate a Devfile
   version      Print the client version information
 
 `
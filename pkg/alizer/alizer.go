@@ -3,8 +3,12 @@ package alizer
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
 
 	"github.com/redhat-developer/alizer/go/pkg/apis/model"
 	"github.com/redhat-developer/alizer/go/pkg/apis/recognizer"
@@ -138,6 +142,133 @@ func (o *Alizer) DetectPorts(path string) ([]int, error) {
 	return components[0].Ports, nil
 }
 
+func (o *Alizer) DetectLanguages(path string) ([]api.DetectedLanguage, error) {
+	languages, err := recognizer.Analyze(path)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]api.DetectedLanguage, 0, len(languages))
+	for _, language := range languages {
+		result = append(result, api.DetectedLanguage{
+			Name:       language.Name,
+			Aliases:    language.Aliases,
+			Weight:     language.Weight,
+			Frameworks: language.Frameworks,
+			Tools:      language.Tools,
+		})
+	}
+	return result, nil
+}
+
+func (o *Alizer) DetectDevfiles(ctx context.Context, path string, languages []api.DetectedLanguage) ([]api.RecommendedDevfile, error) {
+	types := []model.DevFileType{}
+	components, err := o.registryClient.ListDevfileStacks(ctx, "", "", "", false, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range components.Items {
+		types = append(types, model.DevFileType{
+			Name:        component.Name,
+			Language:    component.Language,
+			ProjectType: component.ProjectType,
+			Tags:        component.Tags,
+		})
+	}
+	indexes, err := recognizer.SelectDevFilesFromTypes(path, types)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.RecommendedDevfile, 0, len(indexes))
+	selected := map[int]bool{}
+	for _, index := range indexes {
+		if selected[index] {
+			continue
+		}
+		selected[index] = true
+		stack := components.Items[index]
+		recommended := api.RecommendedDevfile{
+			Devfile:         stack.Name,
+			DevfileRegistry: stack.Registry.Name,
+			Confidence:      getStackConfidence(languages, stack),
+		}
+		for _, version := range stack.Versions {
+			if version.IsDefault {
+				recommended.DevfileVersion = version.Version
+			}
+		}
+		result = append(result, recommended)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Confidence > result[j].Confidence
+	})
+	return result, nil
+}
+
+// Shares of the weight of the language given to a stack matching the language, a detected framework and a detected tool
+const (
+	languageShare  = 0.6
+	frameworkShare = 0.3
+	toolShare      = 0.1
+)
+
+// getStackConfidence returns the confidence, between 0 and 100, that the stack matches the project.
+// The weight of the language of the stack in the project is shared between the language itself,
+// the frameworks and the tools detected for this language which match the project type or a tag of the stack.
+// When no framework is detected, the framework share is given to all the stacks of the language.
+func getStackConfidence(languages []api.DetectedLanguage, stack api.DevfileStack) float64 {
+	language, found := getLanguage(languages, stack.Language)
+	if !found {
+		return 0
+	}
+	share := languageShare
+	if len(language.Frameworks) == 0 || matchesStack(language.Frameworks, stack) {
+		share += frameworkShare
+	}
+	if matchesStack(language.Tools, stack) {
+		share += toolShare
+	}
+	return math.Round(language.Weight*share*100) / 100
+}
+
+// getLanguage returns the language with the given name or alias
+func getLanguage(languages []api.DetectedLanguage, name string) (api.DetectedLanguage, bool) {
+	for _, language := range languages {
+		if strings.EqualFold(language.Name, name) {
+			return language, true
+		}
+		for _, alias := range language.Aliases {
+			if strings.EqualFold(alias, name) {
+				return language, true
+			}
+		}
+	}
+	return api.DetectedLanguage{}, false
+}
+
+// matchesStack returns true if one of the names matches the project type or one of the tags of the stack,
+// ignoring case and non-alphanumeric characters ("Spring Boot" matches "springboot")
+func matchesStack(names []string, stack api.DevfileStack) bool {
+	candidates := append([]string{stack.ProjectType}, stack.Tags...)
+	for _, name := range names {
+		for _, candidate := range candidates {
+			if n := normalizeName(name); n != "" && n == normalizeName(candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
 func NewDetectionResult(typ model.DevFileType, registry api.Registry, appPorts []int, devfileVersion, name string) *api.DetectionResult {
 	return &api.DetectionResult{
 		Devfile:          typ.Name,
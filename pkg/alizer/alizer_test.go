@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/registry"
@@ -200,3 +201,121 @@ func TestDetectName(t *testing.T) {
 		})
 	}
 }
+
+func TestDetectLanguages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registryClient := registry.NewMockClient(ctrl)
+	alizerClient := NewAlizerClient(registryClient)
+
+	languages, err := alizerClient.DetectLanguages(GetTestProjectPath("nodejs"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(languages) == 0 {
+		t.Fatal("expected at least one language to be detected")
+	}
+	if languages[0].Name != "JavaScript" {
+		t.Errorf("expected JavaScript to be the main language, got %q", languages[0].Name)
+	}
+	for i := 1; i < len(languages); i++ {
+		if languages[i].Weight > languages[i-1].Weight {
+			t.Errorf("expected languages sorted by decreasing weight, got %v", languages)
+		}
+	}
+}
+
+func TestGetStackConfidence(t *testing.T) {
+	java := api.DetectedLanguage{
+		Name:       "Java",
+		Weight:     80,
+		Frameworks: []string{"Quarkus"},
+		Tools:      []string{"Maven"},
+	}
+	javascript := api.DetectedLanguage{
+		Name:       "JavaScript",
+		Weight:     20,
+		Frameworks: []string{"Express"},
+		Tools:      []string{"Node.js"},
+	}
+	for _, tt := range []struct {
+		stack string
+		want  float64
+	}{
+		// framework matching a tag
+		{stack: "java-quarkus", want: 72},
+		// tool matching the project type
+		{stack: "java-maven", want: 56},
+		// language only
+		{stack: "java-wildfly", want: 48},
+		// framework and tool ("Node.js" matches the "NodeJS" tag)
+		{stack: "nodejs", want: 20},
+		// language not detected
+		{stack: "python", want: 0},
+	} {
+		t.Run(tt.stack, func(t *testing.T) {
+			var stack api.DevfileStack
+			for _, s := range types {
+				if s.Name == tt.stack {
+					stack = s
+				}
+			}
+			got := getStackConfidence([]api.DetectedLanguage{java, javascript}, stack)
+			if got != tt.want {
+				t.Errorf("expected confidence %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDetectDevfiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		languages []api.DetectedLanguage
+		want      api.RecommendedDevfile
+	}{
+		{
+			name: "Node.JS example, matching the language by its alias",
+			path: GetTestProjectPath("nodejs"),
+			languages: []api.DetectedLanguage{
+				{Name: "TypeScript", Weight: 10},
+				{Name: "ECMAScript", Aliases: []string{"javascript"}, Weight: 90},
+			},
+			want: api.RecommendedDevfile{
+				Devfile:         "nodejs",
+				DevfileRegistry: "registry2",
+				// no framework detected
+				Confidence: 81,
+			},
+		},
+		{
+			name: "Java example, with unknown language",
+			path: GetTestProjectPath("openjdk"),
+			want: api.RecommendedDevfile{
+				Devfile:         "java-maven",
+				DevfileRegistry: "registry1",
+				Confidence:      0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			registryClient := registry.NewMockClient(ctrl)
+			ctx := context.Background()
+			registryClient.EXPECT().ListDevfileStacks(ctx, "", "", "", false, false).Return(list, nil)
+			alizerClient := NewAlizerClient(registryClient)
+
+			devfiles, err := alizerClient.DetectDevfiles(ctx, tt.path, tt.languages)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(devfiles) == 0 {
+				t.Fatal("expected at least one Devfile to be recommended")
+			}
+			if diff := cmp.Diff(tt.want, devfiles[0]); diff != "" {
+				t.Errorf("DetectDevfiles() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
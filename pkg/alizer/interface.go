@@ -12,4 +12,9 @@ type Client interface {
 	DetectFramework(ctx context.Context, path string) (_ model.DevFileType, defaultVersion string, _ api.Registry, _ error)
 	DetectName(path string) (string, error)
 	DetectPorts(path string) ([]int, error)
+	// DetectLanguages returns the languages detected in the project in path, by decreasing weight
+	DetectLanguages(path string) ([]api.DetectedLanguage, error)
+	// DetectDevfiles returns the Devfiles of the registries matching the project in path, by decreasing relevance.
+	// The confidence of each Devfile is the weight of its language in languages.
+	DetectDevfiles(ctx context.Context, path string, languages []api.DetectedLanguage) ([]api.RecommendedDevfile, error)
 }
@@ -36,6 +36,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DetectDevfiles mocks base method.
+func (m *MockClient) DetectDevfiles(ctx context.Context, path string, languages []api.DetectedLanguage) ([]api.RecommendedDevfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDevfiles", ctx, path, languages)
+	ret0, _ := ret[0].([]api.RecommendedDevfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDevfiles indicates an expected call of DetectDevfiles.
+func (mr *MockClientMockRecorder) DetectDevfiles(ctx, path, languages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDevfiles", reflect.TypeOf((*MockClient)(nil).DetectDevfiles), ctx, path, languages)
+}
+
 // DetectFramework mocks base method.
 func (m *MockClient) DetectFramework(ctx context.Context, path string) (model.DevFileType, string, api.Registry, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +68,21 @@ func (mr *MockClientMockRecorder) DetectFramework(ctx, path interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectFramework", reflect.TypeOf((*MockClient)(nil).DetectFramework), ctx, path)
 }
 
+// DetectLanguages mocks base method.
+func (m *MockClient) DetectLanguages(path string) ([]api.DetectedLanguage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectLanguages", path)
+	ret0, _ := ret[0].([]api.DetectedLanguage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectLanguages indicates an expected call of DetectLanguages.
+func (mr *MockClientMockRecorder) DetectLanguages(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectLanguages", reflect.TypeOf((*MockClient)(nil).DetectLanguages), path)
+}
+
 // DetectName mocks base method.
 func (m *MockClient) DetectName(path string) (string, error) {
 	m.ctrl.T.Helper()
@@ -19,4 +19,28 @@ type DetectionResult struct {
 	DevfileVersion   string `json:"devfileVersion,omitempty"`
 	// Name represents the project/application name as detected by alizer
 	Name string `json:"name,omitempty"`
+	// Languages represents the languages detected in the project, with their frameworks and tools, by decreasing weight
+	Languages []DetectedLanguage `json:"languages,omitempty"`
+	// RecommendedDevfiles represents the Devfiles matching the project, by decreasing relevance
+	RecommendedDevfiles []RecommendedDevfile `json:"recommendedDevfiles,omitempty"`
+}
+
+// DetectedLanguage is a language detected in a project
+type DetectedLanguage struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+	// Weight is the percentage of the source files of the project written in this language
+	Weight     float64  `json:"weight"`
+	Frameworks []string `json:"frameworks,omitempty"`
+	Tools      []string `json:"tools,omitempty"`
+}
+
+// RecommendedDevfile is a Devfile of a registry matching a project
+type RecommendedDevfile struct {
+	Devfile         string `json:"devfile"`
+	DevfileRegistry string `json:"devfileRegistry,omitempty"`
+	DevfileVersion  string `json:"devfileVersion,omitempty"`
+	// Confidence is the weight in the project of the language the Devfile is matching, between 0 and 100,
+	// reduced when the frameworks and tools detected for the language do not match the project type or tags of the Devfile
+	Confidence float64 `json:"confidence"`
 }
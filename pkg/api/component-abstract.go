@@ -8,6 +8,10 @@ type ComponentAbstract struct {
 	// RunningIn are the modes the component is running in, among Dev and Deploy
 	RunningIn RunningModes `json:"runningIn"`
 	Type      string       `json:"projectType"`
+	// Language is the language of the component, as defined in the Devfile metadata, if known
+	Language string `json:"language,omitempty"`
+	// ManagerType is the kind of tool managing the component, one of odo-v3, odo-v2, devfile, other or none
+	ManagerType string `json:"managerType,omitempty"`
 	// RunningOn is the platform the component is running on, either cluster or podman
 	//
 	// Deprecated: This field is deprecated and will be replaced by Platform
@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 // Component describes the state of a devfile component
 type Component struct {
 	DevfilePath       string          `json:"devfilePath,omitempty"`
@@ -15,6 +17,20 @@ type Component struct {
 	Ingresses []ConnectionData        `json:"ingresses,omitempty"`
 	Routes    []ConnectionData        `json:"routes,omitempty"`
 	ManagedBy string                  `json:"managedBy"`
+	// DevSession describes the `odo dev` session currently running for the component on the cluster, if any.
+	DevSession *DevSession `json:"devSession,omitempty"`
+}
+
+// DevSession describes an `odo dev` session, as published on the cluster
+type DevSession struct {
+	// Owner is the name of the user running the session
+	Owner string `json:"owner"`
+	// Machine is the hostname of the machine running the session
+	Machine string `json:"machine"`
+	// StartedAt is the time at which the session started
+	StartedAt time.Time `json:"startedAt"`
+	// ForwardedPorts are the ports forwarded by the session on the machine running it
+	ForwardedPorts []ForwardedPort `json:"forwardedPorts,omitempty"`
 }
 
 type ForwardedPort struct {
@@ -37,4 +53,13 @@ type ConnectionData struct {
 type Rules struct {
 	Host  string   `json:"host"`
 	Paths []string `json:"paths"`
+	// TLS is set when the host is served over TLS
+	TLS *TLS `json:"tls,omitempty"`
+}
+
+type TLS struct {
+	// SecretName is the name of the secret containing the certificate of an Ingress host; empty for the default certificate
+	SecretName string `json:"secretName,omitempty"`
+	// Termination is the TLS termination of a Route (edge, passthrough or reencrypt)
+	Termination string `json:"termination,omitempty"`
 }
@@ -0,0 +1,61 @@
+package api
+
+// DevfileUpgrade describes the upgrade of a project Devfile to a more recent version of its stack, returned by `odo devfile upgrade`
+type DevfileUpgrade struct {
+	// Devfile is the name of the stack in the registry
+	Devfile string `json:"devfile"`
+	// DevfileRegistry is the name of the registry containing the stack
+	DevfileRegistry string `json:"devfileRegistry"`
+	// CurrentVersion is the version of the stack the project Devfile is based on
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	// TargetVersion is the version of the stack the project Devfile is upgraded to
+	TargetVersion string `json:"targetVersion"`
+	// Changes are the changes between the current and target versions of the stack
+	Changes []DevfileChange `json:"changes,omitempty"`
+	// Applied is true if the changes have been written to the project Devfile
+	Applied bool `json:"applied"`
+}
+
+// DevfileElementKind is the kind of a Devfile element affected by an upgrade
+type DevfileElementKind string
+
+const (
+	DevfileElementComponent DevfileElementKind = "component"
+	DevfileElementImage     DevfileElementKind = "image"
+	DevfileElementCommand   DevfileElementKind = "command"
+	DevfileElementParent    DevfileElementKind = "parent"
+)
+
+// DevfileChangeType is the type of change of a Devfile element
+type DevfileChangeType string
+
+const (
+	DevfileChangeAdded    DevfileChangeType = "added"
+	DevfileChangeRemoved  DevfileChangeType = "removed"
+	DevfileChangeModified DevfileChangeType = "modified"
+)
+
+// DevfileChange is the change of an element of a Devfile between two versions of a stack
+type DevfileChange struct {
+	Kind   DevfileElementKind `json:"kind"`
+	Name   string             `json:"name"`
+	Change DevfileChangeType  `json:"change"`
+	// Preserved is true if the element has been customized in the project Devfile,
+	// in which case the change is not applied and the customization is kept
+	Preserved bool `json:"preserved,omitempty"`
+	// Inherited is true if an element added to the stack is already inherited from the parent of the project Devfile,
+	// in which case it is not added to the project Devfile
+	Inherited bool `json:"inherited,omitempty"`
+	// Fields are the changes of the fields of a modified element
+	Fields []DevfileFieldChange `json:"fields,omitempty"`
+}
+
+// DevfileFieldChange is the change of a field of a Devfile element between two versions of a stack
+type DevfileFieldChange struct {
+	// Path is the path of the field in the element, e.g. "container.image"
+	Path string `json:"path"`
+	// Old is the value of the field in the current version, not set if the field has been added
+	Old interface{} `json:"old,omitempty"`
+	// New is the value of the field in the target version, not set if the field has been removed
+	New interface{} `json:"new,omitempty"`
+}
@@ -0,0 +1,50 @@
+package api
+
+// DevfileValidation is the result of the validation of a Devfile, returned by `odo validate`
+type DevfileValidation struct {
+	// DevfilePath is the path of the validated Devfile
+	DevfilePath string `json:"devfilePath"`
+	// Valid is true if no problem of severity error has been found
+	Valid bool `json:"valid"`
+	// Problems are the errors and warnings found in the Devfile
+	Problems []DevfileProblem `json:"problems,omitempty"`
+}
+
+// DevfileProblemSeverity is the severity of a problem found in a Devfile
+type DevfileProblemSeverity string
+
+const (
+	// DevfileProblemError is the severity of a problem making the Devfile unusable by odo
+	DevfileProblemError DevfileProblemSeverity = "error"
+	// DevfileProblemWarning is the severity of a problem which does not prevent odo from using the Devfile
+	DevfileProblemWarning DevfileProblemSeverity = "warning"
+)
+
+// DevfileProblem is an error or warning found in a Devfile
+type DevfileProblem struct {
+	Severity DevfileProblemSeverity `json:"severity"`
+	// Line is the line of the Devfile the problem relates to, 0 if it cannot be determined
+	// (e.g. for elements inherited from a parent Devfile)
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// HasErrors returns true if at least one of the problems has the error severity
+func (o DevfileValidation) HasErrors() bool {
+	for _, p := range o.Problems {
+		if p.Severity == DevfileProblemError {
+			return true
+		}
+	}
+	return false
+}
+
+// HasWarnings returns true if at least one of the problems has the warning severity
+func (o DevfileValidation) HasWarnings() bool {
+	for _, p := range o.Problems {
+		if p.Severity == DevfileProblemWarning {
+			return true
+		}
+	}
+	return false
+}
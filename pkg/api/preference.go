@@ -16,3 +16,16 @@ type PreferenceView struct {
 	Preferences []PreferenceItem `json:"preferences,omitempty"`
 	Registries  []Registry       `json:"registries,omitempty"`
 }
+
+// TelemetryView describes the data sent to telemetry
+type TelemetryView struct {
+	Enabled  bool             `json:"enabled"`
+	Endpoint string           `json:"endpoint,omitempty"` // The endpoint to which data is sent, empty for Segment
+	Fields   []TelemetryField `json:"fields"`
+}
+
+type TelemetryField struct {
+	Name        string `json:"name"`
+	Event       string `json:"event"`       // The event sending the field, possible values identify, track
+	Description string `json:"description"` // The description of the field and of its anonymization
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+// DevStatus is the history of an odo dev session, as recorded in the state file
+type DevStatus struct {
+	// LastPush is the time of the last push of the sources to the component
+	LastPush *time.Time `json:"lastPush,omitempty"`
+	// LastPushError is the error returned by the last push, empty if the push succeeded
+	LastPushError string `json:"lastPushError,omitempty"`
+	// LastBuild is the result of the last execution of the build command
+	LastBuild *CommandResult `json:"lastBuild,omitempty"`
+	// LastTests is the result of the last execution of the test command, when the session runs the tests
+	LastTests *CommandResult `json:"lastTests,omitempty"`
+	// TestRuns is the number of executions of the test command since the start of the session
+	TestRuns int `json:"testRuns,omitempty"`
+	// FailedTestRuns is the number of executions of the test command which failed since the start of the session
+	FailedTestRuns int `json:"failedTestRuns,omitempty"`
+	// Pushes is the number of pushes done since the start of the session
+	Pushes int `json:"pushes"`
+	// FailedPushes is the number of pushes which failed since the start of the session
+	FailedPushes int `json:"failedPushes"`
+	// SyncedFiles is the number of modified files synced since the start of the session
+	SyncedFiles int `json:"syncedFiles"`
+	// DeletedFiles is the number of files deleted from the component since the start of the session
+	DeletedFiles int `json:"deletedFiles"`
+	// Watch is the health of the files watcher
+	Watch WatchHealth `json:"watch"`
+	// ResourceUsage is the last resource usage of the containers of the component, recorded when the ResourceUsageInterval preference is set
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+}
+
+// ResourceUsage is the resource usage of the containers of a component, as reported by the metrics API
+type ResourceUsage struct {
+	Time       time.Time                `json:"time"`
+	Containers []ContainerResourceUsage `json:"containers"`
+}
+
+// ContainerResourceUsage is the CPU and memory usage of a container, compared to its limits
+type ContainerResourceUsage struct {
+	Name string `json:"name"`
+	// CPU is the CPU usage, in millicores (Example: 250m)
+	CPU string `json:"cpu"`
+	// CPULimit is the CPU limit of the container, empty if the container has no CPU limit
+	CPULimit string `json:"cpuLimit,omitempty"`
+	// CPULimitPercent is the CPU usage as a percentage of the CPU limit, if any
+	CPULimitPercent *int64 `json:"cpuLimitPercent,omitempty"`
+	// Memory is the memory usage, in mebibytes (Example: 128Mi)
+	Memory string `json:"memory"`
+	// MemoryLimit is the memory limit of the container, empty if the container has no memory limit
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+	// MemoryLimitPercent is the memory usage as a percentage of the memory limit, if any
+	MemoryLimitPercent *int64 `json:"memoryLimitPercent,omitempty"`
+}
+
+func (o ContainerResourceUsage) String() string {
+	return fmt.Sprintf("%s: CPU %s, memory %s", o.Name, formatUsage(o.CPU, o.CPULimit, o.CPULimitPercent), formatUsage(o.Memory, o.MemoryLimit, o.MemoryLimitPercent))
+}
+
+// formatUsage returns the usage, compared to the limit if any
+func formatUsage(value string, limit string, percent *int64) string {
+	if limit == "" || percent == nil {
+		return value
+	}
+	return fmt.Sprintf("%s/%s (%d%%)", value, limit, *percent)
+}
+
+// CommandResult is the result of the execution of a Devfile command
+type CommandResult struct {
+	Time    time.Time `json:"time"`
+	Success bool      `json:"success"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// WatchHealth describes the health of the files watcher of an odo dev session
+type WatchHealth struct {
+	// Watching indicates if the session is watching for files changes
+	Watching bool `json:"watching"`
+	// Error is the error returned by the watcher, if any
+	Error string `json:"error,omitempty"`
+}
+
+// DevSessionStatus is the status of an odo dev session running on a platform
+type DevSessionStatus struct {
+	Platform       string          `json:"platform"`
+	PID            int             `json:"pid"`
+	ForwardedPorts []ForwardedPort `json:"forwardedPorts,omitempty"`
+	DevStatus
+}
+
+// Status is the status of the inner loop of a component, as returned by `odo status`
+type Status struct {
+	ComponentName string             `json:"componentName"`
+	RunningIn     RunningModes       `json:"runningIn"`
+	DevSessions   []DevSessionStatus `json:"devSessions"`
+	// LastSession is the status of the last Dev session when it ended, set when no Dev session is running
+	LastSession *DevSessionStatus `json:"lastSession,omitempty"`
+}
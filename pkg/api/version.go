@@ -0,0 +1,37 @@
+package api
+
+// VersionInfo describes the version of odo and, optionally, the connectivity to the platforms and registries
+type VersionInfo struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	// Cluster describes the cluster of the current Kubernetes context, if reachable or if connectivity was checked
+	Cluster *ClusterConnectivity `json:"cluster,omitempty"`
+	// Podman describes the Podman client and socket, only if connectivity was checked
+	Podman *PodmanConnectivity `json:"podman,omitempty"`
+	// Registries describes the Devfile registries in the preferences, only if connectivity was checked
+	Registries []RegistryConnectivity `json:"registries,omitempty"`
+}
+
+type ClusterConnectivity struct {
+	Context           string `json:"context,omitempty"`
+	ServerURL         string `json:"serverURL,omitempty"`
+	Reachable         bool   `json:"reachable"`
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	OpenShiftVersion  string `json:"openshiftVersion,omitempty"`
+	Error             string `json:"error,omitempty"`
+}
+
+type PodmanConnectivity struct {
+	Reachable     bool   `json:"reachable"`
+	ClientVersion string `json:"clientVersion,omitempty"`
+	// ServerVersion is the version of the Podman service the client connects to, when Podman is used remotely (e.g. with Podman machine)
+	ServerVersion string `json:"serverVersion,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+type RegistryConnectivity struct {
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	Reachable bool   `json:"reachable"`
+	Error     string `json:"error,omitempty"`
+}
@@ -1,5 +1,6 @@
 package auth
 
 type Client interface {
-	Login(server, username, password, token, caAuth string, skipTLS bool) error
+	// Login logs in to the server, and writes the credentials to the kubeconfig file, or to the default kubeconfig file if empty
+	Login(server, username, password, token, caAuth string, skipTLS bool, kubeconfig string) error
 }
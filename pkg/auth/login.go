@@ -23,7 +23,7 @@ func NewKubernetesClient() *KubernetesClient {
 }
 
 // Login takes care of authentication part and returns error, if any
-func (o KubernetesClient) Login(server, username, password, token, caAuth string, skipTLS bool) error {
+func (o KubernetesClient) Login(server, username, password, token, caAuth string, skipTLS bool, kubeconfig string) error {
 	// Here we are grabbing the stdout output and then
 	// throwing it through "copyAndFilter" in order to get
 	// a correctly filtered result from `odo login`
@@ -42,7 +42,7 @@ func (o KubernetesClient) Login(server, username, password, token, caAuth string
 		Password:       password,
 		Project:        "",
 		Token:          token,
-		PathOptions:    &clientcmd.PathOptions{GlobalFile: clientcmd.RecommendedHomeFile, EnvVar: clientcmd.RecommendedConfigPathEnvVar, ExplicitFileFlag: "config", LoadingRules: &clientcmd.ClientConfigLoadingRules{ExplicitPath: ""}},
+		PathOptions:    &clientcmd.PathOptions{GlobalFile: clientcmd.RecommendedHomeFile, EnvVar: clientcmd.RecommendedConfigPathEnvVar, ExplicitFileFlag: "config", LoadingRules: &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}},
 		RequestTimeout: 0,
 		IOStreams:      genericclioptions.IOStreams{Out: filteredWriter, In: os.Stdin, ErrOut: odolog.GetStderr()},
 	}
@@ -50,6 +50,7 @@ func (o KubernetesClient) Login(server, username, password, token, caAuth string
 	// initialize client-go client and read starting kubeconfig file
 
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
 	configOverrides := &clientcmd.ConfigOverrides{}
 	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
 
@@ -83,13 +84,13 @@ func (o KubernetesClient) Login(server, username, password, token, caAuth string
 	// as unauthorized errors are handled MANUALLY by oc.
 	if err := a.GatherInfo(); err != nil {
 		if kapierrors.IsUnauthorized(err) {
-			fmt.Println("Login failed (401 Unauthorized)")
-			fmt.Println("Verify you have provided correct credentials.")
+			fmt.Fprintln(odolog.GetStdout(), "Login failed (401 Unauthorized)")
+			fmt.Fprintln(odolog.GetStdout(), "Verify you have provided correct credentials.")
 
 			if err, isStatusErr := err.(*kapierrors.StatusError); isStatusErr {
 				if details := err.Status().Details; details != nil {
 					for _, cause := range details.Causes {
-						fmt.Println(cause.Message)
+						fmt.Fprintln(odolog.GetStdout(), cause.Message)
 					}
 				}
 			}
@@ -34,15 +34,15 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // Login mocks base method.
-func (m *MockClient) Login(server, username, password, token, caAuth string, skipTLS bool) error {
+func (m *MockClient) Login(server, username, password, token, caAuth string, skipTLS bool, kubeconfig string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", server, username, password, token, caAuth, skipTLS)
+	ret := m.ctrl.Call(m, "Login", server, username, password, token, caAuth, skipTLS, kubeconfig)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockClientMockRecorder) Login(server, username, password, token, caAuth, skipTLS interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) Login(server, username, password, token, caAuth, skipTLS, kubeconfig interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockClient)(nil).Login), server, username, password, token, caAuth, skipTLS)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockClient)(nil).Login), server, username, password, token, caAuth, skipTLS, kubeconfig)
 }
@@ -0,0 +1,142 @@
+package asker
+
+import (
+	"sort"
+
+	"github.com/redhat-developer/odo/pkg/prompt"
+)
+
+const (
+	bindAsFiles  = "Bind As Files"
+	bindAsEnvVar = "Bind As Environment Variables"
+)
+
+const (
+	namingStrategyDefault   = "DEFAULT"
+	namingStrategyNone      = "none"
+	namingStrategylowercase = "lowercase"
+	namingStrategyUpperCase = "uppercase"
+	NamingStrategyCustom    = "CUSTOM"
+)
+
+// PromptAsker asks the questions of the interactive mode using a prompt client
+type PromptAsker struct {
+	promptClient prompt.Client
+}
+
+var _ Asker = (*PromptAsker)(nil)
+
+func NewPromptAsker(promptClient prompt.Client) *PromptAsker {
+	return &PromptAsker{
+		promptClient: promptClient,
+	}
+}
+
+func (o *PromptAsker) SelectNamespaceListOption() (ServiceInstancesNamespaceListOption, error) {
+	options := []string{"current namespace", "all accessible namespaces"}
+	answer, err := o.promptClient.Select(prompt.Question{Name: "namespaceListOption", Message: "Do you want to list services from:"}, options, "")
+	if err != nil {
+		return 0, err
+	}
+	// respect order of ServiceInstancesNamespaceListOption constants
+	return ServiceInstancesNamespaceListOption(answer + 1), nil
+}
+
+func (o *PromptAsker) AskNamespace() (string, error) {
+	return o.promptClient.Input(prompt.Question{
+		Name:    "namespace",
+		Message: "Enter the namespace containing the service instances or press Enter to use the current namespace:",
+	}, "")
+}
+
+func (o *PromptAsker) SelectNamespace(options []string) (string, error) {
+	answer, err := o.promptClient.Select(prompt.Question{Name: "namespace", Message: "Select the namespace containing the service instances:"}, options, "")
+	if err != nil {
+		return "", err
+	}
+	return options[answer], nil
+}
+
+func (o *PromptAsker) SelectWorkloadResource(options []string) (int, error) {
+	return o.promptClient.Select(prompt.Question{Name: "workloadResource", Message: "Select workload resource you want to bind:"}, options, "")
+}
+
+func (o *PromptAsker) SelectWorkloadResourceName(names []string) (bool, string, error) {
+	sort.Strings(names)
+	notFoundOption := "DOES NOT EXIST YET"
+	goBackOption := "** GO BACK **"
+	names = append(names, notFoundOption, goBackOption)
+	answer, err := o.promptClient.Select(prompt.Question{Name: "workloadResourceName", Message: "Select workload resource name you want to bind:"}, names, "")
+	if err != nil {
+		return false, "", err
+	}
+	switch names[answer] {
+	case notFoundOption:
+		return false, "", nil
+	case goBackOption:
+		return true, "", nil
+	}
+	return false, names[answer], nil
+}
+
+func (o *PromptAsker) SelectNamingStrategy() (string, error) {
+	options := []string{namingStrategyDefault, namingStrategyNone, namingStrategylowercase, namingStrategyUpperCase, NamingStrategyCustom}
+	answer, err := o.promptClient.Select(prompt.Question{Name: "namingStrategy", Message: "Select naming strategy for binding names:"}, options, "")
+	if err != nil {
+		return "", err
+	}
+	if options[answer] == namingStrategyDefault {
+		return "", nil
+	}
+	return options[answer], nil
+}
+
+func (o *PromptAsker) AskWorkloadResourceName() (string, error) {
+	return o.promptClient.Input(prompt.Question{Name: "workloadName", Message: "Enter the Workload's name:"}, "")
+}
+
+func (o *PromptAsker) AskServiceInstance(serviceInstances []string) (string, error) {
+	sort.Strings(serviceInstances)
+	answer, err := o.promptClient.Select(prompt.Question{Name: "serviceInstance", Message: "Select service instance you want to bind to:"}, serviceInstances, "")
+	if err != nil {
+		return "", err
+	}
+	return serviceInstances[answer], nil
+}
+
+func (o *PromptAsker) AskServiceBindingName(defaultName string) (string, error) {
+	return o.promptClient.Input(prompt.Question{Name: "name", Message: "Enter the Binding's name:"}, defaultName)
+}
+
+func (o *PromptAsker) AskBindAsFiles() (bool, error) {
+	options := []string{bindAsFiles, bindAsEnvVar}
+	answer, err := o.promptClient.Select(prompt.Question{Name: "bindAs", Message: "How do you want to bind the service?"}, options, "")
+	if err != nil {
+		return true, err
+	}
+	return options[answer] == bindAsFiles, nil
+}
+
+func (o *PromptAsker) AskNamingStrategy() (string, error) {
+	return o.promptClient.Input(prompt.Question{Name: "customNamingStrategy", Message: "Enter the naming strategy:"}, "")
+}
+
+func (o *PromptAsker) SelectCreationOptions() ([]CreationOption, error) {
+	options, err := o.promptClient.MultiSelect(prompt.Question{
+		Name:    "creationOptions",
+		Message: "Check(with Space Bar) one or more operations to perform with the ServiceBinding:",
+		Help:    "Use the Space Bar to select one or more operations to perform with the ServiceBinding",
+	}, []string{"create it on cluster", "display it", "save it to file"}) // respect order of CreationOption constants
+	if err != nil {
+		return nil, err
+	}
+	result := make([]CreationOption, 0, len(options))
+	for _, option := range options {
+		result = append(result, CreationOption(option))
+	}
+	return result, nil
+}
+
+func (o *PromptAsker) AskOutputFilePath(defaultValue string) (string, error) {
+	return o.promptClient.Input(prompt.Question{Name: "outputFile", Message: "Save the ServiceBinding to file:"}, defaultValue)
+}
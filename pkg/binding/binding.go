@@ -11,6 +11,7 @@ import (
 	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 
 	"github.com/redhat-developer/odo/pkg/project"
+	"github.com/redhat-developer/odo/pkg/prompt"
 
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
@@ -43,9 +44,9 @@ type BindingClient struct {
 
 var _ Client = (*BindingClient)(nil)
 
-func NewBindingClient(projectClient project.Client, kubernetesClient kclient.ClientInterface) *BindingClient {
+func NewBindingClient(projectClient project.Client, kubernetesClient kclient.ClientInterface, promptClient prompt.Client) *BindingClient {
 	// We create the asker client and the backends here and not at the CLI level, as we want to hide these details to the CLI
-	askerClient := asker.NewSurveyAsker()
+	askerClient := asker.NewPromptAsker(promptClient)
 	return &BindingClient{
 		flagsBackend:       backendpkg.NewFlagsBackend(),
 		interactiveBackend: backendpkg.NewInteractiveBackend(askerClient, projectClient, kubernetesClient),
@@ -1,6 +1,7 @@
 package component
 
 import (
+	"context"
 	"fmt"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -16,6 +17,7 @@ import (
 )
 
 // ApplyKubernetes contains the logic to create the k8s resources defined by the `apply` command
+// ctx: the context, containing the extra labels and annotations defined in the preferences
 // mode(Dev, Deploy): the mode in which the resources are deployed
 // appName: application name
 // devfile: the devfile object
@@ -23,6 +25,7 @@ import (
 // kubeClient: Kubernetes client to be used to deploy the resource
 // path: path to the context directory
 func ApplyKubernetes(
+	ctx context.Context,
 	mode string,
 	appName string,
 	componentName string,
@@ -49,6 +52,14 @@ func ApplyKubernetes(
 	// Retrieve the component type from the devfile and also inject it into the list of annotations
 	annotations := make(map[string]string)
 	odolabels.SetProjectType(annotations, GetComponentTypeFromDevfileMetadata(devfile.Data.GetMetadata()))
+	odolabels.SetLanguage(annotations, devfile.Data.GetMetadata().Language)
+
+	extraLabels, extraAnnotations, err := GetExtraMetadata(ctx, devfile)
+	if err != nil {
+		return err
+	}
+	labels = odolabels.AddExtra(labels, extraLabels)
+	annotations = odolabels.AddExtra(annotations, extraAnnotations)
 
 	// Get the Kubernetes component
 	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
@@ -25,6 +25,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/util"
 
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -167,6 +168,8 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 			ManagedBy:        managedBy,
 			Type:             componentType,
 			ManagedByVersion: managedByVersion,
+			Language:         odolabels.GetLanguage(labels, annotations),
+			ManagerType:      odolabels.GetManagerType(labels, annotations),
 			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
 			RunningOn: commonflags.PlatformCluster,
 			Platform:  commonflags.PlatformCluster,
@@ -188,6 +191,13 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 				if otherCompo.ManagedBy == api.TypeUnknown && component.ManagedBy != api.TypeUnknown {
 					components[v].ManagedBy = component.ManagedBy
 				}
+				if otherCompo.Language == "" {
+					components[v].Language = component.Language
+				}
+				// a resource of the component may have been created without the labels of its manager
+				if otherCompo.ManagerType == odolabels.ManagerTypeNone {
+					components[v].ManagerType = component.ManagerType
+				}
 			}
 		}
 		if !componentFound {
@@ -233,6 +243,7 @@ func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Clien
 	}
 	if devObj != nil {
 		localComponent.Type = GetComponentTypeFromDevfileMetadata(devObj.Data.GetMetadata())
+		localComponent.Language = devObj.Data.GetMetadata().Language
 	}
 
 	componentInDevfile := ""
@@ -467,9 +478,19 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 
 	selector := odolabels.GetNameSelector(componentName)
 
-	k8sIngresses, err := client.ListIngresses(client.GetCurrentNamespace(), selector)
+	capabilities, err := client.GetClusterCapabilities()
 	if err != nil {
-		return nil, nil, err
+		// Ingresses are part of the Kubernetes API, only the Routes are not listed when the APIs cannot be detected
+		klog.V(2).Infof("unable to detect the Route and Ingress APIs, Routes are not listed: %v", err)
+		capabilities = kclient.ClusterCapabilities{Ingresses: true}
+	}
+
+	k8sIngresses := &networkingv1.IngressList{}
+	if capabilities.Ingresses {
+		k8sIngresses, err = client.ListIngresses(client.GetCurrentNamespace(), selector)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	for _, ing := range k8sIngresses.Items {
 		if ownerReferences := ing.GetOwnerReferences(); ownerReferences != nil {
@@ -488,7 +509,7 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 					if host == "" {
 						host = "*"
 					}
-					rules = append(rules, api.Rules{Host: host, Paths: paths})
+					rules = append(rules, api.Rules{Host: host, Paths: paths, TLS: getIngressHostTLS(ing.Spec.TLS, rule.Host)})
 				}
 				if len(ing.Spec.Rules) == 0 {
 					rules = append(rules, api.Rules{Host: "*", Paths: []string{"/*"}})
@@ -497,11 +518,8 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 			}(),
 		})
 	}
-	// Return early if it is not an OpenShift cluster
-	if isOC, e := client.IsProjectSupported(); !isOC {
-		if e != nil {
-			klog.V(4).Infof("unable to detect project support: %s", e.Error())
-		}
+	// Return early if Routes are not supported by the cluster
+	if !capabilities.Routes {
 		return ings, nil, nil
 	}
 
@@ -524,10 +542,14 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 		if err != nil {
 			return nil, nil, err
 		}
+		var tls *api.TLS
+		if route.Spec.TLS != nil {
+			tls = &api.TLS{Termination: string(route.Spec.TLS.Termination)}
+		}
 		routes = append(routes, api.ConnectionData{
 			Name: route.GetName(),
 			Rules: []api.Rules{
-				{Host: route.Spec.Host, Paths: []string{route.Spec.Path}},
+				{Host: route.Spec.Host, Paths: []string{route.Spec.Path}, TLS: tls},
 			},
 		})
 	}
@@ -535,6 +557,22 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 	return ings, routes, nil
 }
 
+// getIngressHostTLS returns the TLS information of an Ingress host, or nil if the host is not served over TLS.
+// A TLS entry without any host applies to all the hosts of the Ingress.
+func getIngressHostTLS(ingressTLS []networkingv1.IngressTLS, host string) *api.TLS {
+	for _, tls := range ingressTLS {
+		if len(tls.Hosts) == 0 {
+			return &api.TLS{SecretName: tls.SecretName}
+		}
+		for _, h := range tls.Hosts {
+			if h == host {
+				return &api.TLS{SecretName: tls.SecretName}
+			}
+		}
+	}
+	return nil
+}
+
 func GetContainersNames(pod *corev1.Pod) []string {
 	result := make([]string, 0, len(pod.Spec.Containers))
 	for _, container := range pod.Spec.Containers {
@@ -42,6 +42,10 @@ func TestListAllClusterComponents(t *testing.T) {
 	res2 := getUnstructured("svc1", "service", "v1", "odo", odoVersion, "nodejs", "my-ns")
 	res3 := getUnstructured("dep1", "deployment", "v1", "Unknown", "", "Unknown", "my-ns")
 	res3.SetLabels(map[string]string{})
+	resV2 := getUnstructured("compv2", "deployment", "v1", "odo", "v2.5.1", "springboot", "my-ns")
+	resV2.SetAnnotations(labels.Builder().WithProjectType("springboot").WithLanguage("java").Labels())
+	resPlain := getUnstructured("plain", "deployment", "v1", "", "", "", "my-ns")
+	resPlain.SetAnnotations(nil)
 
 	commonLabels := labels.Builder().WithComponentName("comp1").WithManager("odo").WithManagedByVersion(odoVersion)
 
@@ -87,6 +91,7 @@ func TestListAllClusterComponents(t *testing.T) {
 				ManagedByVersion: "",
 				RunningIn:        nil,
 				Type:             "Unknown",
+				ManagerType:      "devfile",
 				RunningOn:        "cluster",
 				Platform:         "cluster",
 			}},
@@ -129,6 +134,7 @@ func TestListAllClusterComponents(t *testing.T) {
 				ManagedByVersion: "",
 				RunningIn:        nil,
 				Type:             "Unknown",
+				ManagerType:      "devfile",
 				RunningOn:        "cluster",
 				Platform:         "cluster",
 			}, {
@@ -137,6 +143,7 @@ func TestListAllClusterComponents(t *testing.T) {
 				ManagedByVersion: "v3.0.0-beta3",
 				RunningIn:        nil,
 				Type:             "nodejs",
+				ManagerType:      "odo-v3",
 				RunningOn:        "cluster",
 				Platform:         "cluster",
 			}},
@@ -164,9 +171,42 @@ func TestListAllClusterComponents(t *testing.T) {
 					"dev":    true,
 					"deploy": true,
 				},
-				Type:      "nodejs",
-				RunningOn: "cluster",
-				Platform:  "cluster",
+				Type:        "nodejs",
+				ManagerType: "odo-v3",
+				RunningOn:   "cluster",
+				Platform:    "cluster",
+			}},
+			wantErr: false,
+		},
+		{
+			name: "odo v2 component with language and plain Deployment",
+			fields: fields{
+				kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+					var resources []unstructured.Unstructured
+					resources = append(resources, resV2, resPlain)
+					client := kclient.NewMockClientInterface(ctrl)
+					client.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "my-ns").Return(resources, nil)
+					return client
+				},
+			},
+			args: args{
+				namespace: "my-ns",
+			},
+			want: []api.ComponentAbstract{{
+				Name:             "compv2",
+				ManagedBy:        "odo",
+				ManagedByVersion: "v2.5.1",
+				Type:             "springboot",
+				Language:         "java",
+				ManagerType:      "odo-v2",
+				RunningOn:        "cluster",
+				Platform:         "cluster",
+			}, {
+				Name:        "plain",
+				Type:        "Unknown",
+				ManagerType: "none",
+				RunningOn:   "cluster",
+				Platform:    "cluster",
 			}},
 			wantErr: false,
 		},
@@ -654,9 +694,9 @@ func TestListRoutesAndIngresses(t *testing.T) {
 
 	mockKubeClient := func(ctrl *gomock.Controller, isOCP bool, ingresses []v1.Ingress, routeUnstructured map[string]interface{}) kclient.ClientInterface {
 		client := kclient.NewMockClientInterface(ctrl)
+		client.EXPECT().GetClusterCapabilities().Return(kclient.ClusterCapabilities{Routes: isOCP, Ingresses: true}, nil)
 		client.EXPECT().GetCurrentNamespace().Return(namespace)
 		client.EXPECT().ListIngresses(namespace, selector).Return(&v1.IngressList{Items: ingresses}, nil)
-		client.EXPECT().IsProjectSupported().Return(isOCP, nil)
 		if isOCP {
 			client.EXPECT().GetGVRFromGVK(kclient.RouteGVK).Return(routeGVR, nil)
 			client.EXPECT().GetCurrentNamespace().Return(namespace)
@@ -688,6 +728,22 @@ func TestListRoutesAndIngresses(t *testing.T) {
 			wantRoutes: []api.ConnectionData{routeConnectionData},
 			wantErr:    false,
 		},
+		{
+			name: "list only ingresses when the APIs cannot be detected",
+			args: args{
+				client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+					client := kclient.NewMockClientInterface(ctrl)
+					client.EXPECT().GetClusterCapabilities().Return(kclient.ClusterCapabilities{}, errors.New("discovery error"))
+					client.EXPECT().GetCurrentNamespace().Return(namespace)
+					client.EXPECT().ListIngresses(namespace, selector).Return(&v1.IngressList{Items: []v1.Ingress{*ing}}, nil)
+					return client
+				},
+				componentName: componentName,
+			},
+			wantIngs:   []api.ConnectionData{ingConnectionData},
+			wantRoutes: nil,
+			wantErr:    false,
+		},
 		{
 			name: "list only ingresses when the cluster is not ocp",
 			args: args{
@@ -712,6 +768,45 @@ func TestListRoutesAndIngresses(t *testing.T) {
 			wantRoutes: nil,
 			wantErr:    false,
 		},
+		{
+			name: "list ingresses and routes with TLS",
+			args: args{
+				client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+					tlsIng := ing.DeepCopy()
+					tlsIng.Spec.TLS = []v1.IngressTLS{{Hosts: []string{"nodejs.example.com"}, SecretName: "my-cert"}}
+					tlsRoute := route.DeepCopy()
+					tlsRoute.Spec.TLS = &v12.TLSConfig{Termination: v12.TLSTerminationEdge}
+					tlsRouteUnstructured, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(tlsRoute)
+					return mockKubeClient(ctrl, true, []v1.Ingress{*tlsIng}, tlsRouteUnstructured)
+				},
+				componentName: componentName,
+			},
+			wantIngs: []api.ConnectionData{
+				{
+					Name: k8sComponentName,
+					Rules: []api.Rules{
+						{
+							Host:  "nodejs.example.com",
+							Paths: []string{"/", "/foo"},
+							TLS:   &api.TLS{SecretName: "my-cert"},
+						},
+					},
+				},
+			},
+			wantRoutes: []api.ConnectionData{
+				{
+					Name: k8sComponentName,
+					Rules: []api.Rules{
+						{
+							Host:  "",
+							Paths: []string{"/foo"},
+							TLS:   &api.TLS{Termination: "edge"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "skip ingress if it has an owner reference",
 			args: args{
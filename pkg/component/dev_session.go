@@ -0,0 +1,115 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	devSessionSuffix = "-dev-session"
+	// devSessionKey is the key of the ConfigMap data containing the JSON description of the session
+	devSessionKey = "session.json"
+)
+
+// NewDevSession returns the description of a Dev session started at startedAt on the current machine by the current user
+func NewDevSession(startedAt time.Time, fwPorts []api.ForwardedPort) api.DevSession {
+	session := api.DevSession{
+		StartedAt:      startedAt,
+		ForwardedPorts: fwPorts,
+	}
+	if u, err := user.Current(); err == nil {
+		session.Owner = u.Username
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		session.Machine = hostname
+	}
+	return session
+}
+
+// getDevSessionConfigMapName returns the name of the ConfigMap containing the Dev session information of the component
+func getDevSessionConfigMapName(componentName, appName string) (string, error) {
+	return util.NamespaceKubernetesObjectWithTrim(componentName, appName, 63-len(devSessionSuffix))
+}
+
+// SetDevSession publishes the Dev session information of the component on the cluster, into a ConfigMap
+// owned by the component's Deployment, so it is removed along with the Deployment.
+// The extra labels and annotations are added to the ConfigMap, as to the other resources created by odo.
+func SetDevSession(client kclient.ClientInterface, componentName, appName string, session api.DevSession, ownerReference metav1.OwnerReference, extraLabels, extraAnnotations map[string]string) error {
+	name, err := getDevSessionConfigMapName(componentName, appName)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name + devSessionSuffix,
+			Labels:      odolabels.AddExtra(odolabels.GetLabels(componentName, appName, "", odolabels.ComponentDevMode, true), extraLabels),
+			Annotations: odolabels.AddExtra(nil, extraAnnotations),
+		},
+		Data: map[string]string{
+			devSessionKey: string(content),
+		},
+	}
+	return client.TryWithBlockOwnerDeletion(ownerReference, func(ownerRef metav1.OwnerReference) error {
+		cm.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		_, err = client.ApplyConfigMap(cm)
+		return err
+	})
+}
+
+// GetDevSession returns the Dev session information of the component published on the cluster,
+// or nil if no Dev session is running for the component
+func GetDevSession(client kclient.ClientInterface, componentName, appName string) (*api.DevSession, error) {
+	if client == nil {
+		return nil, nil
+	}
+	name, err := getDevSessionConfigMapName(componentName, appName)
+	if err != nil {
+		return nil, err
+	}
+	cm, err := client.GetConfigMap(name + devSessionSuffix)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	content, ok := cm.Data[devSessionKey]
+	if !ok {
+		return nil, nil
+	}
+	var session api.DevSession
+	err = json.Unmarshal([]byte(content), &session)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Dev session information: %w", err)
+	}
+	return &session, nil
+}
+
+// DeleteDevSession removes the Dev session information of the component from the cluster, when the session ends
+func DeleteDevSession(client kclient.ClientInterface, componentName, appName string) error {
+	name, err := getDevSessionConfigMapName(componentName, appName)
+	if err != nil {
+		return err
+	}
+	err = client.DeleteConfigMap(name + devSessionSuffix)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func TestDevSession(t *testing.T) {
+	fkclient, _ := kclient.FakeNew()
+	fkclient.Namespace = "project"
+
+	got, err := GetDevSession(fkclient, "my-component", "app")
+	if err != nil {
+		t.Fatalf("unexpected error getting non-existing session: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected no session, got %+v", got)
+	}
+
+	session := api.DevSession{
+		Owner:     "alice",
+		Machine:   "laptop",
+		StartedAt: time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC),
+		ForwardedPorts: []api.ForwardedPort{
+			{
+				ContainerName: "runtime",
+				LocalAddress:  "127.0.0.1",
+				LocalPort:     20001,
+				ContainerPort: 3000,
+			},
+		},
+	}
+	ownerRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "my-component-app",
+	}
+
+	for i := 0; i < 2; i++ {
+		// Setting the session twice checks that an existing session is updated
+		err = SetDevSession(fkclient, "my-component", "app", session, ownerRef, map[string]string{"team": "a"}, map[string]string{"owner": "me"})
+		if err != nil {
+			t.Fatalf("unexpected error setting session: %v", err)
+		}
+	}
+
+	cm, err := fkclient.GetConfigMap("my-component-app" + devSessionSuffix)
+	if err != nil {
+		t.Fatalf("unexpected error getting ConfigMap: %v", err)
+	}
+	if cm.Labels["team"] != "a" || cm.Annotations["owner"] != "me" {
+		t.Errorf("expected extra labels and annotations on the ConfigMap, got %v and %v", cm.Labels, cm.Annotations)
+	}
+
+	got, err = GetDevSession(fkclient, "my-component", "app")
+	if err != nil {
+		t.Fatalf("unexpected error getting session: %v", err)
+	}
+	if diff := cmp.Diff(&session, got); diff != "" {
+		t.Errorf("GetDevSession() mismatch (-want +got):\n%s", diff)
+	}
+
+	for i := 0; i < 2; i++ {
+		// Deleting the session twice checks that deleting a non-existing session is not an error
+		err = DeleteDevSession(fkclient, "my-component", "app")
+		if err != nil {
+			t.Fatalf("unexpected error deleting session: %v", err)
+		}
+	}
+	got, err = GetDevSession(fkclient, "my-component", "app")
+	if err != nil || got != nil {
+		t.Errorf("expected no session and no error after deletion, got %+v, %v", got, err)
+	}
+
+	got, err = GetDevSession(nil, "my-component", "app")
+	if err != nil || got != nil {
+		t.Errorf("expected no session and no error without client, got %+v, %v", got, err)
+	}
+}
@@ -22,3 +22,8 @@ func (e NoComponentFoundError) Error() string {
 	}
 	return fmt.Sprintf("no component found with name %q", e.name)
 }
+
+// ErrorCode returns the code identifying the error in telemetry
+func (e NoComponentFoundError) ErrorCode() string {
+	return "no-component-found"
+}
@@ -34,7 +34,7 @@ func ExecuteInNewContainer(
 	appName string,
 	command v1alpha2.Command,
 ) error {
-	policy, err := kubeClient.GetCurrentNamespacePolicy()
+	policy, err := GetPodSecurityPolicy(ctx, kubeClient)
 	if err != nil {
 		return err
 	}
@@ -92,6 +92,13 @@ func ExecuteInNewContainer(
 	job.Annotations = map[string]string{}
 	odolabels.AddCommonAnnotations(job.Annotations)
 	odolabels.SetProjectType(job.Annotations, GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	odolabels.SetLanguage(job.Annotations, devfileObj.Data.GetMetadata().Language)
+	extraLabels, extraAnnotations, err := GetExtraMetadata(ctx, devfileObj)
+	if err != nil {
+		return err
+	}
+	job.SetLabels(odolabels.AddExtra(job.GetLabels(), extraLabels))
+	job.SetAnnotations(odolabels.AddExtra(job.GetAnnotations(), extraAnnotations))
 
 	//	Make sure there are no existing jobs
 	checkAndDeleteExistingJob := func() {
@@ -152,7 +159,7 @@ func ExecuteInNewContainer(
 		if logErr != nil {
 			log.Warningf("failed to fetch the logs of execution; cause: %s", logErr)
 		}
-		fmt.Println("Execution output:")
+		fmt.Fprintln(log.GetStdout(), "Execution output:")
 		_ = util.DisplayLog(false, jobLogs, log.GetStderr(), componentName, 100)
 	}
 
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/remotecmd"
@@ -41,7 +43,14 @@ func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClie
 
 	// Spinner created but not started yet.
 	// It will be displayed when the statusHandlerFunc function is called with the "Starting" state.
-	spinner := log.NewStatus(log.GetStdout())
+	// The status of a command executed in parallel is written through a writer prefixing each line,
+	// so the lines of the different commands are not mixed. All the lines written are complete,
+	// the writer does not need to be closed
+	var out io.Writer = log.GetStdout()
+	if prefix := libdevfile.GetOutputPrefix(ctx); prefix != "" {
+		out = util.NewLinePrefixWriter(out, prefix)
+	}
+	spinner := log.NewStatus(out)
 
 	// if we need to restart, issue the remote process handler command to stop all running commands first.
 	// We do not need to restart Hot reload capable commands.
@@ -8,6 +8,7 @@ import (
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/platform"
@@ -30,7 +31,27 @@ func ExecuteTerminatingCommand(ctx context.Context, execClient exec.Client, plat
 	} else {
 		msg += " (command: " + command.Id + ")"
 	}
-	spinner := log.Spinner(msg)
+	// Use GetStderr in order to make sure that colour output is correct
+	// on non-TTY terminals
+	var (
+		prefix  = libdevfile.GetOutputPrefix(ctx)
+		spinner *log.Status
+		errOut  = log.GetStderr()
+	)
+	if prefix != "" {
+		// Commands executed in parallel write all their output through writers prefixing each line.
+		// As these writers are not terminals, the status is displayed without spinning,
+		// and the lines of the different commands are not mixed
+		out := util.NewLinePrefixWriter(log.GetStdout(), prefix)
+		defer out.Close()
+		prefixedErrOut := util.NewLinePrefixWriter(errOut, prefix)
+		defer prefixedErrOut.Close()
+		errOut = prefixedErrOut
+		spinner = log.NewStatus(out)
+		spinner.Start(msg, true)
+	} else {
+		spinner = log.Spinner(msg)
+	}
 	defer spinner.End(false)
 
 	logger := machineoutput.NewMachineEventLoggingClient()
@@ -48,9 +69,7 @@ func ExecuteTerminatingCommand(ctx context.Context, execClient exec.Client, plat
 			return fmt.Errorf("unable to log error %v: %w", err, errLog)
 		}
 
-		// Use GetStderr in order to make sure that colour output is correct
-		// on non-TTY terminals
-		errLog = util.DisplayLog(false, rd, log.GetStderr(), componentName, -1)
+		errLog = util.DisplayLog(false, rd, errOut, componentName, -1)
 		if errLog != nil {
 			return fmt.Errorf("unable to log error %v: %w", err, errLog)
 		}
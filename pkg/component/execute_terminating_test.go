@@ -0,0 +1,131 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// captureOutput returns what f writes to the standard output and error
+func captureOutput(t *testing.T, f func()) (string, string) {
+	// capture replaces file by a pipe, and returns a function restoring file and returning what has been written
+	capture := func(file **os.File) func() string {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		done := make(chan string)
+		go func() {
+			out, _ := io.ReadAll(r)
+			done <- string(out)
+		}()
+		previous := *file
+		*file = w
+		return func() string {
+			*file = previous
+			w.Close()
+			return <-done
+		}
+	}
+	stdout := capture(&os.Stdout)
+	stderr := capture(&os.Stderr)
+	f()
+	return stdout(), stderr()
+}
+
+func TestExecuteTerminatingCommand_ParallelFailures(t *testing.T) {
+	dData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dData.AddComponents([]v1alpha2.Component{containerComponent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dData.AddCommands([]v1alpha2.Command{
+		generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Kind:      v1alpha2.BuildCommandGroupKind,
+			Id:        "build-all",
+			IsDefault: pointer.Bool(true),
+			Commands:  []string{"build-backend", "build-frontend"},
+			Parallel:  pointer.Bool(true),
+		}),
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Kind:        v1alpha2.BuildCommandGroupKind,
+			Id:          "build-backend",
+			CommandLine: "go build ./...",
+			Component:   containerComponent.Name,
+		}),
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Kind:        v1alpha2.BuildCommandGroupKind,
+			Id:          "build-frontend",
+			CommandLine: "npm run build",
+			Component:   containerComponent.Name,
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: dData}
+
+	ctrl := gomock.NewController(t)
+	execClient := exec.NewMockClient(ctrl)
+	execClient.EXPECT().ExecuteCommand(gomock.Any(), gomock.Any(), "a-pod", containerComponent.Name, false, gomock.Any(), gomock.Any()).
+		Return(nil, nil, errors.New("exit status 1")).Times(2)
+	platformClient := platform.NewMockClient(ctrl)
+	platformClient.EXPECT().GetRunningPodFromSelector(gomock.Any()).
+		Return(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a-pod"}}, nil).Times(2)
+	platformClient.EXPECT().GetPodLogs("a-pod", containerComponent.Name, false).
+		DoAndReturn(func(_, _ string, _ bool) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("first log line\nsecond log line\n")), nil
+		}).Times(2)
+
+	ctx := odocontext.WithApplication(context.Background(), "app")
+	ctx = odocontext.WithComponentName(ctx, "my-component")
+	handler := NewRunHandler(ctx, platformClient, execClient, nil, "a-pod", false, []string{containerComponent.Name}, "Building your application in container", nil, nil, devfileObj, "")
+
+	stdout, stderr := captureOutput(t, func() {
+		err = libdevfile.Build(ctx, devfileObj, "", handler)
+	})
+
+	var parallelErr libdevfile.ParallelCommandError
+	if !errors.As(err, &parallelErr) {
+		t.Fatalf("expected a ParallelCommandError, got %v", err)
+	}
+	if got := len(parallelErr.Errors()); got != 2 {
+		t.Errorf("expected 2 errors, got %d: %v", got, parallelErr)
+	}
+
+	for name, output := range map[string]string{"stdout": stdout, "stderr": stderr} {
+		for _, prefix := range []string{"[build-backend] ", "[build-frontend] "} {
+			if !strings.Contains(output, prefix) {
+				t.Errorf("expected %s to contain lines prefixed with %q, got:\n%s", name, prefix, output)
+			}
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+			if !strings.HasPrefix(line, "[build-backend] ") && !strings.HasPrefix(line, "[build-frontend] ") {
+				t.Errorf("expected all the lines of %s to be prefixed with the id of their command, got %q", name, line)
+			}
+		}
+	}
+	if strings.Contains(stdout, "\r") {
+		t.Errorf("expected no carriage return in the status of commands executed in parallel, got %q", stdout)
+	}
+}
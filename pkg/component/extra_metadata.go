@@ -0,0 +1,76 @@
+package component
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// GetExtraMetadata returns the labels and annotations to add to all the resources created by odo for the component:
+// the ones defined by the ExtraLabels and ExtraAnnotations preferences, completed or overridden by the ones defined
+// by the odo.dev/extra-labels and odo.dev/extra-annotations attributes of the Devfile
+func GetExtraMetadata(ctx context.Context, devfileObj parser.DevfileObj) (labels map[string]string, annotations map[string]string, err error) {
+	labels = copyMap(odocontext.GetExtraLabels(ctx))
+	annotations = copyMap(odocontext.GetExtraAnnotations(ctx))
+
+	if devfileObj.Data == nil || devfileObj.Data.GetSchemaVersion() == string(data.APISchemaVersion200) {
+		// attributes are not supported by 2.0.0
+		return labels, annotations, nil
+	}
+	attributes, err := devfileObj.Data.GetAttributes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if attributes.Exists(odolabels.ExtraLabelsAttribute) {
+		var devfileLabels map[string]string
+		err = attributes.GetInto(odolabels.ExtraLabelsAttribute, &devfileLabels)
+		if err == nil {
+			err = odolabels.ValidateExtraLabels(devfileLabels)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %q attribute in the Devfile: %w", odolabels.ExtraLabelsAttribute, err)
+		}
+		labels = mergeExtra(labels, devfileLabels)
+	}
+
+	if attributes.Exists(odolabels.ExtraAnnotationsAttribute) {
+		var devfileAnnotations map[string]string
+		err = attributes.GetInto(odolabels.ExtraAnnotationsAttribute, &devfileAnnotations)
+		if err == nil {
+			err = odolabels.ValidateExtraAnnotations(devfileAnnotations)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %q attribute in the Devfile: %w", odolabels.ExtraAnnotationsAttribute, err)
+		}
+		annotations = mergeExtra(annotations, devfileAnnotations)
+	}
+
+	return labels, annotations, nil
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func mergeExtra(dst map[string]string, src map[string]string) map[string]string {
+	if dst == nil && len(src) > 0 {
+		dst = make(map[string]string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,86 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devfile/library/v2/pkg/devfile"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+func TestGetExtraMetadata(t *testing.T) {
+	tests := []struct {
+		name            string
+		prefLabels      map[string]string
+		prefAnnotations map[string]string
+		attributes      string
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+		wantErr         bool
+	}{
+		{
+			name: "no extra metadata",
+		},
+		{
+			name:            "preferences only",
+			prefLabels:      map[string]string{"team": "my-team"},
+			prefAnnotations: map[string]string{"owner": "me@example.com"},
+			wantLabels:      map[string]string{"team": "my-team"},
+			wantAnnotations: map[string]string{"owner": "me@example.com"},
+		},
+		{
+			name:       "Devfile attributes override preferences",
+			prefLabels: map[string]string{"team": "my-team", "cost-center": "1234"},
+			attributes: `
+  odo.dev/extra-labels:
+    team: other-team
+  odo.dev/extra-annotations:
+    owner: me@example.com
+`,
+			wantLabels:      map[string]string{"team": "other-team", "cost-center": "1234"},
+			wantAnnotations: map[string]string{"owner": "me@example.com"},
+		},
+		{
+			name: "invalid label value in Devfile attribute",
+			attributes: `
+  odo.dev/extra-labels:
+    owner: me@example.com
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "schemaVersion: 2.2.0\nmetadata:\n  name: my-component\n"
+			if tt.attributes != "" {
+				content += "attributes:" + tt.attributes
+			}
+			devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{
+				Data:             []byte(content),
+				FlattenedDevfile: pointer.Bool(false),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+			ctx = odocontext.WithExtraLabels(ctx, tt.prefLabels)
+			ctx = odocontext.WithExtraAnnotations(ctx, tt.prefAnnotations)
+
+			gotLabels, gotAnnotations, err := GetExtraMetadata(ctx, devfileObj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetExtraMetadata() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.wantLabels, gotLabels); diff != "" {
+				t.Errorf("GetExtraMetadata() labels mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantAnnotations, gotAnnotations); diff != "" {
+				t.Errorf("GetExtraMetadata() annotations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -93,7 +93,7 @@ func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind v1alph
 	}
 	switch platform := a.platformClient.(type) {
 	case kclient.ClientInterface:
-		return ApplyKubernetes(mode, appName, componentName, a.devfile, kubernetes, platform, a.path)
+		return ApplyKubernetes(a.ctx, mode, appName, componentName, a.devfile, kubernetes, platform, a.path)
 	default:
 		klog.V(4).Info("apply kubernetes/Openshift commands are not implemented on podman")
 		log.Warningf("Apply Kubernetes/Openshift components are not supported on Podman. Skipping: %v.", kubernetes.Name)
@@ -0,0 +1,47 @@
+package component
+
+import (
+	"context"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog"
+	psaapi "k8s.io/pod-security-admission/api"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+// GetPodSecurityPolicy returns the Pod Security Admission policy the pods created by odo must respect.
+// The level set by the ODO_POD_SECURITY_LEVEL environment variable takes precedence over the level
+// enforced on the current namespace.
+// If the namespace cannot be read, or kubeClient is nil, the restricted level is used, so the pods are accepted whatever the enforced level.
+func GetPodSecurityPolicy(ctx context.Context, kubeClient kclient.ClientInterface) (psaapi.Policy, error) {
+	if level := envcontext.GetEnvConfig(ctx).OdoPodSecurityLevel; level != nil {
+		parsed, err := psaapi.ParseLevel(*level)
+		if err != nil {
+			return psaapi.Policy{}, fmt.Errorf("invalid value for ODO_POD_SECURITY_LEVEL: %w", err)
+		}
+		return levelPolicy(parsed), nil
+	}
+
+	if kubeClient == nil {
+		return levelPolicy(psaapi.LevelRestricted), nil
+	}
+
+	policy, err := kubeClient.GetCurrentNamespacePolicy()
+	if kerrors.IsForbidden(err) {
+		klog.V(2).Infof("unable to get the Pod Security level of the namespace, using the %s level: %v", psaapi.LevelRestricted, err)
+		return levelPolicy(psaapi.LevelRestricted), nil
+	}
+	return policy, err
+}
+
+func levelPolicy(level psaapi.Level) psaapi.Policy {
+	return psaapi.Policy{
+		Enforce: psaapi.LevelVersion{
+			Level:   level,
+			Version: psaapi.LatestVersion(),
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sethvargo/go-envconfig"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	psaapi "k8s.io/pod-security-admission/api"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func TestGetPodSecurityPolicy(t *testing.T) {
+	baselinePolicy := psaapi.Policy{
+		Enforce: psaapi.LevelVersion{Level: psaapi.LevelBaseline, Version: psaapi.LatestVersion()},
+	}
+	restrictedPolicy := psaapi.Policy{
+		Enforce: psaapi.LevelVersion{Level: psaapi.LevelRestricted, Version: psaapi.LatestVersion()},
+	}
+	tests := []struct {
+		name       string
+		env        map[string]string
+		kubeClient func(ctrl *gomock.Controller) kclient.ClientInterface
+		want       psaapi.Policy
+		wantErr    bool
+	}{
+		{
+			name: "policy of the namespace",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespacePolicy().Return(baselinePolicy, nil)
+				return client
+			},
+			want: baselinePolicy,
+		},
+		{
+			name: "namespace cannot be read",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespacePolicy().
+					Return(psaapi.Policy{}, kerrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "my-ns", errors.New("forbidden")))
+				return client
+			},
+			want: restrictedPolicy,
+		},
+		{
+			name: "error getting the namespace",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespacePolicy().Return(psaapi.Policy{}, errors.New("an error"))
+				return client
+			},
+			wantErr: true,
+		},
+		{
+			name: "no cluster",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return nil
+			},
+			want: restrictedPolicy,
+		},
+		{
+			name: "level set by the environment",
+			env:  map[string]string{"ODO_POD_SECURITY_LEVEL": "restricted"},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return kclient.NewMockClientInterface(ctrl)
+			},
+			want: restrictedPolicy,
+		},
+		{
+			name: "invalid level set by the environment",
+			env:  map[string]string{"ODO_POD_SECURITY_LEVEL": "strict"},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return kclient.NewMockClientInterface(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			envConfig, err := config.GetConfigurationWith(envconfig.MapLookuper(tt.env))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := envcontext.WithEnvConfig(context.Background(), *envConfig)
+
+			got, err := GetPodSecurityPolicy(ctx, tt.kubeClient(ctrl))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPodSecurityPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("GetPodSecurityPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
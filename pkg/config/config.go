@@ -11,6 +11,9 @@ type Configuration struct {
 	DevfileProxy                  *string       `env:"DEVFILE_PROXY,noinit"`
 	DockerCmd                     string        `env:"DOCKER_CMD,default=docker"`
 	Globalodoconfig               *string       `env:"GLOBALODOCONFIG,noinit"`
+	Lang                          *string       `env:"LANG,noinit"`
+	LcAll                         *string       `env:"LC_ALL,noinit"`
+	LcMessages                    *string       `env:"LC_MESSAGES,noinit"`
 	OdoDebugTelemetryFile         *string       `env:"ODO_DEBUG_TELEMETRY_FILE,noinit"`
 	OdoDisableTelemetry           *bool         `env:"ODO_DISABLE_TELEMETRY,noinit"`
 	OdoLogLevel                   *int          `env:"ODO_LOG_LEVEL,noinit"`
@@ -23,6 +26,11 @@ type Configuration struct {
 	OdoContainerBackendGlobalArgs []string      `env:"ODO_CONTAINER_BACKEND_GLOBAL_ARGS,noinit,delimiter=;"`
 	OdoImageBuildArgs             []string      `env:"ODO_IMAGE_BUILD_ARGS,noinit,delimiter=;"`
 	OdoContainerRunArgs           []string      `env:"ODO_CONTAINER_RUN_ARGS,noinit,delimiter=;"`
+	OdoPodSecurityLevel           *string       `env:"ODO_POD_SECURITY_LEVEL,noinit"`
+	OdoClusterAPIRetries          int           `env:"ODO_CLUSTER_API_RETRIES,default=3"`
+	OdoClusterAPIRetryBackoff     time.Duration `env:"ODO_CLUSTER_API_RETRY_BACKOFF,default=500ms"`
+	OdoImagePushRetries           int           `env:"ODO_IMAGE_PUSH_RETRIES,default=3"`
+	OdoImagePushRetryBackoff      time.Duration `env:"ODO_IMAGE_PUSH_RETRY_BACKOFF,default=2s"`
 }
 
 // GetConfiguration initializes a Configuration for odo by using the system environment.
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sethvargo/go-envconfig"
 )
@@ -16,14 +17,30 @@ func TestDefaultValues(t *testing.T) {
 	checkDefaultStringValue(t, "PodmanCmd", cfg.PodmanCmd, "podman")
 	checkDefaultStringValue(t, "TelemetryCaller", cfg.TelemetryCaller, "")
 	checkDefaultBoolValue(t, "OdoExperimentalMode", cfg.OdoExperimentalMode, false)
+	if cfg.OdoClusterAPIRetries != 3 {
+		t.Errorf("default value for %q should be %d but is %d", "OdoClusterAPIRetries", 3, cfg.OdoClusterAPIRetries)
+	}
+	if cfg.OdoClusterAPIRetryBackoff != 500*time.Millisecond {
+		t.Errorf("default value for %q should be %v but is %v", "OdoClusterAPIRetryBackoff", 500*time.Millisecond, cfg.OdoClusterAPIRetryBackoff)
+	}
+	if cfg.OdoImagePushRetries != 3 {
+		t.Errorf("default value for %q should be %d but is %d", "OdoImagePushRetries", 3, cfg.OdoImagePushRetries)
+	}
+	if cfg.OdoImagePushRetryBackoff != 2*time.Second {
+		t.Errorf("default value for %q should be %v but is %v", "OdoImagePushRetryBackoff", 2*time.Second, cfg.OdoImagePushRetryBackoff)
+	}
 
 	// Use noinit to set non initialized value as nil instead of zero-value
 	checkNilString(t, "DevfileProxy", cfg.DevfileProxy)
 	checkNilString(t, "Globalodoconfig", cfg.Globalodoconfig)
 	checkNilString(t, "Globalodoconfig", cfg.Globalodoconfig)
+	checkNilString(t, "Lang", cfg.Lang)
+	checkNilString(t, "LcAll", cfg.LcAll)
+	checkNilString(t, "LcMessages", cfg.LcMessages)
 	checkNilString(t, "OdoDebugTelemetryFile", cfg.OdoDebugTelemetryFile)
 	checkNilBool(t, "OdoDisableTelemetry", cfg.OdoDisableTelemetry)
 	checkNilString(t, "OdoTrackingConsent", cfg.OdoTrackingConsent)
+	checkNilString(t, "OdoPodSecurityLevel", cfg.OdoPodSecurityLevel)
 
 }
 
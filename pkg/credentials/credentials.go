@@ -0,0 +1,120 @@
+// Package credentials resolves the credentials needed to access private git repositories and files over HTTP(S).
+// The credentials are searched in the secret store of the system, where they are saved with `odo preference add credential`,
+// then in the credential helpers configured for git.
+package credentials
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+	"k8s.io/klog"
+)
+
+// keyringService is the service under which the credentials are saved in the secret store, indexed by host
+const keyringService = "odo-credentials"
+
+// Credentials are the username and password (or token) used to access a host
+type Credentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password"`
+}
+
+// gitCredentialFill runs `git credential fill` with the input and returns its output.
+// It is a variable so it can be replaced in tests.
+var gitCredentialFill = func(input string) (string, error) {
+	cmd := exec.Command("git", "credential", "fill")
+	cmd.Stdin = strings.NewReader(input)
+	// Do not let git ask the user for the credentials on the terminal
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	err := cmd.Run()
+	return stdout.String(), err
+}
+
+// Save saves the credentials for the host in the secret store
+func Save(host string, credentials Credentials) error {
+	data, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+	err = keyring.Set(keyringService, host, string(data))
+	if err != nil {
+		return fmt.Errorf("unable to save the credentials to the keyring: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes the credentials for the host from the secret store
+func Delete(host string) error {
+	err := keyring.Delete(keyringService, host)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("no credentials found for %q", host)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to delete the credentials from the keyring: %w", err)
+	}
+	return nil
+}
+
+// Lookup returns the credentials to access the HTTP(S) URL, or nil if no credentials are found
+func Lookup(rawURL string) (*Credentials, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		// SCP-like git URLs are not parsed as URLs; the SSH agent or keys are used for them
+		return nil, nil
+	}
+
+	data, err := keyring.Get(keyringService, u.Host)
+	if err == nil {
+		var credentials Credentials
+		err = json.Unmarshal([]byte(data), &credentials)
+		if err != nil {
+			return nil, fmt.Errorf("invalid credentials for %q in the keyring: %w", u.Host, err)
+		}
+		klog.V(4).Infof("using the credentials of the keyring for %q", u.Host)
+		return &credentials, nil
+	}
+	if !errors.Is(err, keyring.ErrNotFound) {
+		klog.V(4).Infof("unable to get the credentials for %q from the keyring: %v", u.Host, err)
+	}
+
+	return lookupGitCredentialHelpers(u), nil
+}
+
+// lookupGitCredentialHelpers returns the credentials given by the git credential helpers for the URL, or nil if none is found
+func lookupGitCredentialHelpers(u *url.URL) *Credentials {
+	input := fmt.Sprintf("protocol=%s\nhost=%s\npath=%s\n\n", u.Scheme, u.Host, strings.TrimPrefix(u.Path, "/"))
+	output, err := gitCredentialFill(input)
+	if err != nil {
+		klog.V(4).Infof("no credentials found by the git credential helpers for %q: %v", u.Host, err)
+		return nil
+	}
+	var credentials Credentials
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "username":
+			credentials.Username = value
+		case "password":
+			credentials.Password = value
+		}
+	}
+	if credentials.Password == "" {
+		return nil
+	}
+	klog.V(4).Infof("using the credentials of the git credential helpers for %q", u.Host)
+	return &credentials
+}
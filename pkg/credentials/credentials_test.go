@@ -0,0 +1,106 @@
+package credentials
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zalando/go-keyring"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name              string
+		url               string
+		saved             map[string]Credentials
+		gitCredentialFill func(input string) (string, error)
+		want              *Credentials
+		wantErr           bool
+	}{
+		{
+			name: "credentials saved in the keyring",
+			url:  "https://github.com/org/repo.git",
+			saved: map[string]Credentials{
+				"github.com": {Username: "user", Password: "token"},
+			},
+			gitCredentialFill: func(input string) (string, error) {
+				t.Errorf("git credential helpers should not be called")
+				return "", nil
+			},
+			want: &Credentials{Username: "user", Password: "token"},
+		},
+		{
+			name: "credentials given by the git credential helpers",
+			url:  "https://gitlab.com/org/repo.git",
+			saved: map[string]Credentials{
+				"github.com": {Username: "user", Password: "token"},
+			},
+			gitCredentialFill: func(input string) (string, error) {
+				want := "protocol=https\nhost=gitlab.com\npath=org/repo.git\n\n"
+				if input != want {
+					t.Errorf("git credential fill input = %q, want %q", input, want)
+				}
+				return "protocol=https\nhost=gitlab.com\nusername=git-user\npassword=git-token\n", nil
+			},
+			want: &Credentials{Username: "git-user", Password: "git-token"},
+		},
+		{
+			name: "no credentials found",
+			url:  "https://gitlab.com/org/repo.git",
+			gitCredentialFill: func(input string) (string, error) {
+				return "", errors.New("exit status 128")
+			},
+		},
+		{
+			name: "no password given by the git credential helpers",
+			url:  "https://gitlab.com/org/repo.git",
+			gitCredentialFill: func(input string) (string, error) {
+				return "protocol=https\nhost=gitlab.com\nusername=git-user\n", nil
+			},
+		},
+		{
+			name: "not an HTTP URL",
+			url:  "git@github.com:org/repo.git",
+			saved: map[string]Credentials{
+				"github.com": {Username: "user", Password: "token"},
+			},
+			gitCredentialFill: func(input string) (string, error) {
+				t.Errorf("git credential helpers should not be called")
+				return "", nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyring.MockInit()
+			for host, credentials := range tt.saved {
+				if err := Save(host, credentials); err != nil {
+					t.Fatal(err)
+				}
+			}
+			gitCredentialFill = tt.gitCredentialFill
+
+			got, err := Lookup(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Lookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Lookup() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	keyring.MockInit()
+	if err := Save("github.com", Credentials{Password: "token"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Delete("github.com"); err != nil {
+		t.Errorf("Delete() unexpected error: %v", err)
+	}
+	if err := Delete("github.com"); err == nil {
+		t.Errorf("Delete() expected an error for credentials not found")
+	}
+}
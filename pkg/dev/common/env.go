@@ -0,0 +1,86 @@
+package common
+
+import (
+	"sort"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+// AddEnvToRunContainers adds the environment variables of options.Env to the Container components
+// used by the run command (or by the debug command in Debug mode), overriding the variables with the same names.
+// Only the Devfile object in memory is modified, the variables are never written to the Devfile.
+func AddEnvToRunContainers(devfileObj parser.DevfileObj, options dev.StartOptions) error {
+	if len(options.Env) == 0 {
+		return nil
+	}
+
+	runCommand, runKind := getRunCommand(options)
+	cmd, err := libdevfile.ValidateAndGetCommand(devfileObj, runCommand, runKind)
+	if err != nil {
+		return err
+	}
+	containers, err := libdevfile.GetContainerComponentsForCommand(devfileObj, cmd)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(options.Env))
+	for name := range options.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, container := range containers {
+		components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+			FilterByName: container,
+		})
+		if err != nil {
+			return err
+		}
+		for _, comp := range components {
+			if comp.Container == nil {
+				continue
+			}
+			comp.Container.Env = mergeEnv(comp.Container.Env, names, options.Env)
+			err = devfileObj.Data.UpdateComponent(comp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeEnv returns env with the values of the variables defined in values replaced, and the other variables of values
+// appended in the order of names
+func mergeEnv(env []devfilev1.EnvVar, names []string, values map[string]string) []devfilev1.EnvVar {
+	result := make([]devfilev1.EnvVar, 0, len(env)+len(names))
+	replaced := make(map[string]struct{}, len(names))
+	for _, e := range env {
+		if v, ok := values[e.Name]; ok {
+			e.Value = v
+			replaced[e.Name] = struct{}{}
+		}
+		result = append(result, e)
+	}
+	for _, name := range names {
+		if _, ok := replaced[name]; ok {
+			continue
+		}
+		result = append(result, devfilev1.EnvVar{Name: name, Value: values[name]})
+	}
+	return result
+}
+
+// getRunCommand returns the name and kind of the command executed by odo dev to run the application
+func getRunCommand(options dev.StartOptions) (string, devfilev1.CommandGroupKind) {
+	if options.Debug {
+		return options.DebugCommand, devfilev1.DebugCommandGroupKind
+	}
+	return options.RunCommand, devfilev1.RunCommandGroupKind
+}
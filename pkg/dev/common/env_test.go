@@ -0,0 +1,127 @@
+package common
+
+import (
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+)
+
+func TestAddEnvToRunContainers(t *testing.T) {
+	buildDevfile := func(t *testing.T) parser.DevfileObj {
+		devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = devfileData.AddComponents([]devfilev1.Component{
+			generator.GetContainerComponent(generator.ContainerComponentParams{
+				Name: "runtime",
+				Container: devfilev1.Container{
+					Image: "runtime:latest",
+					Env: []devfilev1.EnvVar{
+						{Name: "MODE", Value: "production"},
+						{Name: "PORT", Value: "8080"},
+					},
+				},
+			}),
+			generator.GetContainerComponent(generator.ContainerComponentParams{
+				Name:      "debugger",
+				Container: devfilev1.Container{Image: "debugger:latest"},
+			}),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = devfileData.AddCommands([]devfilev1.Command{
+			generator.GetExecCommand(generator.ExecCommandParams{
+				Id:          "run",
+				Kind:        devfilev1.RunCommandGroupKind,
+				IsDefault:   pointer.Bool(true),
+				Component:   "runtime",
+				CommandLine: "./start",
+			}),
+			generator.GetExecCommand(generator.ExecCommandParams{
+				Id:          "debug",
+				Kind:        devfilev1.DebugCommandGroupKind,
+				IsDefault:   pointer.Bool(true),
+				Component:   "debugger",
+				CommandLine: "./debug",
+			}),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parser.DevfileObj{Data: devfileData}
+	}
+	getEnv := func(t *testing.T, devfileObj parser.DevfileObj, name string) []devfilev1.EnvVar {
+		components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{FilterByName: name})
+		if err != nil || len(components) != 1 {
+			t.Fatalf("unable to get component %q: %v", name, err)
+		}
+		return components[0].Container.Env
+	}
+
+	tests := []struct {
+		name         string
+		options      dev.StartOptions
+		wantRuntime  []devfilev1.EnvVar
+		wantDebugger []devfilev1.EnvVar
+	}{
+		{
+			name: "no env",
+			wantRuntime: []devfilev1.EnvVar{
+				{Name: "MODE", Value: "production"},
+				{Name: "PORT", Value: "8080"},
+			},
+		},
+		{
+			name: "env added to the container of the run command",
+			options: dev.StartOptions{
+				Env: map[string]string{"MODE": "development", "TOKEN": "secret", "FEATURE": "on"},
+			},
+			wantRuntime: []devfilev1.EnvVar{
+				{Name: "MODE", Value: "development"},
+				{Name: "PORT", Value: "8080"},
+				{Name: "FEATURE", Value: "on"},
+				{Name: "TOKEN", Value: "secret"},
+			},
+		},
+		{
+			name: "env added to the container of the debug command",
+			options: dev.StartOptions{
+				Debug: true,
+				Env:   map[string]string{"FEATURE": "on"},
+			},
+			wantRuntime: []devfilev1.EnvVar{
+				{Name: "MODE", Value: "production"},
+				{Name: "PORT", Value: "8080"},
+			},
+			wantDebugger: []devfilev1.EnvVar{
+				{Name: "FEATURE", Value: "on"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileObj := buildDevfile(t)
+			err := AddEnvToRunContainers(devfileObj, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantRuntime, getEnv(t, devfileObj, "runtime"), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("runtime env mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDebugger, getEnv(t, devfileObj, "debugger"), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("debugger env mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
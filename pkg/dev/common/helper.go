@@ -0,0 +1,10 @@
+package common
+
+// See https://github.com/devfile/developer-images and https://quay.io/repository/devfile/base-developer-image?tab=tags
+const (
+	// PortForwardingHelperContainerName is the name of the side container injected to forward traffic
+	// to applications listening on the loopback interface of the pod
+	PortForwardingHelperContainerName = "odo-helper-port-forwarding"
+	// PortForwardingHelperImage is the image of the side container, providing socat
+	PortForwardingHelperImage = "quay.io/devfile/base-developer-image@sha256:27d5ce66a259decb84770ea0d1ce8058a806f39dfcfeed8387f9cf2f29e76480"
+)
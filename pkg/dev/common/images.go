@@ -0,0 +1,82 @@
+package common
+
+import (
+	"reflect"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+// GetImageComponentsToPushOnStart returns the Image components to build and push before starting the containers:
+// the ones to push automatically, and the ones applied by the commands executed by odo dev
+// (the postStart events, the build command and the run or debug command) whose image is used by a Container component.
+func GetImageComponentsToPushOnStart(devfileObj parser.DevfileObj, options dev.StartOptions) ([]devfilev1.Component, error) {
+	components, err := libdevfile.GetImageComponentsToPushAutomatically(devfileObj)
+	if err != nil {
+		return nil, err
+	}
+
+	runCommand, runKind := getRunCommand(options)
+	ids := append([]string{}, devfileObj.Data.GetEvents().PostStart...)
+	for _, c := range []struct {
+		name string
+		kind devfilev1.CommandGroupKind
+	}{
+		{name: options.BuildCommand, kind: devfilev1.BuildCommandGroupKind},
+		{name: runCommand, kind: runKind},
+	} {
+		cmd, present, err := libdevfile.GetCommand(devfileObj, c.name, c.kind)
+		if err != nil {
+			return nil, err
+		}
+		if present {
+			ids = append(ids, cmd.Id)
+		}
+	}
+	usedByContainers, err := libdevfile.GetImageComponentsUsedByContainers(devfileObj, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range usedByContainers {
+		found := false
+		for _, auto := range components {
+			if auto.Name == c.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			components = append(components, c)
+		}
+	}
+	return components, nil
+}
+
+// appliedImagesHandler is a libdevfile.Handler which does not build again the Image components
+// already built before the containers were started
+type appliedImagesHandler struct {
+	libdevfile.Handler
+	applied map[string]devfilev1.ImageComponent
+}
+
+// NewAppliedImagesHandler returns a libdevfile.Handler delegating to handler, except for the Image components
+// present, with the same definition, in applied
+func NewAppliedImagesHandler(handler libdevfile.Handler, applied map[string]devfilev1.ImageComponent) libdevfile.Handler {
+	return appliedImagesHandler{
+		Handler: handler,
+		applied: applied,
+	}
+}
+
+func (o appliedImagesHandler) ApplyImage(img devfilev1.Component) error {
+	if alreadyApplied, ok := o.applied[img.Name]; ok && img.Image != nil && reflect.DeepEqual(*img.Image, alreadyApplied) {
+		klog.V(1).Infof("Skipping image component %q; already built before starting the containers", img.Name)
+		return nil
+	}
+	return o.Handler.ApplyImage(img)
+}
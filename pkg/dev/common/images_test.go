@@ -0,0 +1,128 @@
+package common
+
+import (
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+)
+
+func TestGetImageComponentsToPushOnStart(t *testing.T) {
+	autoImage := generator.GetImageComponent(generator.ImageComponentParams{
+		Name:  "auto-image",
+		Image: devfilev1.Image{ImageName: "auto:latest", ImageUnion: devfilev1.ImageUnion{AutoBuild: pointer.Bool(true)}},
+	})
+	runImage := generator.GetImageComponent(generator.ImageComponentParams{
+		Name:  "run-image",
+		Image: devfilev1.Image{ImageName: "run:latest"},
+	})
+	debugImage := generator.GetImageComponent(generator.ImageComponentParams{
+		Name:  "debug-image",
+		Image: devfilev1.Image{ImageName: "debug:latest"},
+	})
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{
+		autoImage,
+		runImage,
+		debugImage,
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "runtime",
+			Container: devfilev1.Container{Image: "run:latest"},
+		}),
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "debugger",
+			Container: devfilev1.Container{Image: "debug:latest"},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddCommands([]devfilev1.Command{
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "build-run-image", Component: "run-image"}),
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "build-debug-image", Component: "debug-image"}),
+		generator.GetExecCommand(generator.ExecCommandParams{Id: "start", Component: "runtime", CommandLine: "./start"}),
+		generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:        "run",
+			Kind:      devfilev1.RunCommandGroupKind,
+			IsDefault: pointer.Bool(true),
+			Commands:  []string{"build-run-image", "start"},
+		}),
+		generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:        "debug",
+			Kind:      devfilev1.DebugCommandGroupKind,
+			IsDefault: pointer.Bool(true),
+			Commands:  []string{"build-debug-image", "start"},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: devfileData}
+
+	tests := []struct {
+		name    string
+		options dev.StartOptions
+		want    []devfilev1.Component
+	}{
+		{
+			name: "run mode",
+			want: []devfilev1.Component{autoImage, runImage},
+		},
+		{
+			name:    "debug mode",
+			options: dev.StartOptions{Debug: true},
+			want:    []devfilev1.Component{autoImage, debugImage},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetImageComponentsToPushOnStart(devfileObj, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.SortSlices(func(a, b devfilev1.Component) bool { return a.Name < b.Name })); diff != "" {
+				t.Errorf("GetImageComponentsToPushOnStart() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppliedImagesHandler_ApplyImage(t *testing.T) {
+	img := generator.GetImageComponent(generator.ImageComponentParams{
+		Name:  "my-image",
+		Image: devfilev1.Image{ImageName: "my-image:latest"},
+	})
+	changed := generator.GetImageComponent(generator.ImageComponentParams{
+		Name:  "my-image",
+		Image: devfilev1.Image{ImageName: "my-image:v2"},
+	})
+	other := generator.GetImageComponent(generator.ImageComponentParams{
+		Name:  "other-image",
+		Image: devfilev1.Image{ImageName: "other-image:latest"},
+	})
+
+	ctrl := gomock.NewController(t)
+	handler := libdevfile.NewMockHandler(ctrl)
+	handler.EXPECT().ApplyImage(changed).Return(nil)
+	handler.EXPECT().ApplyImage(other).Return(nil)
+
+	h := NewAppliedImagesHandler(handler, map[string]devfilev1.ImageComponent{"my-image": *img.Image})
+	for _, c := range []devfilev1.Component{img, changed, other} {
+		if err := h.ApplyImage(c); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
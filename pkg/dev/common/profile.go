@@ -0,0 +1,43 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/devfile/profile"
+)
+
+// ApplyProfile returns the options with the commands and environment variables of the profile selected by options.Profile,
+// defined in the Devfile. The environment variables of options.Env override the ones of the profile.
+func ApplyProfile(devfileObj parser.DevfileObj, options dev.StartOptions) (dev.StartOptions, error) {
+	if options.Profile == "" {
+		return options, nil
+	}
+	p, err := profile.Get(devfileObj, options.Profile)
+	if err != nil {
+		return options, err
+	}
+	options.BuildCommand = p.BuildCommand
+	options.RunCommand = p.RunCommand
+	options.Debug = p.Debug
+	options.DebugCommand = p.DebugCommand
+	if len(p.Env) == 0 {
+		return options, nil
+	}
+	env := make(map[string]string, len(p.Env)+len(options.Env))
+	for name, value := range p.Env {
+		if errs := validation.IsEnvVarName(name); len(errs) > 0 {
+			return options, fmt.Errorf("invalid environment variable name %q in profile %q: %s", name, p.Name, strings.Join(errs, "; "))
+		}
+		env[name] = value
+	}
+	for name, value := range options.Env {
+		env[name] = value
+	}
+	options.Env = env
+	return options, nil
+}
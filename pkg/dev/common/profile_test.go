@@ -0,0 +1,91 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/devfile/library/v2/pkg/devfile"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+)
+
+func TestApplyProfile(t *testing.T) {
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{
+		Data: []byte(`schemaVersion: 2.2.0
+metadata:
+  name: my-component
+attributes:
+  odo.dev/profiles:
+    dev-debug:
+      debug: true
+      debugCommand: debug-verbose
+      env:
+        LOG_LEVEL: debug
+        PORT: "8080"
+    test:
+      buildCommand: build-tests
+      runCommand: test
+    invalid-env:
+      env:
+        1NVALID: value
+`),
+		FlattenedDevfile: pointer.Bool(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		options dev.StartOptions
+		want    dev.StartOptions
+		wantErr bool
+	}{
+		{
+			name:    "no profile",
+			options: dev.StartOptions{RunCommand: "run", Env: map[string]string{"PORT": "3000"}},
+			want:    dev.StartOptions{RunCommand: "run", Env: map[string]string{"PORT": "3000"}},
+		},
+		{
+			name:    "commands of the profile",
+			options: dev.StartOptions{Profile: "test"},
+			want:    dev.StartOptions{Profile: "test", BuildCommand: "build-tests", RunCommand: "test"},
+		},
+		{
+			name:    "environment variables of the options override the ones of the profile",
+			options: dev.StartOptions{Profile: "dev-debug", Env: map[string]string{"PORT": "3000"}},
+			want: dev.StartOptions{
+				Profile:      "dev-debug",
+				Debug:        true,
+				DebugCommand: "debug-verbose",
+				Env:          map[string]string{"LOG_LEVEL": "debug", "PORT": "3000"},
+			},
+		},
+		{
+			name:    "invalid environment variable name",
+			options: dev.StartOptions{Profile: "invalid-env"},
+			wantErr: true,
+		},
+		{
+			name:    "profile not defined",
+			options: dev.StartOptions{Profile: "prod"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyProfile(devfileObj, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ApplyProfile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
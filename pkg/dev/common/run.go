@@ -22,6 +22,25 @@ func Run(
 	configAutomountClient configAutomount.Client,
 	filesystem filesystem.Filesystem,
 ) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+
+	handler, err := newRunningPodHandler(ctx, platformClient, execClient, configAutomountClient, filesystem, "Executing command in container")
+	if err != nil {
+		return err
+	}
+
+	return libdevfile.ExecuteCommandByName(ctx, *devfileObj, commandName, handler, false)
+}
+
+// newRunningPodHandler returns a handler executing commands in the pod of the component started by odo dev
+func newRunningPodHandler(
+	ctx context.Context,
+	platformClient platform.Client,
+	execClient exec.Client,
+	configAutomountClient configAutomount.Client,
+	filesystem filesystem.Filesystem,
+	msg string,
+) (libdevfile.Handler, error) {
 	var (
 		componentName = odocontext.GetComponentName(ctx)
 		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
@@ -30,10 +49,10 @@ func Run(
 
 	pod, err := platformClient.GetPodUsingComponentName(componentName)
 	if err != nil {
-		return fmt.Errorf("unable to get pod for component %s: %w. Please check the command 'odo dev' is running", componentName, err)
+		return nil, fmt.Errorf("unable to get pod for component %s: %w. Please check the command 'odo dev' is running", componentName, err)
 	}
 
-	handler := component.NewRunHandler(
+	return component.NewRunHandler(
 		ctx,
 		platformClient,
 		execClient,
@@ -41,13 +60,11 @@ func Run(
 		pod.Name,
 		false,
 		component.GetContainersNames(pod),
-		"Executing command in container",
+		msg,
 
 		filesystem,
 		image.SelectBackend(ctx),
 		*devfileObj,
 		devfilePath,
-	)
-
-	return libdevfile.ExecuteCommandByName(ctx, *devfileObj, commandName, handler, false)
+	), nil
 }
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"context"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+// RecordBuild records the result of the build command in the state, for `odo status`. Errors are not fatal.
+func RecordBuild(ctx context.Context, stateClient state.Client, buildErr error) {
+	if stateClient == nil {
+		return
+	}
+	if err := stateClient.RecordBuild(ctx, buildErr); err != nil {
+		klog.V(4).Infof("unable to record build in state: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/configAutomount"
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// Test executes once the test command commandName, or the default test command if commandName is empty,
+// in the pod of the component started by odo dev, and reports its result in out.
+func Test(
+	ctx context.Context,
+	commandName string,
+	platformClient platform.Client,
+	execClient exec.Client,
+	configAutomountClient configAutomount.Client,
+	filesystem filesystem.Filesystem,
+	out io.Writer,
+) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+
+	handler, err := newRunningPodHandler(ctx, platformClient, execClient, configAutomountClient, filesystem, "Executing test command in container")
+	if err != nil {
+		return err
+	}
+
+	// The result is not recorded in the state, which belongs to the odo dev session
+	return RunTests(ctx, *devfileObj, commandName, handler, out, nil, 1)
+}
+
+// RunTests executes the test command commandName, or the default test command if commandName is empty, with handler.
+// The result of this iteration of the tests is reported in out, in the state for `odo status` if stateClient is not nil,
+// and as a JSON event. The error returned by the test command is returned.
+func RunTests(
+	ctx context.Context,
+	devfileObj parser.DevfileObj,
+	commandName string,
+	handler libdevfile.Handler,
+	out io.Writer,
+	stateClient state.Client,
+	iteration int,
+) error {
+	// A test command removed from the Devfile during the session is reported as a failure of the tests
+	commandID := commandName
+	cmd, testsErr := libdevfile.ValidateAndGetCommand(devfileObj, commandName, devfilev1.TestCommandGroupKind)
+	if testsErr == nil {
+		commandID = cmd.Id
+		testsErr = libdevfile.Test(ctx, devfileObj, cmd.Id, handler)
+	}
+
+	if testsErr == nil {
+		log.Fsuccess(out, fmt.Sprintf(i18n.T("Tests passed (iteration %d)"), iteration))
+	} else {
+		log.Ferror(out, fmt.Sprintf(i18n.T("Tests failed (iteration %d): %v"), iteration, testsErr))
+	}
+	machineoutput.NewMachineEventLoggingClient().TestResult(commandID, odocontext.GetComponentName(ctx), iteration, testsErr, machineoutput.TimestampNow())
+	if stateClient != nil {
+		if err := stateClient.RecordTests(ctx, testsErr); err != nil {
+			klog.V(4).Infof("unable to record tests in state: %v", err)
+		}
+	}
+	return testsErr
+}
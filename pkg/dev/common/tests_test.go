@@ -0,0 +1,102 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/golang/mock/gomock"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+func TestRunTests(t *testing.T) {
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{
+		Data: []byte(`schemaVersion: 2.2.0
+metadata:
+  name: my-component
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+commands:
+  - id: unit-tests
+    exec:
+      component: runtime
+      commandLine: npm test
+      group:
+        kind: test
+        isDefault: true
+  - id: e2e-tests
+    exec:
+      component: runtime
+      commandLine: npm run e2e
+      group:
+        kind: test
+`),
+		FlattenedDevfile: pointer.Bool(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		commandName string
+		testsErr    error
+		wantCommand string
+		wantOut     string
+		wantErr     bool
+	}{
+		{
+			name:        "default test command passes",
+			wantCommand: "unit-tests",
+			wantOut:     "Tests passed (iteration 2)",
+		},
+		{
+			name:        "named test command fails",
+			commandName: "e2e-tests",
+			testsErr:    errors.New("exit status 1"),
+			wantCommand: "e2e-tests",
+			wantOut:     "Tests failed (iteration 2): exit status 1",
+			wantErr:     true,
+		},
+		{
+			name:        "test command not found",
+			commandName: "removed",
+			wantOut:     "Tests failed (iteration 2)",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := libdevfile.NewMockHandler(ctrl)
+			if tt.wantCommand != "" {
+				handler.EXPECT().ExecuteTerminatingCommand(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, cmd v1alpha2.Command) error {
+						if cmd.Id != tt.wantCommand {
+							t.Errorf("executed command %q, want %q", cmd.Id, tt.wantCommand)
+						}
+						return tt.testsErr
+					})
+			}
+			var out bytes.Buffer
+			ctx := odocontext.WithComponentName(context.Background(), "my-component")
+			err := RunTests(ctx, devfileObj, tt.commandName, handler, &out, nil, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunTests() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.Contains(out.String(), tt.wantOut) {
+				t.Errorf("RunTests() output = %q, want it to contain %q", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package dev
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/api"
 )
@@ -18,6 +19,13 @@ type StartOptions struct {
 	RunCommand string
 	// If DebugCommand is set, this will look up the specified debug command in the Devfile and execute it. Otherwise, it uses the default one.
 	DebugCommand string
+	// If RunTests is set, the test command is executed after each successful push of the sources, and the result is reported.
+	RunTests bool
+	// If TestCommand is set, this will look up the specified test command in the Devfile. Otherwise, it uses the default one.
+	TestCommand string
+	// If Profile is set, the build, run and debug commands and the Debug mode are the ones of the profile with this name
+	// defined in the Devfile, and the environment variables of the profile are added to Env.
+	Profile string
 	// if RandomPorts is set, will port forward on random local ports, else uses ports starting at 20001
 	RandomPorts bool
 	// CustomForwardedPorts define custom ports for port forwarding
@@ -34,6 +42,33 @@ type StartOptions struct {
 	ForwardLocalhost bool
 	// Variables to override in the Devfile
 	Variables map[string]string
+	// Env are environment variables to add to the containers running the run (or debug) command, for this session only.
+	// They override the variables with the same names defined in the Devfile.
+	Env map[string]string
+	// ServiceAccount is the service account of the pod, taking precedence over the pod-overrides of the Devfile;
+	// the ServiceAccount preference is used if empty and the pod-overrides do not set one.
+	// Applicable to the cluster only.
+	ServiceAccount string
+	// ImagePullSecrets are the names of the secrets used to pull the images; the ImagePullSecrets preference is used if empty.
+	// Applicable to the cluster only.
+	ImagePullSecrets []string
+	// If Expose is set, public endpoints are exposed with an OpenShift Route, or an Ingress if Routes are not supported.
+	// Applicable to the cluster only.
+	Expose bool
+	// ExposeDomain is the domain used to build the hosts of the Ingresses created when Expose is set.
+	// Applicable to the cluster only.
+	ExposeDomain string
+	// IdleTimeout is the duration without file changes and without connections on the forwarded ports
+	// after which the component is scaled down to zero replicas, until the next change; 0 disables it.
+	// Applicable to the cluster only.
+	IdleTimeout time.Duration
+	// RollbackOnFailure rolls the component back automatically to its last working state
+	// when a pod of the component keeps crashing after an update.
+	// Applicable to the cluster only.
+	RollbackOnFailure bool
+	// TextExtensions are the extensions, or names, of the text files whose CRLF line endings are converted to LF
+	// when synced to the containers. Line endings are not converted if empty.
+	TextExtensions []string
 
 	Out    io.Writer
 	ErrOut io.Writer
@@ -53,6 +88,18 @@ type Client interface {
 		commandName string,
 	) error
 
+	// Test executes once the test command commandName, or the default test command if commandName is empty,
+	// in the component started by odo dev, and reports its result to out.
+	Test(
+		ctx context.Context,
+		commandName string,
+		out io.Writer,
+	) error
+
 	// CleanupResources deletes the component created using the context's devfile and writes any outputs to out
 	CleanupResources(ctx context.Context, out io.Writer) error
+
+	// CleanupLeftResources deletes the component left by a previous session which could not complete its cleanup,
+	// before the current session deploys the component, and writes any outputs to out
+	CleanupLeftResources(ctx context.Context, out io.Writer) error
 }
@@ -7,6 +7,7 @@ import (
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/labels"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 )
@@ -16,7 +17,7 @@ func (o *DevClient) CleanupResources(ctx context.Context, out io.Writer) error {
 		componentName = odocontext.GetComponentName(ctx)
 		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
 	)
-	fmt.Fprintln(out, "Cleaning resources, please wait")
+	fmt.Fprintln(out, i18n.T("Cleaning resources, please wait"))
 	appname := odocontext.GetApplication(ctx)
 	isInnerLoopDeployed, resources, err := o.deleteClient.ListClusterResourcesToDeleteFromDevfile(*devfileObj, appname, componentName, labels.ComponentDevMode)
 	if err != nil {
@@ -39,6 +40,14 @@ func (o *DevClient) CleanupResources(ctx context.Context, out io.Writer) error {
 	for _, fail := range failed {
 		fmt.Fprintf(out, "Failed to delete the %q resource: %s\n", fail.GetKind(), fail.GetName())
 	}
+	if len(failed) > 0 {
+		return fmt.Errorf("unable to delete %d resources", len(failed))
+	}
 
 	return nil
 }
+
+func (o *DevClient) CleanupLeftResources(ctx context.Context, out io.Writer) error {
+	// The resources are found from the Devfile and the labels, independently of the session which created them
+	return o.CleanupResources(ctx, out)
+}
@@ -18,7 +18,9 @@ import (
 	dfutil "github.com/devfile/library/v2/pkg/util"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/dev/kubedev/resources"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev/storage"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev/utils"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
@@ -39,7 +41,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog"
-	"k8s.io/utils/pointer"
 )
 
 // createComponents creates the components into the cluster
@@ -67,7 +68,7 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 	}
 
 	klog.V(4).Infof("component state: %q\n", componentStatus.GetState())
-	err = o.buildPushAutoImageComponents(ctx, o.filesystem, parameters.Devfile, componentStatus)
+	err = o.buildPushAutoImageComponents(ctx, o.filesystem, parameters.Devfile, parameters.StartOptions, componentStatus)
 	if err != nil {
 		return false, err
 	}
@@ -125,6 +126,11 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 		return false, err
 	}
 
+	err = o.exposeEndpoints(ctx, parameters, ownerReference)
+	if err != nil {
+		return false, err
+	}
+
 	err = o.updatePVCsOwnerReferences(ctx, ownerReference)
 	if err != nil {
 		return false, err
@@ -167,8 +173,8 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 	return true, nil
 }
 
-func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, fs filesystem.Filesystem, devfileObj parser.DevfileObj, compStatus *watch.ComponentStatus) error {
-	components, err := libdevfile.GetImageComponentsToPushAutomatically(devfileObj)
+func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, fs filesystem.Filesystem, devfileObj parser.DevfileObj, options dev.StartOptions, compStatus *watch.ComponentStatus) error {
+	components, err := common.GetImageComponentsToPushOnStart(devfileObj, options)
 	if err != nil {
 		return err
 	}
@@ -249,97 +255,61 @@ func (o *DevClient) createOrUpdateComponent(
 		path          = filepath.Dir(devfilePath)
 	)
 
-	runtime := component.GetComponentRuntimeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata())
-
-	// Set the labels
-	labels := odolabels.GetLabels(componentName, appName, runtime, odolabels.ComponentDevMode, true)
-
-	annotations := make(map[string]string)
-	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata()))
-	odolabels.AddCommonAnnotations(annotations)
-	klog.V(4).Infof("We are deploying these annotations: %s", annotations)
-
-	deploymentObjectMeta, err := o.generateDeploymentObjectMeta(ctx, deployment, labels, annotations)
+	extraLabels, extraAnnotations, err := component.GetExtraMetadata(ctx, parameters.Devfile)
 	if err != nil {
 		return nil, false, err
 	}
 
-	policy, err := o.kubernetesClient.GetCurrentNamespacePolicy()
-	if err != nil {
-		return nil, false, err
-	}
-	podTemplateSpec, err := generator.GetPodTemplateSpec(parameters.Devfile, generator.PodTemplateParams{
-		ObjectMeta:                 deploymentObjectMeta,
-		PodSecurityAdmissionPolicy: policy,
-	})
-	if err != nil {
-		return nil, false, err
-	}
-	containers := podTemplateSpec.Spec.Containers
-	if len(containers) == 0 {
-		return nil, false, fmt.Errorf("no valid components found in the devfile")
-	}
-
-	initContainers := podTemplateSpec.Spec.InitContainers
-
-	containers, err = utils.UpdateContainersEntrypointsIfNeeded(parameters.Devfile, containers, commands.BuildCmd, commands.RunCmd, commands.DebugCmd)
+	// The name of the existing deployment is kept
+	deploymentObjectMeta, err := o.generateDeploymentObjectMeta(ctx, deployment, nil, nil)
 	if err != nil {
 		return nil, false, err
 	}
 
-	// Returns the volumes to add to the PodTemplate and adds volumeMounts to the containers and initContainers
-	volumes, err := o.buildVolumes(ctx, parameters, containers, initContainers)
+	policy, err := component.GetPodSecurityPolicy(ctx, o.kubernetesClient)
 	if err != nil {
 		return nil, false, err
 	}
-	podTemplateSpec.Spec.Volumes = volumes
-
-	selectorLabels := map[string]string{
-		"component": componentName,
-	}
-
-	deployParams := generator.DeploymentParams{
-		TypeMeta:          generator.GetTypeMeta(kclient.DeploymentKind, kclient.DeploymentAPIVersion),
-		ObjectMeta:        deploymentObjectMeta,
-		PodTemplateSpec:   podTemplateSpec,
-		PodSelectorLabels: selectorLabels,
-		Replicas:          pointer.Int32(1),
+	pullSecrets := parameters.StartOptions.ImagePullSecrets
+	if len(pullSecrets) == 0 {
+		pullSecrets = o.prefClient.GetImagePullSecrets()
 	}
 
 	// Save generation to check if deployment is updated later
 	var originalGeneration int64 = 0
+	var previousPodSpec *corev1.PodSpec
 	if deployment != nil {
 		originalGeneration = deployment.GetGeneration()
-	}
-
-	deployment, err = generator.GetDeployment(parameters.Devfile, deployParams)
-	if err != nil {
-		return nil, false, err
-	}
-	if deployment.Annotations == nil {
-		deployment.Annotations = make(map[string]string)
-	}
-
-	if vcsUri := util.GetGitOriginPath(path); vcsUri != "" {
-		deployment.Annotations["app.openshift.io/vcs-uri"] = vcsUri
-	}
-
-	// add the annotations to the service for linking
-	serviceAnnotations := make(map[string]string)
-	serviceAnnotations["service.binding/backend_ip"] = "path={.spec.clusterIP}"
-	serviceAnnotations["service.binding/backend_port"] = "path={.spec.ports},elementType=sliceOfMaps,sourceKey=name,sourceValue=port"
-
-	serviceName, err := util.NamespaceKubernetesObjectWithTrim(componentName, appName, 63)
-	if err != nil {
-		return nil, false, err
-	}
-	serviceObjectMeta := generator.GetObjectMeta(serviceName, o.kubernetesClient.GetCurrentNamespace(), labels, serviceAnnotations)
-	serviceParams := generator.ServiceParams{
-		ObjectMeta:     serviceObjectMeta,
-		SelectorLabels: selectorLabels,
-	}
-	svc, err := generator.GetService(parameters.Devfile, serviceParams, parsercommon.DevfileOptions{})
-
+		previousPodSpec = &deployment.Spec.Template.Spec
+	}
+
+	deployment, svc, err := resources.BuildDeploymentAndService(resources.Params{
+		Devfile:                    parameters.Devfile,
+		ComponentName:              componentName,
+		AppName:                    appName,
+		Namespace:                  deploymentObjectMeta.Namespace,
+		DeploymentName:             deploymentObjectMeta.Name,
+		Path:                       path,
+		BuildCommand:               commands.BuildCmd,
+		RunCommand:                 commands.RunCmd,
+		DebugCommand:               commands.DebugCmd,
+		Debug:                      parameters.StartOptions.Debug,
+		Profile:                    parameters.StartOptions.Profile,
+		ExtraLabels:                extraLabels,
+		ExtraAnnotations:           extraAnnotations,
+		PodSecurityAdmissionPolicy: policy,
+		ServiceAccount:             parameters.StartOptions.ServiceAccount,
+		DefaultServiceAccount:      o.prefClient.GetServiceAccount(),
+		ImagePullSecrets:           pullSecrets,
+		ForwardLocalhost:           parameters.StartOptions.ForwardLocalhost,
+		// Returns the volumes to add to the PodTemplate and adds volumeMounts to the containers and initContainers
+		BuildVolumes: func(containers, initContainers []corev1.Container) ([]corev1.Volume, error) {
+			return o.buildVolumes(ctx, parameters, containers, initContainers)
+		},
+		PodTemplateToApply: o.getPodTemplateToApply,
+		ConstraintsClient:  o.kubernetesClient,
+		PreviousPodSpec:    previousPodSpec,
+	})
 	if err != nil {
 		return nil, false, err
 	}
@@ -581,6 +551,14 @@ func (o *DevClient) pushDevfileKubernetesComponents(
 	// Set the annotations for the component type
 	annotations := make(map[string]string)
 	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata()))
+	odolabels.SetLanguage(annotations, parameters.Devfile.Data.GetMetadata().Language)
+
+	extraLabels, extraAnnotations, err := component.GetExtraMetadata(ctx, parameters.Devfile)
+	if err != nil {
+		return nil, err
+	}
+	labels = odolabels.AddExtra(labels, extraLabels)
+	annotations = odolabels.AddExtra(annotations, extraAnnotations)
 
 	// create the Kubernetes objects from the manifest and delete the ones not in the devfile
 	err = service.PushKubernetesResources(o.kubernetesClient, parameters.Devfile, k8sComponents, labels, annotations, path, mode, reference)
@@ -648,13 +626,20 @@ func (o *DevClient) buildVolumes(ctx context.Context, parameters common.PushPara
 
 	runtime := component.GetComponentRuntimeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata())
 
+	extraLabels, extraAnnotations, err := component.GetExtraMetadata(ctx, parameters.Devfile)
+	if err != nil {
+		return nil, err
+	}
+
 	storageClient := storagepkg.NewClient(componentName, appName, storagepkg.ClientOptions{
-		Client:  o.kubernetesClient,
-		Runtime: runtime,
+		Client:           o.kubernetesClient,
+		Runtime:          runtime,
+		ExtraLabels:      extraLabels,
+		ExtraAnnotations: extraAnnotations,
 	})
 
 	// Create the PVC for the project sources, if not ephemeral
-	err := storage.HandleOdoSourceStorage(o.kubernetesClient, storageClient, componentName, o.prefClient.GetEphemeralSourceVolume())
+	err = storage.HandleOdoSourceStorage(o.kubernetesClient, storageClient, componentName, o.prefClient.GetEphemeralSourceVolume())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,226 @@
+package kubedev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/util"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog"
+)
+
+// exposeEndpoints creates an OpenShift Route (or an Ingress on clusters not supporting Routes) for each public endpoint
+// of the container components, and deletes the ones created for endpoints that are not public anymore.
+// The resources are owned by the component Deployment, so they are deleted along with it when odo dev exits.
+// The URLs are displayed each time they change.
+func (o *DevClient) exposeEndpoints(ctx context.Context, parameters common.PushParameters, ownerReference metav1.OwnerReference) error {
+	if !parameters.StartOptions.Expose {
+		return nil
+	}
+
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		namespace     = o.kubernetesClient.GetCurrentNamespace()
+	)
+
+	capabilities, err := o.kubernetesClient.GetClusterCapabilities()
+	if err != nil {
+		return err
+	}
+	gvk, err := capabilities.ExposureGVK()
+	if err != nil {
+		return err
+	}
+	isRoute := gvk == kclient.RouteGVK
+	if !isRoute && parameters.StartOptions.ExposeDomain == "" {
+		return errors.New("the cluster does not support Routes, a domain must be specified with --expose-domain to create Ingresses")
+	}
+	gvr, err := o.kubernetesClient.GetGVRFromGVK(gvk)
+	if err != nil {
+		return fmt.Errorf("unable to determine GVR for %s: %w", gvk.String(), err)
+	}
+
+	serviceName, err := util.NamespaceKubernetesObjectWithTrim(componentName, appName, 63)
+	if err != nil {
+		return err
+	}
+
+	endpoints, err := getEndpointsToExpose(parameters.Devfile, parameters.StartOptions.Debug)
+	if err != nil {
+		return err
+	}
+
+	runtime := component.GetComponentRuntimeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata())
+	labels := odolabels.GetLabels(componentName, appName, runtime, odolabels.ComponentDevMode, true)
+	annotations := make(map[string]string)
+	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata()))
+	odolabels.SetLanguage(annotations, parameters.Devfile.Data.GetMetadata().Language)
+	extraLabels, extraAnnotations, err := component.GetExtraMetadata(ctx, parameters.Devfile)
+	if err != nil {
+		return err
+	}
+	labels = odolabels.AddExtra(labels, extraLabels)
+	annotations = odolabels.AddExtra(annotations, extraAnnotations)
+
+	urls := make(map[string]string, len(endpoints))
+	names := make(map[string]struct{}, len(endpoints))
+	for _, endpoint := range endpoints {
+		var name string
+		name, err = util.NamespaceKubernetesObjectWithTrim(endpoint.Name, serviceName, 63)
+		if err != nil {
+			return err
+		}
+		names[name] = struct{}{}
+
+		objectMeta := generator.GetObjectMeta(name, namespace, labels, annotations)
+		objectMeta.OwnerReferences = []metav1.OwnerReference{ownerReference}
+		secure := isSecureEndpoint(endpoint)
+
+		var (
+			resource interface{}
+			host     string
+		)
+		if isRoute {
+			resource = generator.GetRoute(endpoint, generator.RouteParams{
+				TypeMeta:   generator.GetTypeMeta(gvk.Kind, gvk.GroupVersion().String()),
+				ObjectMeta: objectMeta,
+				RouteSpecParams: generator.RouteSpecParams{
+					ServiceName: serviceName,
+					PortNumber:  intstr.FromInt(endpoint.TargetPort),
+					Path:        endpoint.Path,
+					Secure:      secure,
+				},
+			})
+		} else {
+			host = fmt.Sprintf("%s.%s", name, parameters.StartOptions.ExposeDomain)
+			ingress := generator.GetNetworkingV1Ingress(endpoint, generator.IngressParams{
+				TypeMeta:   generator.GetTypeMeta(gvk.Kind, gvk.GroupVersion().String()),
+				ObjectMeta: objectMeta,
+				IngressSpecParams: generator.IngressSpecParams{
+					ServiceName:   serviceName,
+					IngressDomain: host,
+					PortNumber:    intstr.FromInt(endpoint.TargetPort),
+					Path:          endpoint.Path,
+				},
+			})
+			if className := capabilities.IngressClassName(); className != "" {
+				ingress.Spec.IngressClassName = &className
+			}
+			resource = ingress
+		}
+
+		var u unstructured.Unstructured
+		u, err = kclient.ConvertK8sResourceToUnstructured(resource)
+		if err != nil {
+			return err
+		}
+		_, err = o.kubernetesClient.PatchDynamicResource(u)
+		if err != nil {
+			return fmt.Errorf("unable to expose endpoint %q: %w", endpoint.Name, err)
+		}
+
+		if isRoute {
+			// the host of a Route is set by the cluster when it is not specified
+			var route *unstructured.Unstructured
+			route, err = o.kubernetesClient.GetDynamicResource(gvr, name)
+			if err != nil {
+				return fmt.Errorf("unable to get the Route exposing endpoint %q: %w", endpoint.Name, err)
+			}
+			host, _, _ = unstructured.NestedString(route.Object, "spec", "host")
+		}
+		urls[endpoint.Name] = getEndpointURL(host, endpoint.Path, secure)
+	}
+
+	// Delete the resources exposing endpoints not public anymore
+	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, true)
+	list, err := o.kubernetesClient.ListDynamicResources(namespace, gvr, selector)
+	if err != nil {
+		return err
+	}
+	for _, u := range list.Items {
+		if _, ok := names[u.GetName()]; ok {
+			continue
+		}
+		klog.V(3).Infof("Deleting %s %q not exposing any endpoint", u.GetKind(), u.GetName())
+		err = o.kubernetesClient.DeleteDynamicResource(u.GetName(), gvr, false)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete %s %q: %w", u.GetKind(), u.GetName(), err)
+		}
+	}
+
+	if !reflect.DeepEqual(urls, o.exposedURLs) {
+		endpointNames := make([]string, 0, len(urls))
+		for endpointName := range urls {
+			endpointNames = append(endpointNames, endpointName)
+		}
+		sort.Strings(endpointNames)
+		for _, endpointName := range endpointNames {
+			log.Finfof(parameters.StartOptions.Out, "Endpoint %q exposed at %s", endpointName, urls[endpointName])
+		}
+		o.exposedURLs = urls
+	}
+	return nil
+}
+
+// getEndpointsToExpose returns the public HTTP and WebSocket endpoints of the container components.
+// Debug endpoints are returned only if debug is true.
+func getEndpointsToExpose(devfileObj parser.DevfileObj, debug bool) ([]devfilev1.Endpoint, error) {
+	endpoints, err := libdevfile.GetEndpointsFromDevfile(devfileObj, []devfilev1.EndpointExposure{
+		devfilev1.InternalEndpointExposure,
+		devfilev1.NoneEndpointExposure,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []devfilev1.Endpoint
+	for _, endpoint := range endpoints {
+		if !debug && libdevfile.IsDebugEndpoint(endpoint) {
+			continue
+		}
+		switch endpoint.Protocol {
+		case devfilev1.TCPEndpointProtocol, devfilev1.UDPEndpointProtocol:
+			klog.V(4).Infof("endpoint %q cannot be exposed with protocol %s", endpoint.Name, endpoint.Protocol)
+			continue
+		}
+		result = append(result, endpoint)
+	}
+	return result, nil
+}
+
+func isSecureEndpoint(endpoint devfilev1.Endpoint) bool {
+	if endpoint.Secure != nil && *endpoint.Secure {
+		return true
+	}
+	return endpoint.Protocol == devfilev1.HTTPSEndpointProtocol || endpoint.Protocol == devfilev1.WSSEndpointProtocol
+}
+
+func getEndpointURL(host string, path string, secure bool) string {
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, host, path)
+}
@@ -0,0 +1,240 @@
+package kubedev
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileParser "github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+)
+
+func getDevfileWithEndpoints(t *testing.T, endpoints []devfilev1.Endpoint) devfileParser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: devfilev1.ComponentUnion{
+				Container: &devfilev1.ContainerComponent{
+					Container: devfilev1.Container{Image: "my-image"},
+					Endpoints: endpoints,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return devfileParser.DevfileObj{Data: devfileData}
+}
+
+func TestGetEndpointsToExpose(t *testing.T) {
+	endpoints := []devfilev1.Endpoint{
+		{Name: "http", TargetPort: 8080},
+		{Name: "public", TargetPort: 8081, Exposure: devfilev1.PublicEndpointExposure},
+		{Name: "internal", TargetPort: 8082, Exposure: devfilev1.InternalEndpointExposure},
+		{Name: "none", TargetPort: 8083, Exposure: devfilev1.NoneEndpointExposure},
+		{Name: "tcp", TargetPort: 8084, Protocol: devfilev1.TCPEndpointProtocol},
+		{Name: "debug", TargetPort: 5858},
+	}
+
+	tests := []struct {
+		name  string
+		debug bool
+		want  []string
+	}{
+		{
+			name: "not in debug mode",
+			want: []string{"http", "public"},
+		},
+		{
+			name:  "in debug mode",
+			debug: true,
+			want:  []string{"http", "public", "debug"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getEndpointsToExpose(getDevfileWithEndpoints(t, endpoints), tt.debug)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var names []string
+			for _, endpoint := range got {
+				names = append(names, endpoint.Name)
+			}
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("getEndpointsToExpose() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetEndpointURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		host   string
+		path   string
+		secure bool
+		want   string
+	}{
+		{
+			name: "without path",
+			host: "my-host.example.com",
+			want: "http://my-host.example.com/",
+		},
+		{
+			name:   "secure with path",
+			host:   "my-host.example.com",
+			path:   "/api",
+			secure: true,
+			want:   "https://my-host.example.com/api",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getEndpointURL(tt.host, tt.path, tt.secure); got != tt.want {
+				t.Errorf("getEndpointURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSecureEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint devfilev1.Endpoint
+		want     bool
+	}{
+		{
+			name:     "http endpoint",
+			endpoint: devfilev1.Endpoint{Protocol: devfilev1.HTTPEndpointProtocol},
+			want:     false,
+		},
+		{
+			name:     "https endpoint",
+			endpoint: devfilev1.Endpoint{Protocol: devfilev1.HTTPSEndpointProtocol},
+			want:     true,
+		},
+		{
+			name:     "secure endpoint",
+			endpoint: devfilev1.Endpoint{Secure: pointer.Bool(true)},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSecureEndpoint(tt.endpoint); got != tt.want {
+				t.Errorf("isSecureEndpoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDevClient_exposeEndpoints(t *testing.T) {
+	ingressGVR := schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}
+	ownerReference := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "my-component-app"}
+
+	tests := []struct {
+		name         string
+		expose       bool
+		exposeDomain string
+		kubeClient   func(ctrl *gomock.Controller) kclient.ClientInterface
+		wantErr      bool
+		wantOut      string
+	}{
+		{
+			name: "not exposing endpoints",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return kclient.NewMockClientInterface(ctrl)
+			},
+		},
+		{
+			name:   "no domain on a cluster not supporting Routes",
+			expose: true,
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+				client.EXPECT().GetClusterCapabilities().Return(kclient.ClusterCapabilities{Ingresses: true}, nil)
+				return client
+			},
+			wantErr: true,
+		},
+		{
+			name:         "creating Ingresses and deleting the ones not exposing any endpoint",
+			expose:       true,
+			exposeDomain: "example.com",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+				client.EXPECT().GetClusterCapabilities().Return(kclient.ClusterCapabilities{Ingresses: true}, nil)
+				client.EXPECT().GetGVRFromGVK(kclient.IngressGVK).Return(ingressGVR, nil)
+				client.EXPECT().PatchDynamicResource(gomock.Any()).DoAndReturn(func(u unstructured.Unstructured) (bool, error) {
+					if u.GetName() != "http-my-component-app" {
+						t.Errorf("unexpected Ingress name %q", u.GetName())
+					}
+					if refs := u.GetOwnerReferences(); len(refs) != 1 || refs[0].Name != ownerReference.Name {
+						t.Errorf("unexpected owner references %v", refs)
+					}
+					rules, _, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+					if len(rules) != 1 || rules[0].(map[string]interface{})["host"] != "http-my-component-app.example.com" {
+						t.Errorf("unexpected Ingress rules %v", rules)
+					}
+					return true, nil
+				})
+				stale := unstructured.Unstructured{}
+				stale.SetKind("Ingress")
+				stale.SetName("old-my-component-app")
+				current := unstructured.Unstructured{}
+				current.SetKind("Ingress")
+				current.SetName("http-my-component-app")
+				client.EXPECT().ListDynamicResources("my-ns", ingressGVR, gomock.Any()).
+					Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{current, stale}}, nil)
+				client.EXPECT().DeleteDynamicResource("old-my-component-app", ingressGVR, false).Return(nil)
+				return client
+			},
+			wantOut: `Endpoint "http" exposed at http://http-my-component-app.example.com/`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			out := &bytes.Buffer{}
+			o := DevClient{kubernetesClient: tt.kubeClient(ctrl)}
+			ctx := context.Background()
+			ctx = odocontext.WithApplication(ctx, "app")
+			ctx = odocontext.WithComponentName(ctx, "my-component")
+			err := o.exposeEndpoints(ctx, common.PushParameters{
+				StartOptions: dev.StartOptions{
+					Expose:       tt.expose,
+					ExposeDomain: tt.exposeDomain,
+					Out:          out,
+				},
+				Devfile: getDevfileWithEndpoints(t, []devfilev1.Endpoint{{Name: "http", TargetPort: 8080}}),
+			}, ownerReference)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("exposeEndpoints() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Contains(out.Bytes(), []byte(tt.wantOut)) {
+				t.Errorf("exposeEndpoints() output = %q, want it to contain %q", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"time"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
-	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
 	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -42,7 +44,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		return fmt.Errorf("error while retrieving container from pod %s with a mounted project volume: %w", pod.GetName(), err)
 	}
 
-	s := log.Spinner("Syncing files into the container")
+	s := log.Spinner(i18n.T("Syncing files into the container"))
 	defer s.End(false)
 
 	// Get commands
@@ -58,6 +60,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		ComponentName: componentName,
 		ContainerName: containerName,
 		PodName:       pod.GetName(),
+		PodUID:        string(pod.GetUID()),
 		SyncFolder:    syncFolder,
 	}
 
@@ -78,6 +81,8 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		CompInfo:  compInfo,
 		ForcePush: !o.deploymentExists || podChanged,
 		Files:     common.GetSyncFilesFromAttributes(pushDevfileCommands[cmdKind]),
+
+		TextExtensions: parameters.StartOptions.TextExtensions,
 	}
 
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
@@ -100,10 +105,12 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 			component.GetContainersNames(pod),
 			"Executing post-start command in container",
 
-			// TODO(feloy) set these values when we want to support Apply Image/Kubernetes/OpenShift commands for PostStart commands
-			nil, nil, parser.DevfileObj{}, "",
+			o.filesystem,
+			image.SelectBackend(ctx),
+			parameters.Devfile,
+			path,
 		)
-		err = libdevfile.ExecPostStartEvents(ctx, parameters.Devfile, handler)
+		err = libdevfile.ExecPostStartEvents(ctx, parameters.Devfile, common.NewAppliedImagesHandler(handler, componentStatus.ImageComponentsAutoApplied))
 		if err != nil {
 			return err
 		}
@@ -171,17 +178,23 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 				component.GetContainersNames(pod),
 				"Building your application in container",
 
-				// TODO(feloy) set these values when we want to support Apply Image/Kubernetes/OpenShift commands for PostStart commands
-				nil, nil, parser.DevfileObj{}, "",
+				o.filesystem,
+				image.SelectBackend(ctx),
+				parameters.Devfile,
+				path,
 			)
-			return libdevfile.Build(ctx, parameters.Devfile, parameters.StartOptions.BuildCommand, execHandler)
+			return libdevfile.Build(ctx, parameters.Devfile, parameters.StartOptions.BuildCommand,
+				common.NewAppliedImagesHandler(execHandler, componentStatus.ImageComponentsAutoApplied))
 		}
-		if err = doExecuteBuildCommand(); err != nil {
+		err = doExecuteBuildCommand()
+		common.RecordBuild(ctx, o.stateClient, err)
+		if err != nil {
 			componentStatus.SetState(watch.StateReady)
 			return err
 		}
 
-		err = libdevfile.ExecuteCommandByNameAndKind(ctx, parameters.Devfile, cmdName, cmdKind, cmdHandler, false)
+		err = libdevfile.ExecuteCommandByNameAndKind(ctx, parameters.Devfile, cmdName, cmdKind,
+			common.NewAppliedImagesHandler(cmdHandler, componentStatus.ImageComponentsAutoApplied), false)
 		if err != nil {
 			return err
 		}
@@ -192,11 +205,11 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 	}
 
 	// Check that the application is actually listening on the ports declared in the Devfile, so we are sure that port-forwarding will work
-	appReadySpinner := log.Spinner("Waiting for the application to be ready")
+	appReadySpinner := log.Spinner(i18n.T("Waiting for the application to be ready"))
 	err = o.checkAppPorts(ctx, pod.Name, o.portsToForward)
 	appReadySpinner.End(err == nil)
 	if err != nil {
-		log.Warningf("Port forwarding might not work correctly: %v", err)
+		log.Warningf(i18n.T("Port forwarding might not work correctly: %v"), err)
 		log.Warning("Running `odo logs --follow` might help in identifying the problem.")
 		fmt.Fprintln(log.GetStdout())
 	}
@@ -207,10 +220,84 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 	}
 	componentStatus.EndpointsForwarded = o.portForwardClient.GetForwardedPorts()
 
+	if parameters.StartOptions.RunTests && (isComposite || !running || execRequired) {
+		o.testIterations++
+		testsHandler := component.NewRunHandler(
+			ctx,
+			o.kubernetesClient,
+			o.execClient,
+			o.configAutomountClient,
+			pod.GetName(),
+			false,
+			component.GetContainersNames(pod),
+			"Executing test command in container",
+
+			o.filesystem,
+			image.SelectBackend(ctx),
+			parameters.Devfile,
+			path,
+		)
+		// Failing tests are reported, but do not make the push fail
+		_ = common.RunTests(ctx, parameters.Devfile, parameters.StartOptions.TestCommand, testsHandler,
+			parameters.StartOptions.Out, o.stateClient, o.testIterations)
+	}
+
+	if len(componentStatus.EndpointsForwarded) == 0 {
+		// Without forwarded ports, the session is not published by the handler of the port forwarding
+		o.publishDevSession(ctx, nil)
+	}
+
+	if o.failedTemplate == nil {
+		// checkpoint of the last working state, to roll back to it if a next update makes the pod crash
+		o.workingTemplate = o.appliedTemplate
+	}
+
 	componentStatus.SetState(watch.StateReady)
 	return nil
 }
 
+// publishDevSession publishes the information about the current Dev session on the cluster,
+// so other users describing the component know that a session is running.
+// Errors are not fatal, as the session can continue without this information being published.
+func (o *DevClient) publishDevSession(ctx context.Context, forwardedPorts []api.ForwardedPort) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+	deployment, exists, err := o.getComponentDeployment(ctx)
+	if err != nil {
+		klog.V(4).Infof("unable to get deployment to publish Dev session: %v", err)
+		return
+	}
+	if !exists {
+		klog.V(4).Infof("deployment of component %q not found, Dev session is not published", componentName)
+		return
+	}
+	extraLabels, extraAnnotations, err := component.GetExtraMetadata(ctx, *odocontext.GetEffectiveDevfileObj(ctx))
+	if err != nil {
+		klog.V(4).Infof("unable to get the extra labels and annotations to publish Dev session: %v", err)
+		return
+	}
+	session := component.NewDevSession(o.startedAt, forwardedPorts)
+	err = component.SetDevSession(o.kubernetesClient, componentName, appName, session, generator.GetOwnerReference(deployment), extraLabels, extraAnnotations)
+	if err != nil {
+		klog.V(4).Infof("unable to publish Dev session: %v", err)
+	}
+}
+
+// unpublishDevSession removes the information about the current Dev session from the cluster, when the session ends.
+// Errors are not fatal, the information is removed anyway with the Deployment of the component.
+func (o *DevClient) unpublishDevSession(ctx context.Context) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+	err := component.DeleteDevSession(o.kubernetesClient, componentName, appName)
+	if err != nil {
+		klog.V(4).Infof("unable to remove Dev session information: %v", err)
+	}
+}
+
 func (o *DevClient) getPushDevfileCommands(parameters common.PushParameters) (map[devfilev1.CommandGroupKind]devfilev1.Command, error) {
 	pushDevfileCommands, err := libdevfile.ValidateAndGetPushCommands(parameters.Devfile, parameters.StartOptions.BuildCommand, parameters.StartOptions.RunCommand)
 	if err != nil {
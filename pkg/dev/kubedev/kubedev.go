@@ -3,9 +3,11 @@ package kubedev
 import (
 	"context"
 	"fmt"
+	"time"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/binding"
 	_delete "github.com/redhat-developer/odo/pkg/component/delete"
 	"github.com/redhat-developer/odo/pkg/configAutomount"
@@ -14,13 +16,19 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/watch"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/klog"
 )
 
@@ -42,6 +50,7 @@ type DevClient struct {
 	execClient            exec.Client
 	deleteClient          _delete.Client
 	configAutomountClient configAutomount.Client
+	stateClient           state.Client
 
 	// deploymentExists is true when the deployment is already created when calling createComponents
 	deploymentExists bool
@@ -49,6 +58,20 @@ type DevClient struct {
 	portsChanged bool
 	// portsToForward lists the port to forward during inner loop (TODO move port forward to createComponents)
 	portsToForward map[string][]devfilev1.Endpoint
+	// startedAt is the time at which the Dev session started
+	startedAt time.Time
+	// exposedURLs are the URLs of the endpoints exposed with --expose, by endpoint name
+	exposedURLs map[string]string
+	// testIterations is the number of executions of the test command since the start of the session
+	testIterations int
+
+	// appliedTemplate is the pod template generated from the Devfile by the last call to createOrUpdateComponent
+	appliedTemplate *corev1.PodTemplateSpec
+	// workingTemplate is the pod template generated from the Devfile the last time the component was running successfully
+	workingTemplate *corev1.PodTemplateSpec
+	// failedTemplate is the pod template the component has been rolled back from. While the Devfile generates this template,
+	// workingTemplate is applied instead.
+	failedTemplate *corev1.PodTemplateSpec
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -64,6 +87,7 @@ func NewDevClient(
 	execClient exec.Client,
 	deleteClient _delete.Client,
 	configAutomountClient configAutomount.Client,
+	stateClient state.Client,
 ) *DevClient {
 	return &DevClient{
 		kubernetesClient:      kubernetesClient,
@@ -76,6 +100,7 @@ func NewDevClient(
 		execClient:            execClient,
 		deleteClient:          deleteClient,
 		configAutomountClient: configAutomountClient,
+		stateClient:           stateClient,
 	}
 }
 
@@ -85,6 +110,8 @@ func (o *DevClient) Start(
 ) error {
 	klog.V(4).Infoln("Creating new adapter")
 
+	o.startedAt = time.Now()
+
 	var (
 		componentStatus = watch.ComponentStatus{
 			ImageComponentsAutoApplied: make(map[string]devfilev1.ImageComponent),
@@ -93,14 +120,74 @@ func (o *DevClient) Start(
 
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
 
+	// The session is published once the ports are forwarded, and published again when the port forwarding is re-established
+	o.portForwardClient.SetForwardedPortsHandler(func(ports []api.ForwardedPort) {
+		o.publishDevSession(ctx, ports)
+	})
+
 	watchParameters := watch.WatchParameters{
 		StartOptions:        options,
 		DevfileWatchHandler: o.regenerateAdapterAndPush,
 		WatchCluster:        true,
-		PromptMessage:       promptMessage,
+		PromptMessage:       i18n.T(promptMessage),
+		SuspendHandler:      o.suspend,
+		LastConnectionTime:  o.portForwardClient.GetLastConnectionTime,
+		RollbackHandler:     o.rollback,
+	}
+
+	err := o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
+	o.unpublishDevSession(ctx)
+	return err
+}
+
+// suspend scales the Deployment of the component down to zero replicas and stops the port forwarding,
+// when the session is idle. The Deployment is scaled up again by the next reconcile.
+func (o *DevClient) suspend(ctx context.Context) error {
+	componentName := odocontext.GetComponentName(ctx)
+
+	deployment, exists, err := o.getComponentDeployment(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	o.portForwardClient.StopPortForwarding(ctx, componentName)
+	err = o.stateClient.SetForwardedPorts(ctx, nil)
+	if err != nil {
+		klog.V(4).Infof("unable to reset the forwarded ports in the state file: %v", err)
+	}
+
+	return o.kubernetesClient.ScaleDeployment(deployment.GetName(), 0)
+}
+
+// rollback prepares the next reconcile to apply the pod template of the last working state of the component,
+// instead of the one generated from the Devfile, until the Devfile changes.
+// It returns false if no working state different from the current one has been recorded.
+func (o *DevClient) rollback() bool {
+	if o.workingTemplate == nil || o.appliedTemplate == nil || equality.Semantic.DeepEqual(o.workingTemplate, o.appliedTemplate) {
+		return false
 	}
+	o.failedTemplate = o.appliedTemplate
+	return true
+}
 
-	return o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
+// getPodTemplateToApply returns the pod template to apply, given the one generated from the Devfile:
+// the template of the last working state if the component has been rolled back and the Devfile has not changed since,
+// or the generated one otherwise
+func (o *DevClient) getPodTemplateToApply(generated corev1.PodTemplateSpec) corev1.PodTemplateSpec {
+	o.appliedTemplate = generated.DeepCopy()
+	if o.failedTemplate == nil {
+		return generated
+	}
+	if !equality.Semantic.DeepEqual(o.failedTemplate, o.appliedTemplate) {
+		klog.V(4).Infof("the Devfile has changed since the rollback, applying the generated pod template")
+		o.failedTemplate = nil
+		return generated
+	}
+	klog.V(4).Infof("the component has been rolled back, applying the pod template of the last working state")
+	return *o.workingTemplate.DeepCopy()
 }
 
 // RegenerateAdapterAndPush get the new devfile and pushes the files to remote pod
@@ -111,9 +198,22 @@ func (o *DevClient) regenerateAdapterAndPush(ctx context.Context, pushParams com
 		return fmt.Errorf("unable to read devfile: %w", err)
 	}
 
+	pushParams.StartOptions, err = common.ApplyProfile(devObj, pushParams.StartOptions)
+	if err != nil {
+		return err
+	}
+
+	err = common.AddEnvToRunContainers(devObj, pushParams.StartOptions)
+	if err != nil {
+		return fmt.Errorf("unable to set environment variables: %w", err)
+	}
+
 	pushParams.Devfile = devObj
 
 	err = o.reconcile(ctx, pushParams, componentStatus)
+	if warning := kclient.GetRetriedErrorsWarning(o.kubernetesClient.PopRetriedErrors()); warning != "" {
+		log.Fwarning(pushParams.StartOptions.Out, warning)
+	}
 	if err != nil {
 		return fmt.Errorf("watch command was unable to push component: %w", err)
 	}
@@ -0,0 +1,56 @@
+package kubedev
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func podTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "runtime", Image: image}},
+		},
+	}
+}
+
+func TestDevClient_rollback(t *testing.T) {
+	o := &DevClient{}
+
+	// no working state recorded yet
+	got := o.getPodTemplateToApply(podTemplate("image:1"))
+	if diff := cmp.Diff(podTemplate("image:1"), got); diff != "" {
+		t.Errorf("getPodTemplateToApply() mismatch (-want +got):\n%s", diff)
+	}
+	if o.rollback() {
+		t.Errorf("rollback() should return false when no working state is recorded")
+	}
+
+	// checkpoint done by the innerloop when the component is running
+	o.workingTemplate = o.appliedTemplate
+	if o.rollback() {
+		t.Errorf("rollback() should return false when the working state is the current one")
+	}
+
+	// the Devfile is updated and the pod crashes
+	_ = o.getPodTemplateToApply(podTemplate("image:2"))
+	if !o.rollback() {
+		t.Fatalf("rollback() should return true when a working state is recorded")
+	}
+
+	// while the Devfile is not changed, the working template is applied
+	got = o.getPodTemplateToApply(podTemplate("image:2"))
+	if diff := cmp.Diff(podTemplate("image:1"), got); diff != "" {
+		t.Errorf("getPodTemplateToApply() after rollback mismatch (-want +got):\n%s", diff)
+	}
+
+	// when the Devfile changes, the generated template is applied again
+	got = o.getPodTemplateToApply(podTemplate("image:3"))
+	if diff := cmp.Diff(podTemplate("image:3"), got); diff != "" {
+		t.Errorf("getPodTemplateToApply() after Devfile change mismatch (-want +got):\n%s", diff)
+	}
+	if o.failedTemplate != nil {
+		t.Errorf("the rollback should be cleared after the Devfile changes")
+	}
+}
@@ -13,6 +13,8 @@ import (
 	devfileParser "github.com/devfile/library/v2/pkg/devfile/parser"
 	"github.com/devfile/library/v2/pkg/devfile/parser/data"
 
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/kclient"
@@ -127,13 +129,16 @@ func TestCreateOrUpdateComponent(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			fakePrefClient := preference.NewMockClient(ctrl)
 			fakePrefClient.EXPECT().GetEphemeralSourceVolume().AnyTimes()
+			fakePrefClient.EXPECT().GetServiceAccount().AnyTimes()
+			fakePrefClient.EXPECT().GetImagePullSecrets().AnyTimes()
 			fakeConfigAutomount := configAutomount.NewMockClient(ctrl)
 			fakeConfigAutomount.EXPECT().GetAutomountingVolumes().AnyTimes()
-			client := NewDevClient(fkclient, fakePrefClient, nil, nil, nil, nil, nil, nil, nil, fakeConfigAutomount)
+			client := NewDevClient(fkclient, fakePrefClient, nil, nil, nil, nil, nil, nil, nil, fakeConfigAutomount, nil)
 			ctx := context.Background()
 			ctx = odocontext.WithApplication(ctx, "app")
 			ctx = odocontext.WithComponentName(ctx, "my-component")
 			ctx = odocontext.WithDevfilePath(ctx, "/path/to/devfile")
+			ctx = envcontext.WithEnvConfig(ctx, config.Configuration{})
 			_, _, err := client.createOrUpdateComponent(ctx, common.PushParameters{
 				Devfile: devObj,
 			}, tt.running, libdevfile.DevfileCommands{}, nil)
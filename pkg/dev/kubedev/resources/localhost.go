@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+// Resources of the side container relaying the traffic to the loopback interface
+const (
+	localhostRelayCPURequest    = "10m"
+	localhostRelayMemoryRequest = "16Mi"
+	localhostRelayCPULimit      = "100m"
+	localhostRelayMemoryLimit   = "64Mi"
+)
+
+// addLocalhostRelayContainer adds to the pod a side container relaying the traffic received on the IP of the pod
+// to the ports of the endpoints, on the loopback interface shared by all the containers of the pod.
+// This makes the endpoints reachable through the Service of the component (and the port-forwarding on runtimes
+// forwarding to the pod IP) even if the application listens only on 127.0.0.1. Only the ports listened on
+// exclusively on the loopback interface are relayed, see getLocalhostRelayScript.
+func addLocalhostRelayContainer(spec *corev1.PodSpec, devfileObj parser.DevfileObj, debug bool) error {
+	ports, err := getLocalhostRelayPorts(devfileObj, debug)
+	if err != nil {
+		return err
+	}
+	if len(ports) == 0 || len(spec.Containers) == 0 {
+		return nil
+	}
+
+	container := corev1.Container{
+		Name:    common.PortForwardingHelperContainerName,
+		Image:   common.PortForwardingHelperImage,
+		Command: []string{"/bin/sh", "-c"},
+		Args:    []string{getLocalhostRelayScript(ports)},
+		Env: []corev1.EnvVar{{
+			Name: "POD_IP",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"},
+			},
+		}},
+		// The security context complies with the restricted Pod Security level, and so with all the levels.
+		// It is not copied from the containers of the component, which can be changed with container-overrides
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+		// Resources are required by namespaces with a ResourceQuota on CPU or memory
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(localhostRelayCPURequest),
+				corev1.ResourceMemory: resource.MustParse(localhostRelayMemoryRequest),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(localhostRelayCPULimit),
+				corev1.ResourceMemory: resource.MustParse(localhostRelayMemoryLimit),
+			},
+		},
+	}
+	spec.Containers = append(spec.Containers, container)
+	return nil
+}
+
+// getLocalhostRelayPorts returns the sorted target ports of the TCP-based endpoints of the container components
+func getLocalhostRelayPorts(devfileObj parser.DevfileObj, debug bool) ([]int, error) {
+	containerComponents, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unique := make(map[int]struct{})
+	for _, endpoints := range libdevfile.GetContainerEndpointMapping(containerComponents, debug) {
+		for _, endpoint := range endpoints {
+			if endpoint.Protocol == devfilev1.UDPEndpointProtocol {
+				continue
+			}
+			unique[endpoint.TargetPort] = struct{}{}
+		}
+	}
+	ports := make([]int, 0, len(unique))
+	for p := range unique {
+		ports = append(ports, p)
+	}
+	sort.Ints(ports)
+	return ports, nil
+}
+
+// getLocalhostRelayScript returns a shell script relaying each port listened on by the application only on the loopback interface.
+// For each port, a socat process listening on the pod IP and forwarding to the same port on the loopback interface is started
+// only while a listener on 127.0.0.1 or ::1, and no listener on all the interfaces, is found in /proc/net/tcp and /proc/net/tcp6
+// (shared by all the containers of the pod), so an application listening on 0.0.0.0 can always bind its port.
+// socat is stopped as soon as the listener on the loopback interface disappears, for example when the application is restarted.
+func getLocalhostRelayScript(ports []int) string {
+	list := make([]string, 0, len(ports))
+	for _, p := range ports {
+		list = append(list, fmt.Sprint(p))
+	}
+	return fmt.Sprintf(`listening() {
+  cat /proc/net/tcp /proc/net/tcp6 2>/dev/null | awk -v a="$1" '$2 == a && $4 == "0A" { found = 1 } END { exit !found }'
+}
+for port in %s; do
+  (hex=$(printf '%%04X' ${port}); pid=""
+  while true; do
+    if { listening 0100007F:${hex} || listening 00000000000000000000000001000000:${hex}; } && ! listening 00000000:${hex} && ! listening 00000000000000000000000000000000:${hex}; then
+      if [ -z "${pid}" ] || ! kill -0 ${pid} 2>/dev/null; then
+        socat -d TCP-LISTEN:${port},bind=${POD_IP},reuseaddr,fork TCP:127.0.0.1:${port} &
+        pid=$!
+      fi
+    elif [ -n "${pid}" ]; then
+      kill ${pid} 2>/dev/null; pid=""
+    fi
+    sleep 1
+  done) &
+done
+wait
+`, strings.Join(list, " "))
+}
@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/dev/common"
+)
+
+func getDevfileWithEndpoints(t *testing.T, endpoints []devfilev1.Endpoint) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: devfilev1.ComponentUnion{
+				Container: &devfilev1.ContainerComponent{
+					Container: devfilev1.Container{Image: "my-image"},
+					Endpoints: endpoints,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{Data: devfileData}
+}
+
+func TestAddLocalhostRelayContainer(t *testing.T) {
+	endpoints := []devfilev1.Endpoint{
+		{Name: "http", TargetPort: 8080},
+		{Name: "metrics", TargetPort: 9090, Exposure: devfilev1.InternalEndpointExposure},
+		{Name: "dns", TargetPort: 5353, Protocol: devfilev1.UDPEndpointProtocol},
+		{Name: "debug", TargetPort: 5858},
+	}
+
+	tests := []struct {
+		name      string
+		debug     bool
+		endpoints []devfilev1.Endpoint
+		wantPorts string
+	}{
+		{
+			name:      "not in debug mode",
+			endpoints: endpoints,
+			wantPorts: "for port in 8080 9090; do",
+		},
+		{
+			name:      "in debug mode",
+			debug:     true,
+			endpoints: endpoints,
+			wantPorts: "for port in 5858 8080 9090; do",
+		},
+		{
+			name: "no endpoint",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "runtime"}},
+			}
+			err := addLocalhostRelayContainer(&spec, getDevfileWithEndpoints(t, tt.endpoints), tt.debug)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantPorts == "" {
+				if len(spec.Containers) != 1 {
+					t.Errorf("expected no container to be added, got %v", spec.Containers)
+				}
+				return
+			}
+			if len(spec.Containers) != 2 {
+				t.Fatalf("expected a container to be added, got %d containers", len(spec.Containers))
+			}
+			relay := spec.Containers[1]
+			if relay.Name != common.PortForwardingHelperContainerName || relay.Image != common.PortForwardingHelperImage {
+				t.Errorf("unexpected container %s with image %s", relay.Name, relay.Image)
+			}
+			if !strings.Contains(relay.Args[0], tt.wantPorts) {
+				t.Errorf("expected script to contain %q, got %q", tt.wantPorts, relay.Args[0])
+			}
+			securityContext := &corev1.SecurityContext{
+				AllowPrivilegeEscalation: pointer.Bool(false),
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			}
+			if diff := cmp.Diff(securityContext, relay.SecurityContext); diff != "" {
+				t.Errorf("security context mismatch (-want +got):\n%s", diff)
+			}
+			// the ports listened on all the interfaces by the application are not relayed
+			if !strings.Contains(relay.Args[0], "! listening 00000000:${hex}") {
+				t.Errorf("expected script to check the listeners on all the interfaces, got %q", relay.Args[0])
+			}
+			for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+				if _, found := relay.Resources.Requests[name]; !found {
+					t.Errorf("expected a %s request", name)
+				}
+				if _, found := relay.Resources.Limits[name]; !found {
+					t.Errorf("expected a %s limit", name)
+				}
+			}
+		})
+	}
+}
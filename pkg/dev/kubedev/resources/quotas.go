@@ -0,0 +1,333 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// constrainedResources are the resources of the containers constrained by the LimitRanges of the namespace
+var constrainedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// requestAdjustment is a change of the request of a container, done to fit a LimitRange
+type requestAdjustment struct {
+	container  string
+	resource   corev1.ResourceName
+	from       *resource.Quantity
+	to         resource.Quantity
+	limitRange string
+}
+
+// fitResourceConstraints adjusts the requests of the containers of the pod within the bounds of the LimitRanges of the namespace,
+// and checks that the pod fits in the ResourceQuotas of the namespace, returning an error describing all the limits exceeded.
+// previous is the pod spec of the Deployment being updated, if any, whose resources are released before the new pod is created.
+// If warningsOnly is true, the limits exceeded are reported as warnings instead.
+func fitResourceConstraints(client kclient.ClientInterface, podSpec *corev1.PodSpec, previous *corev1.PodSpec, warningsOnly bool) error {
+	limitRanges, err := client.ListLimitRanges()
+	if err != nil {
+		// The user may not be allowed to list the LimitRanges, the violations are reported by the cluster when the pod is created
+		klog.V(4).Infof("unable to list limit ranges: %v", err)
+		limitRanges = nil
+	}
+	adjustments, problems := applyLimitRanges(podSpec, limitRanges)
+	for _, a := range adjustments {
+		from := "unset"
+		if a.from != nil {
+			from = a.from.String()
+		}
+		log.Infof(i18n.T("The %s request of the container %q has been adjusted from %s to %s to fit the LimitRange %q"),
+			a.resource, a.container, from, a.to.String(), a.limitRange)
+	}
+
+	quotas, err := client.ListResourceQuotas()
+	if err != nil {
+		klog.V(4).Infof("unable to list resource quotas: %v", err)
+	} else {
+		problems = append(problems, checkResourceQuotas(*podSpec, previous, limitRanges, quotas)...)
+	}
+
+	if len(problems) > 0 && warningsOnly {
+		for _, problem := range problems {
+			log.Warningf(i18n.T("The component does not fit in the resource constraints of the namespace: %s"), problem)
+		}
+		return nil
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("the component does not fit in the resource constraints of the namespace:\n - %s", strings.Join(problems, "\n - "))
+	}
+	return nil
+}
+
+// applyLimitRanges adjusts the requests of the containers of the pod to the minimum and maximum limit/request ratio of the LimitRanges,
+// and returns the adjustments done and the constraints of the LimitRanges that cannot be fulfilled by adjusting the requests
+func applyLimitRanges(podSpec *corev1.PodSpec, limitRanges []corev1.LimitRange) (adjustments []requestAdjustment, problems []string) {
+	for _, lr := range limitRanges {
+		for _, item := range lr.Spec.Limits {
+			switch item.Type {
+			case corev1.LimitTypeContainer:
+				for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+					for i := range containers {
+						a, p := applyContainerLimitRange(&containers[i], lr.GetName(), item)
+						adjustments = append(adjustments, a...)
+						problems = append(problems, p...)
+					}
+				}
+			case corev1.LimitTypePod:
+				requests, limits := getPodResources(*podSpec, limitRanges)
+				for _, res := range constrainedResources {
+					if max, ok := item.Max[res]; ok {
+						if limit, ok := limits[res]; ok && limit.Cmp(max) > 0 {
+							problems = append(problems, fmt.Sprintf("the %s limit %s of the pod exceeds by %s the maximum %s of the LimitRange %q",
+								res, limit.String(), subtract(limit, max).String(), max.String(), lr.GetName()))
+						}
+					}
+					if min, ok := item.Min[res]; ok {
+						if request, ok := requests[res]; ok && request.Cmp(min) < 0 {
+							problems = append(problems, fmt.Sprintf("the %s request %s of the pod is lower by %s than the minimum %s of the LimitRange %q",
+								res, request.String(), subtract(min, request).String(), min.String(), lr.GetName()))
+						}
+					}
+				}
+			}
+		}
+	}
+	return adjustments, problems
+}
+
+// applyContainerLimitRange adjusts the requests of the container to an item of type Container of a LimitRange
+func applyContainerLimitRange(container *corev1.Container, limitRangeName string, item corev1.LimitRangeItem) (adjustments []requestAdjustment, problems []string) {
+	for _, res := range constrainedResources {
+		request, hasRequest := getContainerRequest(*container, res, item)
+		limit, hasLimit := getContainerLimit(*container, res, item)
+
+		if max, ok := item.Max[res]; ok && hasLimit && limit.Cmp(max) > 0 {
+			problems = append(problems, fmt.Sprintf("the %s limit %s of the container %q exceeds by %s the maximum %s of the LimitRange %q",
+				res, limit.String(), container.Name, subtract(limit, max).String(), max.String(), limitRangeName))
+			continue
+		}
+
+		wanted := request
+		if min, ok := item.Min[res]; ok {
+			if hasLimit && limit.Cmp(min) < 0 {
+				problems = append(problems, fmt.Sprintf("the %s limit %s of the container %q is lower by %s than the minimum %s of the LimitRange %q",
+					res, limit.String(), container.Name, subtract(min, limit).String(), min.String(), limitRangeName))
+				continue
+			}
+			if !hasRequest || request.Cmp(min) < 0 {
+				wanted = min
+			}
+		}
+		if ratio, ok := item.MaxLimitRequestRatio[res]; ok && hasLimit && !ratio.IsZero() {
+			if minRequest := divideRoundUp(limit, ratio, res); wanted.Cmp(minRequest) < 0 {
+				wanted = minRequest
+			}
+		}
+
+		if hasRequest && wanted.Cmp(request) == 0 || !hasRequest && wanted.IsZero() {
+			continue
+		}
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = corev1.ResourceList{}
+		}
+		container.Resources.Requests[res] = wanted
+		adjustment := requestAdjustment{
+			container:  container.Name,
+			resource:   res,
+			to:         wanted,
+			limitRange: limitRangeName,
+		}
+		if hasRequest {
+			adjustment.from = &request
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+	return adjustments, problems
+}
+
+// checkResourceQuotas returns the ResourceQuotas of the namespace exceeded by the pod
+func checkResourceQuotas(podSpec corev1.PodSpec, previous *corev1.PodSpec, limitRanges []corev1.LimitRange, quotas []corev1.ResourceQuota) []string {
+	needed, missing := getQuotaUsage(podSpec, limitRanges)
+	released := corev1.ResourceList{}
+	if previous != nil {
+		released, _ = getQuotaUsage(*previous, limitRanges)
+	}
+
+	var problems []string
+	for _, quota := range quotas {
+		names := make([]string, 0, len(quota.Status.Hard))
+		for name := range quota.Status.Hard {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			name := corev1.ResourceName(n)
+			need, ok := needed[name]
+			if !ok {
+				continue
+			}
+			if containers := missing[name]; len(containers) > 0 {
+				problems = append(problems, fmt.Sprintf("the quota %q requires %s to be defined for all the containers, it is not defined for the container(s) %s",
+					quota.GetName(), name, strings.Join(containers, ", ")))
+				continue
+			}
+			hard := quota.Status.Hard[name]
+			used := quota.Status.Used[name]
+			available := subtract(hard, used)
+			available.Add(released[name])
+			if need.Cmp(*available) <= 0 {
+				continue
+			}
+			if available.Sign() < 0 {
+				available = &resource.Quantity{Format: available.Format}
+			}
+			problems = append(problems, fmt.Sprintf("the component requires %s of %s, exceeding by %s the %s available in the quota %q (hard: %s, used: %s)",
+				need.String(), name, subtract(need, *available).String(), available.String(), quota.GetName(), hard.String(), used.String()))
+		}
+	}
+	return problems
+}
+
+// getQuotaUsage returns the resources of the pod counted by the ResourceQuotas, and the containers not defining the resources
+func getQuotaUsage(podSpec corev1.PodSpec, limitRanges []corev1.LimitRange) (usage corev1.ResourceList, missing map[corev1.ResourceName][]string) {
+	requests, limits := getPodResources(podSpec, limitRanges)
+	usage = corev1.ResourceList{
+		corev1.ResourceCPU:            requests[corev1.ResourceCPU],
+		corev1.ResourceMemory:         requests[corev1.ResourceMemory],
+		corev1.ResourceRequestsCPU:    requests[corev1.ResourceCPU],
+		corev1.ResourceRequestsMemory: requests[corev1.ResourceMemory],
+		corev1.ResourceLimitsCPU:      limits[corev1.ResourceCPU],
+		corev1.ResourceLimitsMemory:   limits[corev1.ResourceMemory],
+	}
+
+	missing = make(map[corev1.ResourceName][]string)
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for _, c := range containers {
+			for _, res := range constrainedResources {
+				if _, ok := getContainerRequestFromLimitRanges(c, res, limitRanges); !ok {
+					missing[res] = append(missing[res], c.Name)
+					missing[corev1.ResourceName("requests."+res)] = append(missing[corev1.ResourceName("requests."+res)], c.Name)
+				}
+				if _, ok := getContainerLimitFromLimitRanges(c, res, limitRanges); !ok {
+					missing[corev1.ResourceName("limits."+res)] = append(missing[corev1.ResourceName("limits."+res)], c.Name)
+				}
+			}
+		}
+	}
+	return usage, missing
+}
+
+// getPodResources returns the requests and limits of the pod, as computed by Kubernetes:
+// the maximum of the sum of the resources of the containers and of the resources of each init container
+func getPodResources(podSpec corev1.PodSpec, limitRanges []corev1.LimitRange) (requests, limits corev1.ResourceList) {
+	requests = corev1.ResourceList{}
+	limits = corev1.ResourceList{}
+	for _, res := range constrainedResources {
+		var requestsSum, limitsSum resource.Quantity
+		var hasRequest, hasLimit bool
+		for _, c := range podSpec.Containers {
+			if q, ok := getContainerRequestFromLimitRanges(c, res, limitRanges); ok {
+				requestsSum.Add(q)
+				hasRequest = true
+			}
+			if q, ok := getContainerLimitFromLimitRanges(c, res, limitRanges); ok {
+				limitsSum.Add(q)
+				hasLimit = true
+			}
+		}
+		for _, c := range podSpec.InitContainers {
+			if q, ok := getContainerRequestFromLimitRanges(c, res, limitRanges); ok && q.Cmp(requestsSum) > 0 {
+				requestsSum = q
+				hasRequest = true
+			}
+			if q, ok := getContainerLimitFromLimitRanges(c, res, limitRanges); ok && q.Cmp(limitsSum) > 0 {
+				limitsSum = q
+				hasLimit = true
+			}
+		}
+		if hasRequest {
+			requests[res] = requestsSum
+		}
+		if hasLimit {
+			limits[res] = limitsSum
+		}
+	}
+	return requests, limits
+}
+
+// getContainerRequest returns the request of the container, defaulted as done by Kubernetes:
+// to the limit of the container if defined, or to the default request of the LimitRange
+func getContainerRequest(container corev1.Container, res corev1.ResourceName, item corev1.LimitRangeItem) (resource.Quantity, bool) {
+	if q, ok := container.Resources.Requests[res]; ok {
+		return q, true
+	}
+	if q, ok := container.Resources.Limits[res]; ok {
+		return q, true
+	}
+	q, ok := item.DefaultRequest[res]
+	return q, ok
+}
+
+// getContainerLimit returns the limit of the container, defaulted to the default limit of the LimitRange
+func getContainerLimit(container corev1.Container, res corev1.ResourceName, item corev1.LimitRangeItem) (resource.Quantity, bool) {
+	if q, ok := container.Resources.Limits[res]; ok {
+		return q, true
+	}
+	q, ok := item.Default[res]
+	return q, ok
+}
+
+// getContainerRequestFromLimitRanges returns the request of the container, defaulted by the first LimitRange defining a default
+func getContainerRequestFromLimitRanges(container corev1.Container, res corev1.ResourceName, limitRanges []corev1.LimitRange) (resource.Quantity, bool) {
+	for _, item := range getContainerLimitRangeItems(limitRanges) {
+		if q, ok := getContainerRequest(container, res, item); ok {
+			return q, true
+		}
+	}
+	return getContainerRequest(container, res, corev1.LimitRangeItem{})
+}
+
+// getContainerLimitFromLimitRanges returns the limit of the container, defaulted by the first LimitRange defining a default
+func getContainerLimitFromLimitRanges(container corev1.Container, res corev1.ResourceName, limitRanges []corev1.LimitRange) (resource.Quantity, bool) {
+	for _, item := range getContainerLimitRangeItems(limitRanges) {
+		if q, ok := getContainerLimit(container, res, item); ok {
+			return q, true
+		}
+	}
+	return getContainerLimit(container, res, corev1.LimitRangeItem{})
+}
+
+// getContainerLimitRangeItems returns the items of type Container of the LimitRanges
+func getContainerLimitRangeItems(limitRanges []corev1.LimitRange) []corev1.LimitRangeItem {
+	var items []corev1.LimitRangeItem
+	for _, lr := range limitRanges {
+		for _, item := range lr.Spec.Limits {
+			if item.Type == corev1.LimitTypeContainer {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// subtract returns a - b
+func subtract(a, b resource.Quantity) *resource.Quantity {
+	result := a.DeepCopy()
+	result.Sub(b)
+	return &result
+}
+
+// divideRoundUp returns a / b, rounded up to the millicore for CPU and to the byte for the other resources
+func divideRoundUp(a, b resource.Quantity, res corev1.ResourceName) resource.Quantity {
+	if res == corev1.ResourceCPU {
+		return *resource.NewMilliQuantity((a.MilliValue()*1000+b.MilliValue()-1)/b.MilliValue(), a.Format)
+	}
+	return *resource.NewQuantity((a.Value()*1000+b.MilliValue()-1)/b.MilliValue(), a.Format)
+}
@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func container(name string, requests, limits corev1.ResourceList) corev1.Container {
+	return corev1.Container{
+		Name: name,
+		Resources: corev1.ResourceRequirements{
+			Requests: requests,
+			Limits:   limits,
+		},
+	}
+}
+
+func limitRange(name string, items ...corev1.LimitRangeItem) corev1.LimitRange {
+	return corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.LimitRangeSpec{Limits: items},
+	}
+}
+
+func resources(cpu, memory string) corev1.ResourceList {
+	list := corev1.ResourceList{}
+	if cpu != "" {
+		list[corev1.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if memory != "" {
+		list[corev1.ResourceMemory] = resource.MustParse(memory)
+	}
+	return list
+}
+
+func Test_applyLimitRanges(t *testing.T) {
+	tests := []struct {
+		name         string
+		containers   []corev1.Container
+		limitRanges  []corev1.LimitRange
+		wantRequests []corev1.ResourceList
+		wantProblems []string
+	}{
+		{
+			name:         "no limit range",
+			containers:   []corev1.Container{container("runtime", resources("100m", "64Mi"), nil)},
+			wantRequests: []corev1.ResourceList{resources("100m", "64Mi")},
+		},
+		{
+			name:       "requests raised to the minimum",
+			containers: []corev1.Container{container("runtime", resources("100m", "64Mi"), resources("1", "1Gi"))},
+			limitRanges: []corev1.LimitRange{limitRange("limits", corev1.LimitRangeItem{
+				Type: corev1.LimitTypeContainer,
+				Min:  resources("200m", "128Mi"),
+			})},
+			wantRequests: []corev1.ResourceList{resources("200m", "128Mi")},
+		},
+		{
+			name:       "request raised to fit the maximum limit/request ratio",
+			containers: []corev1.Container{container("runtime", resources("100m", "256Mi"), resources("1", "1Gi"))},
+			limitRanges: []corev1.LimitRange{limitRange("limits", corev1.LimitRangeItem{
+				Type:                 corev1.LimitTypeContainer,
+				MaxLimitRequestRatio: resources("4", "4"),
+			})},
+			wantRequests: []corev1.ResourceList{resources("250m", "256Mi")},
+		},
+		{
+			name:       "limit exceeding the maximum",
+			containers: []corev1.Container{container("runtime", resources("100m", "64Mi"), resources("500m", "2Gi"))},
+			limitRanges: []corev1.LimitRange{limitRange("limits", corev1.LimitRangeItem{
+				Type: corev1.LimitTypeContainer,
+				Max:  resources("1", "1536Mi"),
+			})},
+			wantRequests: []corev1.ResourceList{resources("100m", "64Mi")},
+			wantProblems: []string{`the memory limit 2Gi of the container "runtime" exceeds by 512Mi the maximum 1536Mi of the LimitRange "limits"`},
+		},
+		{
+			name:       "limit lower than the minimum",
+			containers: []corev1.Container{container("runtime", nil, resources("50m", ""))},
+			limitRanges: []corev1.LimitRange{limitRange("limits", corev1.LimitRangeItem{
+				Type: corev1.LimitTypeContainer,
+				Min:  resources("100m", ""),
+			})},
+			wantRequests: []corev1.ResourceList{nil},
+			wantProblems: []string{`the cpu limit 50m of the container "runtime" is lower by 50m than the minimum 100m of the LimitRange "limits"`},
+		},
+		{
+			name: "sum of the limits of the containers exceeding the maximum of the pod",
+			containers: []corev1.Container{
+				container("runtime", nil, resources("", "1Gi")),
+				container("tools", nil, resources("", "1Gi")),
+			},
+			limitRanges: []corev1.LimitRange{limitRange("limits", corev1.LimitRangeItem{
+				Type: corev1.LimitTypePod,
+				Max:  resources("", "1536Mi"),
+			})},
+			wantRequests: []corev1.ResourceList{nil, nil},
+			wantProblems: []string{`the memory limit 2Gi of the pod exceeds by 512Mi the maximum 1536Mi of the LimitRange "limits"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podSpec := corev1.PodSpec{Containers: tt.containers}
+			_, problems := applyLimitRanges(&podSpec, tt.limitRanges)
+			if diff := cmp.Diff(tt.wantProblems, problems); diff != "" {
+				t.Errorf("applyLimitRanges() problems mismatch (-want +got):\n%s", diff)
+			}
+			for i, c := range podSpec.Containers {
+				if diff := cmp.Diff(tt.wantRequests[i], c.Resources.Requests, cmp.Comparer(func(a, b resource.Quantity) bool {
+					return a.Cmp(b) == 0
+				})); diff != "" {
+					t.Errorf("applyLimitRanges() requests of container %q mismatch (-want +got):\n%s", c.Name, diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_checkResourceQuotas(t *testing.T) {
+	quota := func(hard, used corev1.ResourceList) corev1.ResourceQuota {
+		return corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+			Status:     corev1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}
+	}
+	tests := []struct {
+		name         string
+		podSpec      corev1.PodSpec
+		previous     *corev1.PodSpec
+		limitRanges  []corev1.LimitRange
+		quotas       []corev1.ResourceQuota
+		wantProblems []string
+	}{
+		{
+			name: "fits in the quota",
+			podSpec: corev1.PodSpec{Containers: []corev1.Container{
+				container("runtime", resources("", "512Mi"), nil),
+			}},
+			quotas: []corev1.ResourceQuota{quota(
+				corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("4Gi")},
+				corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("2Gi")},
+			)},
+		},
+		{
+			name: "exceeds the quota",
+			podSpec: corev1.PodSpec{Containers: []corev1.Container{
+				container("runtime", resources("", "1Gi"), nil),
+				container("tools", resources("", "1Gi"), nil),
+			}},
+			quotas: []corev1.ResourceQuota{quota(
+				corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("4Gi")},
+				corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("2560Mi")},
+			)},
+			wantProblems: []string{`the component requires 2Gi of requests.memory, exceeding by 512Mi the 1536Mi available in the quota "quota" (hard: 4Gi, used: 2560Mi)`},
+		},
+		{
+			name: "fits in the quota when the resources of the previous pod are released",
+			podSpec: corev1.PodSpec{Containers: []corev1.Container{
+				container("runtime", resources("", "2Gi"), nil),
+			}},
+			previous: &corev1.PodSpec{Containers: []corev1.Container{
+				container("runtime", resources("", "1Gi"), nil),
+			}},
+			quotas: []corev1.ResourceQuota{quota(
+				corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("4Gi")},
+				corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("3Gi")},
+			)},
+		},
+		{
+			name: "limits required by the quota",
+			podSpec: corev1.PodSpec{Containers: []corev1.Container{
+				container("runtime", nil, resources("1", "")),
+				container("tools", nil, nil),
+			}},
+			quotas: []corev1.ResourceQuota{quota(
+				corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("4")},
+				corev1.ResourceList{},
+			)},
+			wantProblems: []string{`the quota "quota" requires limits.cpu to be defined for all the containers, it is not defined for the container(s) tools`},
+		},
+		{
+			name: "limits defaulted by a limit range",
+			podSpec: corev1.PodSpec{Containers: []corev1.Container{
+				container("runtime", nil, nil),
+			}},
+			limitRanges: []corev1.LimitRange{limitRange("limits", corev1.LimitRangeItem{
+				Type:    corev1.LimitTypeContainer,
+				Default: resources("500m", ""),
+			})},
+			quotas: []corev1.ResourceQuota{quota(
+				corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("1")},
+				corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("800m")},
+			)},
+			wantProblems: []string{`the component requires 500m of limits.cpu, exceeding by 300m the 200m available in the quota "quota" (hard: 1, used: 800m)`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := checkResourceQuotas(tt.podSpec, tt.previous, tt.limitRanges, tt.quotas)
+			if diff := cmp.Diff(tt.wantProblems, problems); diff != "" {
+				t.Errorf("checkResourceQuotas() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_divideRoundUp(t *testing.T) {
+	got := divideRoundUp(resource.MustParse("1"), resource.MustParse("3"), corev1.ResourceCPU)
+	if want := resource.MustParse("334m"); got.Cmp(want) != 0 {
+		t.Errorf("divideRoundUp() = %s, want %s", got.String(), want.String())
+	}
+	if s := got.String(); !strings.HasSuffix(s, "m") {
+		t.Errorf("divideRoundUp() = %s, should be expressed in millicores", s)
+	}
+}
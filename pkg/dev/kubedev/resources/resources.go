@@ -0,0 +1,201 @@
+// Package resources builds the Deployment and Service running a component in the Dev mode on a cluster,
+// for odo dev and for the export of the Dev mode resources
+package resources
+
+import (
+	"fmt"
+
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+	psaapi "k8s.io/pod-security-admission/api"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/dev/kubedev/utils"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// Params are the parameters used to build the Deployment and Service of a component in the Dev mode
+type Params struct {
+	Devfile       parser.DevfileObj
+	ComponentName string
+	AppName       string
+	// Namespace of the resources, empty to build them without namespace
+	Namespace string
+	// DeploymentName is the name of the existing Deployment of the component, if any
+	DeploymentName string
+	// Path is the directory containing the Devfile
+	Path string
+
+	// BuildCommand, RunCommand and DebugCommand are the commands to execute, the default ones are used if empty
+	BuildCommand string
+	RunCommand   string
+	DebugCommand string
+	Debug        bool
+	Profile      string
+
+	ExtraLabels      map[string]string
+	ExtraAnnotations map[string]string
+
+	// PodSecurityAdmissionPolicy is the Pod Security policy the containers must comply with
+	PodSecurityAdmissionPolicy psaapi.Policy
+	// ServiceAccount is the service account of the pod set by the user, taking precedence over the pod-overrides
+	ServiceAccount string
+	// DefaultServiceAccount is the service account of the pod used when neither ServiceAccount nor the pod-overrides set one
+	DefaultServiceAccount string
+	ImagePullSecrets      []string
+	// ForwardLocalhost adds a side container relaying the traffic to the endpoints listening on the loopback interface
+	ForwardLocalhost bool
+
+	// BuildVolumes returns the volumes of the pod, and adds the volume mounts to the containers and init containers
+	BuildVolumes func(containers, initContainers []corev1.Container) ([]corev1.Volume, error)
+	// PodTemplateToApply, if not nil, returns the pod template to apply, given the one built from the Devfile
+	PodTemplateToApply func(generated corev1.PodTemplateSpec) corev1.PodTemplateSpec
+
+	// ConstraintsClient, if not nil, is used to fit the pod in the LimitRanges and ResourceQuotas of the namespace
+	ConstraintsClient kclient.ClientInterface
+	// ConstraintsWarningsOnly reports the constraints not fulfilled as warnings instead of an error
+	ConstraintsWarningsOnly bool
+	// PreviousPodSpec is the pod spec of the Deployment being updated, if any
+	PreviousPodSpec *corev1.PodSpec
+}
+
+// BuildDeploymentAndService returns the Deployment running the containers of the Devfile, and the Service exposing their endpoints.
+// The Service has no port if no endpoint is defined.
+func BuildDeploymentAndService(params Params) (*appsv1.Deployment, *corev1.Service, error) {
+	metadata := params.Devfile.Data.GetMetadata()
+	runtime := component.GetComponentRuntimeFromDevfileMetadata(metadata)
+
+	labels := odolabels.GetLabels(params.ComponentName, params.AppName, runtime, odolabels.ComponentDevMode, true)
+	labels = odolabels.AddExtra(labels, params.ExtraLabels)
+
+	annotations := make(map[string]string)
+	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(metadata))
+	odolabels.SetLanguage(annotations, metadata.Language)
+	odolabels.AddCommonAnnotations(annotations)
+	odolabels.SetProfile(annotations, params.Profile)
+	annotations = odolabels.AddExtra(annotations, params.ExtraAnnotations)
+	klog.V(4).Infof("We are deploying these annotations: %s", annotations)
+
+	deploymentName := params.DeploymentName
+	if deploymentName == "" {
+		var err error
+		deploymentName, err = util.NamespaceKubernetesObject(params.ComponentName, params.AppName)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	deploymentObjectMeta := generator.GetObjectMeta(deploymentName, params.Namespace, labels, annotations)
+
+	podTemplateSpec, err := generator.GetPodTemplateSpec(params.Devfile, generator.PodTemplateParams{
+		ObjectMeta:                 deploymentObjectMeta,
+		PodSecurityAdmissionPolicy: params.PodSecurityAdmissionPolicy,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	setServiceAccountAndPullSecrets(&podTemplateSpec.Spec, params.ServiceAccount, params.DefaultServiceAccount, params.ImagePullSecrets)
+
+	containers := podTemplateSpec.Spec.Containers
+	if len(containers) == 0 {
+		return nil, nil, fmt.Errorf("no valid components found in the devfile")
+	}
+	initContainers := podTemplateSpec.Spec.InitContainers
+
+	containers, err = utils.UpdateContainersEntrypointsIfNeeded(params.Devfile, containers, params.BuildCommand, params.RunCommand, params.DebugCommand)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	podTemplateSpec.Spec.Volumes, err = params.BuildVolumes(containers, initContainers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if params.ForwardLocalhost {
+		err = addLocalhostRelayContainer(&podTemplateSpec.Spec, params.Devfile, params.Debug)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	selectorLabels := map[string]string{
+		"component": params.ComponentName,
+	}
+
+	deployment, err := generator.GetDeployment(params.Devfile, generator.DeploymentParams{
+		TypeMeta:          generator.GetTypeMeta(kclient.DeploymentKind, kclient.DeploymentAPIVersion),
+		ObjectMeta:        deploymentObjectMeta,
+		PodTemplateSpec:   podTemplateSpec,
+		PodSelectorLabels: selectorLabels,
+		Replicas:          pointer.Int32(1),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if params.PodTemplateToApply != nil {
+		deployment.Spec.Template = params.PodTemplateToApply(deployment.Spec.Template)
+	}
+	if params.ConstraintsClient != nil {
+		err = fitResourceConstraints(params.ConstraintsClient, &deployment.Spec.Template.Spec, params.PreviousPodSpec, params.ConstraintsWarningsOnly)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	if vcsUri := util.GetGitOriginPath(params.Path); vcsUri != "" {
+		deployment.Annotations["app.openshift.io/vcs-uri"] = vcsUri
+	}
+
+	// add the annotations to the service for linking
+	serviceAnnotations := make(map[string]string)
+	serviceAnnotations["service.binding/backend_ip"] = "path={.spec.clusterIP}"
+	serviceAnnotations["service.binding/backend_port"] = "path={.spec.ports},elementType=sliceOfMaps,sourceKey=name,sourceValue=port"
+	serviceAnnotations = odolabels.AddExtra(serviceAnnotations, params.ExtraAnnotations)
+
+	serviceName, err := util.NamespaceKubernetesObjectWithTrim(params.ComponentName, params.AppName, 63)
+	if err != nil {
+		return nil, nil, err
+	}
+	svc, err := generator.GetService(params.Devfile, generator.ServiceParams{
+		TypeMeta:       generator.GetTypeMeta("Service", "v1"),
+		ObjectMeta:     generator.GetObjectMeta(serviceName, params.Namespace, labels, serviceAnnotations),
+		SelectorLabels: selectorLabels,
+	}, parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return deployment, svc, nil
+}
+
+// setServiceAccountAndPullSecrets sets the service account of the pod, if not empty, or else the default service account
+// if the pod has no service account yet (for example set with the pod-overrides attribute),
+// and adds the image pull secrets not already defined
+func setServiceAccountAndPullSecrets(spec *corev1.PodSpec, serviceAccount string, defaultServiceAccount string, pullSecrets []string) {
+	switch {
+	case serviceAccount != "":
+		spec.ServiceAccountName = serviceAccount
+	case spec.ServiceAccountName == "":
+		spec.ServiceAccountName = defaultServiceAccount
+	}
+	for _, name := range pullSecrets {
+		found := false
+		for _, ref := range spec.ImagePullSecrets {
+			if ref.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			spec.ImagePullSecrets = append(spec.ImagePullSecrets, corev1.LocalObjectReference{Name: name})
+		}
+	}
+}
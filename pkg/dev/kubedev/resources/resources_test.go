@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	psaapi "k8s.io/pod-security-admission/api"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+)
+
+func TestSetServiceAccountAndPullSecrets(t *testing.T) {
+	tests := []struct {
+		name                  string
+		spec                  corev1.PodSpec
+		serviceAccount        string
+		defaultServiceAccount string
+		pullSecrets           []string
+		want                  corev1.PodSpec
+	}{
+		{
+			name: "nothing to set",
+		},
+		{
+			name:           "service account and pull secrets",
+			serviceAccount: "my-sa",
+			pullSecrets:    []string{"secret1", "secret2"},
+			want: corev1.PodSpec{
+				ServiceAccountName: "my-sa",
+				ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "secret1"}, {Name: "secret2"}},
+			},
+		},
+		{
+			name:                  "default service account",
+			defaultServiceAccount: "pref-sa",
+			want: corev1.PodSpec{
+				ServiceAccountName: "pref-sa",
+			},
+		},
+		{
+			name:                  "service account takes precedence over the default one",
+			serviceAccount:        "my-sa",
+			defaultServiceAccount: "pref-sa",
+			want: corev1.PodSpec{
+				ServiceAccountName: "my-sa",
+			},
+		},
+		{
+			name: "service account defined by pod-overrides takes precedence over the default one",
+			spec: corev1.PodSpec{
+				ServiceAccountName: "overridden-sa",
+			},
+			defaultServiceAccount: "pref-sa",
+			want: corev1.PodSpec{
+				ServiceAccountName: "overridden-sa",
+			},
+		},
+		{
+			name: "pull secrets already defined by pod-overrides",
+			spec: corev1.PodSpec{
+				ServiceAccountName: "overridden-sa",
+				ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "secret1"}},
+			},
+			pullSecrets: []string{"secret1", "secret2"},
+			want: corev1.PodSpec{
+				ServiceAccountName: "overridden-sa",
+				ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "secret1"}, {Name: "secret2"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			setServiceAccountAndPullSecrets(&spec, tt.serviceAccount, tt.defaultServiceAccount, tt.pullSecrets)
+			if diff := cmp.Diff(tt.want, spec); diff != "" {
+				t.Errorf("setServiceAccountAndPullSecrets() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+const overridesDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+attributes:
+  pod-overrides:
+    spec:
+      nodeSelector:
+        disk: ssd
+      serviceAccountName: devfile-sa
+components:
+- name: runtime
+  attributes:
+    pod-overrides:
+      spec:
+        nodeSelector:
+          disk: hdd
+          zone: a
+        serviceAccountName: component-sa
+        imagePullSecrets:
+        - name: component-secret
+        tolerations:
+        - key: dedicated
+          operator: Exists
+    container-overrides:
+      securityContext:
+        allowPrivilegeEscalation: true
+      resources:
+        limits:
+          memory: 512Mi
+  container:
+    image: my-image
+    memoryLimit: 1Gi
+    endpoints:
+    - name: http
+      targetPort: 3000
+`
+
+func TestBuildDeploymentAndService_OverridesPrecedence(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	if err := os.WriteFile(devfilePath, []byte(overridesDevfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restricted := psaapi.Policy{
+		Enforce: psaapi.LevelVersion{Level: psaapi.LevelRestricted, Version: psaapi.LatestVersion()},
+	}
+
+	tests := []struct {
+		name                  string
+		serviceAccount        string
+		defaultServiceAccount string
+		pullSecrets           []string
+		forwardLocalhost      bool
+		wantServiceAccount    string
+		wantPullSecrets       []corev1.LocalObjectReference
+	}{
+		{
+			name:               "pod-overrides of the Devfile take precedence over the ones of the components",
+			wantServiceAccount: "devfile-sa",
+			wantPullSecrets:    []corev1.LocalObjectReference{{Name: "component-secret"}},
+		},
+		{
+			name:                  "pod-overrides take precedence over the ServiceAccount preference",
+			defaultServiceAccount: "pref-sa",
+			wantServiceAccount:    "devfile-sa",
+			wantPullSecrets:       []corev1.LocalObjectReference{{Name: "component-secret"}},
+		},
+		{
+			name:                  "service account and pull secrets set by odo take precedence over pod-overrides",
+			serviceAccount:        "flag-sa",
+			defaultServiceAccount: "pref-sa",
+			pullSecrets:           []string{"flag-secret"},
+			wantServiceAccount:    "flag-sa",
+			wantPullSecrets:       []corev1.LocalObjectReference{{Name: "component-secret"}, {Name: "flag-secret"}},
+		},
+		{
+			name:               "overrides with the localhost relay container",
+			forwardLocalhost:   true,
+			wantServiceAccount: "devfile-sa",
+			wantPullSecrets:    []corev1.LocalObjectReference{{Name: "component-secret"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment, _, err := BuildDeploymentAndService(Params{
+				Devfile:                    devObj,
+				ComponentName:              "my-component",
+				AppName:                    "app",
+				PodSecurityAdmissionPolicy: restricted,
+				ServiceAccount:             tt.serviceAccount,
+				DefaultServiceAccount:      tt.defaultServiceAccount,
+				ImagePullSecrets:           tt.pullSecrets,
+				ForwardLocalhost:           tt.forwardLocalhost,
+				BuildVolumes: func(containers, initContainers []corev1.Container) ([]corev1.Volume, error) {
+					return nil, nil
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			spec := deployment.Spec.Template.Spec
+
+			// the pod security context generated for the Pod Security level is kept
+			if sc := spec.SecurityContext; sc == nil || sc.RunAsNonRoot == nil || !*sc.RunAsNonRoot || sc.SeccompProfile == nil {
+				t.Errorf("expected the pod security context of the restricted level, got %+v", spec.SecurityContext)
+			}
+			// strategic merge of the pod-overrides, in the order of the components, then of the Devfile
+			if diff := cmp.Diff(map[string]string{"disk": "ssd", "zone": "a"}, spec.NodeSelector); diff != "" {
+				t.Errorf("nodeSelector mismatch (-want +got):\n%s", diff)
+			}
+			if len(spec.Tolerations) != 1 || spec.Tolerations[0].Key != "dedicated" {
+				t.Errorf("expected the tolerations of the component pod-overrides, got %v", spec.Tolerations)
+			}
+			if spec.ServiceAccountName != tt.wantServiceAccount {
+				t.Errorf("expected service account %q, got %q", tt.wantServiceAccount, spec.ServiceAccountName)
+			}
+			if diff := cmp.Diff(tt.wantPullSecrets, spec.ImagePullSecrets); diff != "" {
+				t.Errorf("image pull secrets mismatch (-want +got):\n%s", diff)
+			}
+
+			container := spec.Containers[0]
+			// container-overrides take precedence over the security context generated for the Pod Security level
+			if sc := container.SecurityContext; sc == nil || sc.AllowPrivilegeEscalation == nil || !*sc.AllowPrivilegeEscalation {
+				t.Errorf("expected allowPrivilegeEscalation from container-overrides, got %+v", container.SecurityContext)
+			}
+			// container-overrides take precedence over the fields of the container component
+			if got := container.Resources.Limits[corev1.ResourceMemory]; got.Cmp(resource.MustParse("512Mi")) != 0 {
+				t.Errorf("expected memory limit 512Mi from container-overrides, got %s", got.String())
+			}
+
+			wantContainers := 1
+			if tt.forwardLocalhost {
+				wantContainers = 2
+			}
+			if len(spec.Containers) != wantContainers {
+				t.Fatalf("expected %d containers, got %d", wantContainers, len(spec.Containers))
+			}
+			if tt.forwardLocalhost {
+				// the container-overrides of the components do not apply to the relay container,
+				// which keeps complying with the Pod Security level
+				relay := spec.Containers[1]
+				if sc := relay.SecurityContext; sc == nil || sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+					t.Errorf("expected the relay container to disallow privilege escalation, got %+v", relay.SecurityContext)
+				}
+				if got := relay.Resources.Limits[corev1.ResourceMemory]; got.Cmp(resource.MustParse(localhostRelayMemoryLimit)) != 0 {
+					t.Errorf("expected memory limit %s for the relay container, got %s", localhostRelayMemoryLimit, got.String())
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package kubedev
 
 import (
 	"context"
+	"io"
 
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"k8s.io/klog"
@@ -21,3 +22,20 @@ func (o *DevClient) Run(
 		o.filesystem,
 	)
 }
+
+func (o *DevClient) Test(
+	ctx context.Context,
+	commandName string,
+	out io.Writer,
+) error {
+	klog.V(4).Infof("running test command %q on cluster", commandName)
+	return common.Test(
+		ctx,
+		commandName,
+		o.kubernetesClient,
+		o.execClient,
+		o.configAutomountClient,
+		o.filesystem,
+		out,
+	)
+}
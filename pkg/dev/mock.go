@@ -35,6 +35,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CleanupLeftResources mocks base method.
+func (m *MockClient) CleanupLeftResources(ctx context.Context, out io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupLeftResources", ctx, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanupLeftResources indicates an expected call of CleanupLeftResources.
+func (mr *MockClientMockRecorder) CleanupLeftResources(ctx, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupLeftResources", reflect.TypeOf((*MockClient)(nil).CleanupLeftResources), ctx, out)
+}
+
 // CleanupResources mocks base method.
 func (m *MockClient) CleanupResources(ctx context.Context, out io.Writer) error {
 	m.ctrl.T.Helper()
@@ -76,3 +90,17 @@ func (mr *MockClientMockRecorder) Start(ctx, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockClient)(nil).Start), ctx, options)
 }
+
+// Test mocks base method.
+func (m *MockClient) Test(ctx context.Context, commandName string, out io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Test", ctx, commandName, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Test indicates an expected call of Test.
+func (mr *MockClientMockRecorder) Test(ctx, commandName, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Test", reflect.TypeOf((*MockClient)(nil).Test), ctx, commandName, out)
+}
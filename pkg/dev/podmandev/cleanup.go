@@ -4,12 +4,41 @@ import (
 	"context"
 	"fmt"
 	"io"
+
+	"github.com/redhat-developer/odo/pkg/i18n"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
 func (o *DevClient) CleanupResources(ctx context.Context, out io.Writer) error {
-	fmt.Printf("Cleaning up resources\n")
+	fmt.Fprintln(out, i18n.T("Cleaning up resources"))
 	if o.deployedPod == nil {
 		return nil
 	}
 	return o.podmanClient.CleanupPodResources(o.deployedPod, true)
 }
+
+func (o *DevClient) CleanupLeftResources(ctx context.Context, out io.Writer) error {
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+	)
+	name, err := util.NamespaceKubernetesObject(componentName, appName)
+	if err != nil {
+		return err
+	}
+	pods, err := o.podmanClient.PodLs()
+	if err != nil {
+		return err
+	}
+	if !pods[name] {
+		return nil
+	}
+	fmt.Fprintln(out, i18n.T("Cleaning up resources"))
+	// The volumes of the pod are needed to delete them
+	pod, err := o.podmanClient.KubeGenerate(name)
+	if err != nil {
+		return err
+	}
+	return o.podmanClient.CleanupPodResources(pod, true)
+}
@@ -0,0 +1,59 @@
+package podmandev
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+)
+
+// hostPathAttribute is the attribute of a Volume component indicating to bind-mount a directory of the local filesystem
+// instead of creating a volume. The path is relative to the directory of the Devfile, if not absolute.
+const hostPathAttribute = "odo.dev/podman-host-path"
+
+// getHostPaths returns the absolute paths on the local filesystem of the Volume components
+// defining the hostPathAttribute attribute, indexed by the names of the components
+func getHostPaths(devfileObj parser.DevfileObj, componentDir string) (map[string]string, error) {
+	volumes, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.VolumeComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, volume := range volumes {
+		if !volume.Attributes.Exists(hostPathAttribute) {
+			continue
+		}
+		var path string
+		err = volume.Attributes.GetInto(hostPathAttribute, &path)
+		if err != nil || path == "" {
+			return nil, fmt.Errorf("invalid %q attribute on volume %q: a non-empty path is expected", hostPathAttribute, volume.Name)
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(componentDir, path)
+		}
+		result[volume.Name] = filepath.Clean(path)
+	}
+	return result, nil
+}
+
+// getHostPathsIgnores returns the paths, relative to componentDir, of the host paths located inside componentDir.
+// These paths are bind-mounted into the containers, and do not need to be synced nor watched.
+func getHostPathsIgnores(hostPaths map[string]string, componentDir string) []string {
+	var result []string
+	for _, path := range hostPaths {
+		rel, err := filepath.Rel(componentDir, path)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		result = append(result, filepath.ToSlash(rel))
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,83 @@
+package podmandev
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+)
+
+func Test_getHostPaths(t *testing.T) {
+	withHostPath := func(name, path string) v1alpha2.Component {
+		vol := generator.GetVolumeComponent(generator.VolumeComponentParams{Name: name})
+		vol.Attributes = attributes.Attributes{}.PutString(hostPathAttribute, path)
+		return vol
+	}
+
+	tests := []struct {
+		name        string
+		components  []v1alpha2.Component
+		want        map[string]string
+		wantIgnores []string
+		wantErr     bool
+	}{
+		{
+			name: "no host path",
+			components: []v1alpha2.Component{
+				generator.GetVolumeComponent(generator.VolumeComponentParams{Name: "cache"}),
+			},
+		},
+		{
+			name: "relative and absolute host paths",
+			components: []v1alpha2.Component{
+				withHostPath("sources", "./src/app"),
+				withHostPath("data", "/var/data"),
+				withHostPath("parent", "../shared"),
+				generator.GetVolumeComponent(generator.VolumeComponentParams{Name: "cache"}),
+			},
+			want: map[string]string{
+				"sources": "/path/to/component/src/app",
+				"data":    "/var/data",
+				"parent":  "/path/to/shared",
+			},
+			wantIgnores: []string{"src/app"},
+		},
+		{
+			name: "empty host path",
+			components: []v1alpha2.Component{
+				withHostPath("sources", ""),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = devfileData.AddComponents(tt.components)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := getHostPaths(parser.DevfileObj{Data: devfileData}, "/path/to/component")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getHostPaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("getHostPaths() mismatch (-want +got):\n%s", diff)
+			}
+			gotIgnores := getHostPathsIgnores(got, "/path/to/component")
+			if diff := cmp.Diff(tt.wantIgnores, gotIgnores, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("getHostPathsIgnores() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand" // #nosec
+	"path/filepath"
 	"sort"
 	"time"
 
@@ -14,11 +15,13 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
+	devcommon "github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev/utils"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/storage"
 	"github.com/redhat-developer/odo/pkg/util"
 
@@ -26,12 +29,6 @@ import (
 	"k8s.io/klog"
 )
 
-// See https://github.com/devfile/developer-images and https://quay.io/repository/devfile/base-developer-image?tab=tags
-const (
-	portForwardingHelperContainerName = "odo-helper-port-forwarding"
-	portForwardingHelperImage         = "quay.io/devfile/base-developer-image@sha256:27d5ce66a259decb84770ea0d1ce8058a806f39dfcfeed8387f9cf2f29e76480"
-)
-
 func createPodFromComponent(
 	ctx context.Context,
 	debug bool,
@@ -42,6 +39,7 @@ func createPodFromComponent(
 	randomPorts bool,
 	customForwardedPorts []api.ForwardedPort,
 	usedPorts []int,
+	preferredPorts state.PreferredPorts,
 	customAddress string,
 	devfileObj parser.DevfileObj,
 ) (*corev1.Pod, []api.ForwardedPort, error) {
@@ -61,7 +59,7 @@ func createPodFromComponent(
 	}
 
 	var fwPorts []api.ForwardedPort
-	fwPorts, err = getPortMapping(devfileObj, debug, randomPorts, usedPorts, customForwardedPorts, customAddress)
+	fwPorts, err = getPortMapping(devfileObj, debug, randomPorts, usedPorts, preferredPorts, customForwardedPorts, customAddress)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,14 +91,28 @@ func createPodFromComponent(
 		return nil, nil, err
 	}
 
+	// The host paths are relative to the directory of the Devfile, as for the synchronization of the files
+	hostPaths, err := getHostPaths(devfileObj, filepath.Dir(odocontext.GetDevfilePath(ctx)))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	for _, devfileVolume := range devfileVolumes {
-		volumes = append(volumes, corev1.Volume{
-			Name: devfileVolume.Name,
-			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: getVolumeName(devfileVolume.Name, componentName, appName),
-				},
+		volumeSource := corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: getVolumeName(devfileVolume.Name, componentName, appName),
 			},
+		}
+		if hostPath, ok := hostPaths[devfileVolume.Name]; ok {
+			volumeSource = corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: hostPath,
+				},
+			}
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name:         devfileVolume.Name,
+			VolumeSource: volumeSource,
 		})
 		err = addVolumeMountToContainer(containers, devfileVolume)
 		if err != nil {
@@ -132,6 +144,7 @@ func createPodFromComponent(
 	runtime := component.GetComponentRuntimeFromDevfileMetadata(devfileObj.Data.GetMetadata())
 	pod.SetLabels(labels.GetLabels(componentName, appName, runtime, labels.ComponentDevMode, true))
 	labels.SetProjectType(pod.GetLabels(), component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	labels.SetLanguage(pod.GetLabels(), devfileObj.Data.GetMetadata().Language)
 
 	if pod.Annotations == nil {
 		pod.Annotations = make(map[string]string)
@@ -155,8 +168,8 @@ func addHostPorts(withHelperContainer bool, containers []corev1.Container, fwPor
 		}
 		// Add helper container for port-forwarding
 		pfHelperContainer := corev1.Container{
-			Name:    portForwardingHelperContainerName,
-			Image:   portForwardingHelperImage,
+			Name:    devcommon.PortForwardingHelperContainerName,
+			Image:   devcommon.PortForwardingHelperImage,
 			Command: []string{"tail"},
 			Args:    []string{"-f", "/dev/null"},
 		}
@@ -197,7 +210,10 @@ func getVolumeName(volume string, componentName string, appName string) string {
 	return volume + "-" + componentName + "-" + appName
 }
 
-func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool, usedPorts []int, definedPorts []api.ForwardedPort, address string) ([]api.ForwardedPort, error) {
+// getPortMapping returns the local ports to forward to the endpoints of the containers.
+// usedPorts are the local ports already used by the session, and preferredPorts are the local ports forwarded
+// by previous sessions, which are used again if they are free, so the forwarded ports are stable across sessions.
+func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool, usedPorts []int, preferredPorts state.PreferredPorts, definedPorts []api.ForwardedPort, address string) ([]api.ForwardedPort, error) {
 	if address == "" {
 		address = "127.0.0.1"
 	}
@@ -231,6 +247,39 @@ func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool,
 		return false
 	}
 
+	// assignedPorts are the local ports already assigned to endpoints
+	assignedPorts := make(map[int]struct{})
+
+	isPortUsedBySession := func(p int) bool {
+		for _, port := range usedPorts {
+			if p == port {
+				return true
+			}
+		}
+		return false
+	}
+
+	// isPortReserved returns true if the port is already assigned, or is the local port forwarded to another endpoint by a previous session
+	isPortReserved := func(p int) bool {
+		_, isAssigned := assignedPorts[p]
+		return isAssigned || preferredPorts.IsPreferred(p)
+	}
+
+	// getPreferredLocalPort returns the local port forwarded to the containerPort by a previous session, if it can be used again, or 0
+	getPreferredLocalPort := func(containerPort int, container string) int {
+		p := preferredPorts.LocalPort(container, containerPort)
+		if p == 0 {
+			return 0
+		}
+		_, isAssigned := assignedPorts[p]
+		_, isCustom := customLocalPorts[p]
+		if isAssigned || isCustom || isPortUsedInContainer(p) || (!isPortUsedBySession(p) && !util.IsPortFree(p, address)) {
+			klog.V(2).Infof("local port %d previously forwarded to port %d of container %q is not available, another port is used", p, containerPort, container)
+			return 0
+		}
+		return p
+	}
+
 	// getCustomLocalPort analyzes the definedPorts i.e. custom port forwarding to see if a containerPort has a custom localPort, if a container name is provided, it also takes that into account.
 	getCustomLocalPort := func(containerPort int, container string) int {
 		for _, dp := range definedPorts {
@@ -275,6 +324,9 @@ func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool,
 			var freePort int
 			if len(definedPorts) != 0 {
 				freePort = getCustomLocalPort(ep.TargetPort, containerName)
+				if freePort == 0 {
+					freePort = getPreferredLocalPort(ep.TargetPort, containerName)
+				}
 				if freePort == 0 {
 					for {
 						freePort, err = util.NextFreePort(startPort, endPort, usedPorts, address)
@@ -282,8 +334,8 @@ func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool,
 							klog.Infof("%s", err)
 							continue
 						}
-						// ensure that freePort is not a custom local port
-						if _, isPortUsed := customLocalPorts[freePort]; isPortUsed {
+						// ensure that freePort is not a custom local port, nor a reserved port
+						if _, isPortUsed := customLocalPorts[freePort]; isPortUsed || isPortReserved(freePort) {
 							startPort = freePort + 1
 							continue
 						}
@@ -305,13 +357,17 @@ func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool,
 						time.Sleep(100 * time.Millisecond)
 					}
 				}
-			} else {
+			} else if freePort = getPreferredLocalPort(ep.TargetPort, containerName); freePort == 0 {
 				for {
 					freePort, err = util.NextFreePort(startPort, endPort, usedPorts, address)
 					if err != nil {
 						klog.Infof("%s", err)
 						continue epLoop
 					}
+					if isPortReserved(freePort) {
+						startPort = freePort + 1
+						continue
+					}
 					if !isPortUsedInContainer(freePort) {
 						break
 					}
@@ -320,6 +376,7 @@ func getPortMapping(devfileObj parser.DevfileObj, debug bool, randomPorts bool,
 				}
 				startPort = freePort + 1
 			}
+			assignedPorts[freePort] = struct{}{}
 			fp := api.ForwardedPort{
 				Platform:      commonflags.PlatformPodman,
 				PortName:      portName,
@@ -5,12 +5,14 @@ import (
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	"github.com/devfile/library/v2/pkg/devfile/parser/data"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	devcommon "github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -121,8 +123,8 @@ func buildBasePod(withPfContainer bool) *corev1.Pod {
 		basePod.Spec.Containers = append(basePod.Spec.Containers, corev1.Container{
 			Args:    []string{"-f", "/dev/null"},
 			Command: []string{"tail"},
-			Image:   portForwardingHelperImage,
-			Name:    portForwardingHelperContainerName,
+			Image:   devcommon.PortForwardingHelperImage,
+			Name:    devcommon.PortForwardingHelperContainerName,
 		})
 	}
 	return &basePod
@@ -663,6 +665,49 @@ func Test_createPodFromComponent(t *testing.T) {
 				return pod
 			},
 		},
+		{
+			name: "basic component with volume mount from host path",
+			args: args{
+				devfileObj: func() parser.DevfileObj {
+					// copies are used, as adding volume mounts modifies the components
+					component := baseComponent.DeepCopy()
+					component.Container.VolumeMounts = nil
+					hostPathVolume := volume.DeepCopy()
+					hostPathVolume.Attributes = attributes.Attributes{}.PutString("odo.dev/podman-host-path", "src")
+					data, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+					_ = data.AddCommands([]v1alpha2.Command{command})
+					_ = data.AddComponents([]v1alpha2.Component{*component, *hostPathVolume})
+					_ = data.AddVolumeMounts(baseComponent.Name, []v1alpha2.VolumeMount{
+						{
+							Name: volume.Name,
+							Path: "/projects/src",
+						},
+					})
+
+					return parser.DevfileObj{
+						Data: data,
+					}
+				},
+				componentName: devfileName,
+				appName:       appName,
+			},
+			wantPod: func(basePod *corev1.Pod) *corev1.Pod {
+				pod := basePod.DeepCopy()
+				pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+					Name: volume.Name,
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/tmp/devfile-dir/src",
+						},
+					},
+				})
+				pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      volume.Name,
+					MountPath: "/projects/src",
+				})
+				return pod
+			},
+		},
 		{
 			name: "basic component + application endpoint + debug endpoint + container ports known - with debug / forwardLocalhost=false",
 			args: args{
@@ -1412,6 +1457,8 @@ func Test_createPodFromComponent(t *testing.T) {
 			ctx = odocontext.WithApplication(ctx, tt.args.appName)
 			ctx = odocontext.WithComponentName(ctx, tt.args.componentName)
 			ctx = odocontext.WithWorkingDirectory(ctx, "/tmp/dir")
+			// the directory of the Devfile differs from the working directory, to check which one the relative paths are resolved against
+			ctx = odocontext.WithDevfilePath(ctx, "/tmp/devfile-dir/devfile.yaml")
 			got, gotFwPorts, err := createPodFromComponent(
 				ctx,
 				tt.args.debug,
@@ -1422,6 +1469,7 @@ func Test_createPodFromComponent(t *testing.T) {
 				false,
 				tt.args.customForwardedPorts,
 				[]int{20001, 20002, 20003, 20004, 20005},
+				nil,
 				tt.args.customAddress,
 				devfileObj,
 			)
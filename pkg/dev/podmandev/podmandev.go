@@ -3,6 +3,7 @@ package podmandev
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -13,6 +14,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -47,6 +49,8 @@ type DevClient struct {
 
 	deployedPod *corev1.Pod
 	usedPorts   []int
+	// testIterations is the number of executions of the test command since the start of the session
+	testIterations int
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -87,11 +91,19 @@ func (o *DevClient) Start(
 
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
 
+	// Changes in host paths are directly visible from the containers, they do not need to trigger a new iteration
+	componentDir := filepath.Dir(odocontext.GetDevfilePath(ctx))
+	hostPaths, err := getHostPaths(*odocontext.GetEffectiveDevfileObj(ctx), componentDir)
+	if err != nil {
+		return err
+	}
+	options.IgnorePaths = append(append([]string{}, options.IgnorePaths...), getHostPathsIgnores(hostPaths, componentDir)...)
+
 	watchParameters := watch.WatchParameters{
 		StartOptions:        options,
 		DevfileWatchHandler: o.watchHandler,
 		WatchCluster:        false,
-		PromptMessage:       promptMessage,
+		PromptMessage:       i18n.T(promptMessage),
 	}
 
 	return o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
@@ -115,7 +127,7 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 		PodName:       pod.GetName(),
 		SyncFolder:    syncFolder,
 	}
-	s := log.Spinner("Syncing files into the container")
+	s := log.Spinner(i18n.T("Syncing files into the container"))
 	defer s.End(false)
 
 	cmdKind := devfilev1.RunCommandGroupKind
@@ -129,16 +141,25 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 		return false, err
 	}
 
+	// Host paths are bind-mounted into the containers, there is no need to sync them
+	hostPaths, err := getHostPaths(*devfileObj, path)
+	if err != nil {
+		return false, err
+	}
+	ignores := append(append([]string{}, options.IgnorePaths...), getHostPathsIgnores(hostPaths, path)...)
+
 	syncParams := sync.SyncParameters{
 		Path:                     path,
 		WatchFiles:               nil,
 		WatchDeletedFiles:        nil,
-		IgnoredFiles:             options.IgnorePaths,
+		IgnoredFiles:             ignores,
 		DevfileScanIndexForWatch: true,
 
 		CompInfo:  compInfo,
 		ForcePush: true,
 		Files:     common.GetSyncFilesFromAttributes(devfileCmd),
+
+		TextExtensions: options.TextExtensions,
 	}
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
 	if err != nil {
@@ -173,6 +194,15 @@ func (o *DevClient) watchHandler(ctx context.Context, pushParams common.PushPara
 	if err != nil {
 		return fmt.Errorf("unable to read devfile: %w", err)
 	}
+	pushParams.StartOptions, err = common.ApplyProfile(devObj, pushParams.StartOptions)
+	if err != nil {
+		return err
+	}
+	err = common.AddEnvToRunContainers(devObj, pushParams.StartOptions)
+	if err != nil {
+		return fmt.Errorf("unable to set environment variables: %w", err)
+	}
+
 	pushParams.Devfile = devObj
 
 	return o.reconcile(ctx, pushParams, componentStatus)
@@ -18,6 +18,8 @@ import (
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -44,7 +46,7 @@ func (o *DevClient) reconcile(
 
 	o.warnAboutK8sComponents(devfileObj)
 
-	err := o.buildPushAutoImageComponents(ctx, devfileObj)
+	err := o.buildPushAutoImageComponents(ctx, devfileObj, options, componentStatus)
 	if err != nil {
 		return err
 	}
@@ -74,10 +76,13 @@ func (o *DevClient) reconcile(
 			component.GetContainersNames(pod),
 			"Executing post-start command in container",
 
-			// TODO(feloy) set these values when we want to support Apply Image/Kubernetes/OpenShift commands for PostStart commands
-			nil, nil, parser.DevfileObj{}, "",
+			o.fs,
+			image.SelectBackend(ctx),
+
+			// TODO(feloy) set to deploy Kubernetes/Openshift components
+			parser.DevfileObj{}, "",
 		)
-		err = libdevfile.ExecPostStartEvents(ctx, devfileObj, execHandler)
+		err = libdevfile.ExecPostStartEvents(ctx, devfileObj, common.NewAppliedImagesHandler(execHandler, componentStatus.ImageComponentsAutoApplied))
 		if err != nil {
 			return err
 		}
@@ -96,13 +101,18 @@ func (o *DevClient) reconcile(
 				component.GetContainersNames(pod),
 				"Building your application in container",
 
-				// TODO(feloy) set these values when we want to support Apply Image/Kubernetes/OpenShift commands for PreStop events
-				nil, nil, parser.DevfileObj{}, "",
+				o.fs,
+				image.SelectBackend(ctx),
+
+				// TODO(feloy) set to deploy Kubernetes/Openshift components
+				parser.DevfileObj{}, "",
 			)
-			return libdevfile.Build(ctx, devfileObj, options.BuildCommand, execHandler)
+			return libdevfile.Build(ctx, devfileObj, options.BuildCommand,
+				common.NewAppliedImagesHandler(execHandler, componentStatus.ImageComponentsAutoApplied))
 		}
 
 		err = doExecuteBuildCommand()
+		common.RecordBuild(ctx, o.stateClient, err)
 		if err != nil {
 			return err
 		}
@@ -130,7 +140,8 @@ func (o *DevClient) reconcile(
 			// TODO(feloy) set to deploy Kubernetes/Openshift components
 			parser.DevfileObj{}, "",
 		)
-		err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, cmdHandler, false)
+		err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind,
+			common.NewAppliedImagesHandler(cmdHandler, componentStatus.ImageComponentsAutoApplied), false)
 		if err != nil {
 			return err
 		}
@@ -138,11 +149,11 @@ func (o *DevClient) reconcile(
 	}
 
 	// Check that the application is actually listening on the ports declared in the Devfile, so we are sure that port-forwarding will work
-	appReadySpinner := log.Spinner("Waiting for the application to be ready")
+	appReadySpinner := log.Spinner(i18n.T("Waiting for the application to be ready"))
 	err = o.checkAppPorts(ctx, pod.Name, fwPorts)
 	appReadySpinner.End(err == nil)
 	if err != nil {
-		log.Warningf("Port forwarding might not work correctly: %v", err)
+		log.Warningf(i18n.T("Port forwarding might not work correctly: %v"), err)
 		log.Warning("Running `odo logs --follow --platform podman` might help in identifying the problem.")
 		fmt.Fprintln(options.Out)
 	}
@@ -171,6 +182,34 @@ func (o *DevClient) reconcile(
 	if err != nil {
 		return err
 	}
+	if !options.RandomPorts {
+		// Errors are not fatal, the next sessions may forward other ports
+		if err = o.stateClient.SetPreferredPorts(ctx, componentName, fwPorts); err != nil {
+			klog.V(4).Infof("unable to record the forwarded ports for the next sessions: %v", err)
+		}
+	}
+
+	if options.RunTests && execRequired {
+		o.testIterations++
+		testsHandler := component.NewRunHandler(
+			ctx,
+			o.podmanClient,
+			o.execClient,
+			nil, // TODO(feloy) set this value when we want to support exec on new container on podman
+			pod.Name,
+			false,
+			component.GetContainersNames(pod),
+			"Executing test command in container",
+
+			o.fs,
+			image.SelectBackend(ctx),
+
+			// TODO(feloy) set to deploy Kubernetes/Openshift components
+			parser.DevfileObj{}, "",
+		)
+		// Failing tests are reported, but do not make the push fail
+		_ = common.RunTests(ctx, devfileObj, options.TestCommand, testsHandler, options.Out, o.stateClient, o.testIterations)
+	}
 
 	componentStatus.SetState(watch.StateReady)
 	return nil
@@ -193,17 +232,20 @@ func (o *DevClient) warnAboutK8sComponents(devfileObj parser.DevfileObj) {
 	log.Warningf("Kubernetes components are not supported on Podman. Skipping: %v.", strings.Join(components, ", "))
 }
 
-func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj parser.DevfileObj) error {
-	components, err := libdevfile.GetImageComponentsToPushAutomatically(devfileObj)
+func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj parser.DevfileObj, options dev.StartOptions, compStatus *watch.ComponentStatus) error {
+	components, err := common.GetImageComponentsToPushOnStart(devfileObj, options)
 	if err != nil {
 		return err
 	}
 
+	// Images are built again at each iteration on podman, the cache only prevents the commands to build them twice
+	compStatus.ImageComponentsAutoApplied = make(map[string]devfilev1.ImageComponent)
 	for _, c := range components {
 		err = image.BuildPushSpecificImage(ctx, image.SelectBackend(ctx), o.fs, c, envcontext.GetEnvConfig(ctx).PushImages)
 		if err != nil {
 			return err
 		}
+		compStatus.ImageComponentsAutoApplied[c.Name] = *c.Image
 	}
 	return nil
 }
@@ -211,9 +253,15 @@ func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj
 // deployPod deploys the component as a Pod in podman
 func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, devfileObj parser.DevfileObj) (*corev1.Pod, []api.ForwardedPort, error) {
 
-	spinner := log.Spinner("Deploying pod")
+	spinner := log.Spinner(i18n.T("Deploying pod"))
 	defer spinner.End(false)
 
+	// Errors are not fatal, the ports are assigned from 20001 in this case
+	preferredPorts, err := o.stateClient.GetPreferredPorts(ctx, odocontext.GetComponentName(ctx))
+	if err != nil {
+		klog.V(4).Infof("unable to get the ports forwarded by previous sessions: %v", err)
+	}
+
 	pod, fwPorts, err := createPodFromComponent(
 		ctx,
 		options.Debug,
@@ -224,6 +272,7 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 		options.RandomPorts,
 		options.CustomForwardedPorts,
 		o.usedPorts,
+		preferredPorts,
 		options.CustomAddress,
 		devfileObj,
 	)
@@ -231,6 +280,7 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 		return nil, nil, err
 	}
 	o.usedPorts = getUsedPorts(fwPorts)
+	labels.SetProfile(pod.Annotations, options.Profile)
 
 	if equality.Semantic.DeepEqual(o.deployedPod, pod) {
 		klog.V(4).Info("pod is already deployed as required")
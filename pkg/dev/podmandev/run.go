@@ -2,6 +2,7 @@ package podmandev
 
 import (
 	"context"
+	"io"
 
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"k8s.io/klog"
@@ -21,3 +22,20 @@ func (o *DevClient) Run(
 		o.fs,
 	)
 }
+
+func (o *DevClient) Test(
+	ctx context.Context,
+	commandName string,
+	out io.Writer,
+) error {
+	klog.V(4).Infof("running test command %q on podman", commandName)
+	return common.Test(
+		ctx,
+		commandName,
+		o.podmanClient,
+		o.execClient,
+		nil, // TODO(feloy) set when running on new container is supported on podman
+		o.fs,
+		out,
+	)
+}
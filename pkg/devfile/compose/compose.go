@@ -0,0 +1,538 @@
+// Package compose converts a Docker Compose file into a Devfile
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+)
+
+// RunCommandId is the id of the composite run command starting the services in the order of their dependencies
+const RunCommandId = "run"
+
+// composeFile is the subset of the Compose specification (https://compose-spec.io) used for the conversion
+type composeFile struct {
+	Services map[string]composeService `json:"services"`
+}
+
+type composeService struct {
+	Image       string        `json:"image"`
+	Build       interface{}   `json:"build"`
+	Ports       []interface{} `json:"ports"`
+	Expose      []interface{} `json:"expose"`
+	Environment interface{}   `json:"environment"`
+	Volumes     []interface{} `json:"volumes"`
+	DependsOn   interface{}   `json:"depends_on"`
+	Command     interface{}   `json:"command"`
+	Entrypoint  interface{}   `json:"entrypoint"`
+	WorkingDir  string        `json:"working_dir"`
+}
+
+// converter accumulates the elements of the Devfile and the warnings of the conversion
+type converter struct {
+	components []devfilev1.Component
+	commands   []devfilev1.Command
+	warnings   []string
+
+	// services are the names of the container components
+	services map[string]struct{}
+	// componentNames are the names of the container components, indexed by the names of the services
+	componentNames map[string]string
+	// volumes are the names of the volume components already added
+	volumes map[string]struct{}
+	// ports are the target ports already exposed, with the name of the service exposing them
+	ports map[int]string
+}
+
+// Convert converts the content of a Compose file into the data of a Devfile:
+//   - each service is converted into a container component, and an image component if the service is built,
+//   - the ports are converted into endpoints, public for the published ports and internal for the exposed ones,
+//   - the named volumes are converted into volume components, and the bind mount of the project directory into the source mapping,
+//   - the command and entrypoint of the services are converted into exec commands, started in the order of the depends_on
+//     dependencies by a default composite run command.
+//
+// The elements of the Compose file that cannot be converted are returned as warnings.
+func Convert(content []byte) (data.DevfileData, []string, error) {
+	var compose composeFile
+	err := yaml.Unmarshal(content, &compose)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the Compose file: %w", err)
+	}
+	if len(compose.Services) == 0 {
+		return nil, nil, errors.New("no service found in the Compose file")
+	}
+
+	names := make([]string, 0, len(compose.Services))
+	for name := range compose.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	order, err := startOrder(compose.Services, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := converter{
+		services:       make(map[string]struct{}, len(names)),
+		componentNames: make(map[string]string, len(names)),
+		volumes:        map[string]struct{}{},
+		ports:          map[int]string{},
+	}
+	for _, name := range names {
+		c.addServiceName(name)
+	}
+	var runCommands []string
+	for _, name := range order {
+		runCommand, err := c.convertService(name, compose.Services[name])
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to convert service %q: %w", name, err)
+		}
+		if runCommand != "" {
+			runCommands = append(runCommands, runCommand)
+		}
+	}
+	c.addRunCommand(runCommands)
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		return nil, nil, err
+	}
+	devfileData.SetSchemaVersion(string(data.APISchemaVersion220))
+	err = devfileData.AddComponents(c.components)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = devfileData.AddCommands(c.commands)
+	if err != nil {
+		return nil, nil, err
+	}
+	return devfileData, c.warnings, nil
+}
+
+// addServiceName records the name of the container component of the service.
+// Services whose names differ only by characters invalid in a Devfile (e.g. web_app and web-app) get a suffixed name
+func (o *converter) addServiceName(serviceName string) {
+	name := devfileName(serviceName)
+	if _, found := o.services[name]; found {
+		base := name
+		for i := 2; found; i++ {
+			name = fmt.Sprintf("%s-%d", base, i)
+			_, found = o.services[name]
+		}
+		o.warnings = append(o.warnings, fmt.Sprintf("service %q converted into component %q, as its name is already used by another service", serviceName, name))
+	}
+	o.services[name] = struct{}{}
+	o.componentNames[serviceName] = name
+}
+
+// convertService adds the components and commands of the service, and returns the id of the command starting it, if any
+func (o *converter) convertService(serviceName string, service composeService) (string, error) {
+	name := o.componentNames[serviceName]
+	container := devfilev1.Component{
+		Name: name,
+		ComponentUnion: devfilev1.ComponentUnion{
+			Container: &devfilev1.ContainerComponent{
+				Container: devfilev1.Container{
+					Image:        service.Image,
+					MountSources: pointer.Bool(false),
+				},
+			},
+		},
+	}
+
+	if service.Build != nil {
+		image, err := o.convertBuild(name, service)
+		if err != nil {
+			return "", err
+		}
+		container.Container.Image = image
+	}
+	if container.Container.Image == "" {
+		return "", errors.New("no image or build defined")
+	}
+
+	env, err := o.convertEnvironment(serviceName, service.Environment)
+	if err != nil {
+		return "", err
+	}
+	container.Container.Env = env
+
+	container.Container.Endpoints = append(o.convertPorts(serviceName, service.Ports, devfilev1.PublicEndpointExposure),
+		o.convertPorts(serviceName, service.Expose, devfilev1.InternalEndpointExposure)...)
+
+	volumes, err := o.convertVolumes(serviceName, service.Volumes, &container.Container.Container)
+	if err != nil {
+		return "", err
+	}
+	o.components = append(o.components, container)
+	o.components = append(o.components, volumes...)
+
+	entrypoint, err := stringOrList(service.Entrypoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid entrypoint: %w", err)
+	}
+	command, err := stringOrList(service.Command)
+	if err != nil {
+		return "", fmt.Errorf("invalid command: %w", err)
+	}
+	commandLine := strings.TrimSpace(strings.Join(append(entrypoint, command...), " "))
+	if commandLine == "" {
+		// the container runs the command of its image
+		return "", nil
+	}
+	workingDir := service.WorkingDir
+	if workingDir == "" && container.Container.SourceMapping != "" {
+		workingDir = container.Container.SourceMapping
+	}
+	id := "run-" + name
+	o.commands = append(o.commands, devfilev1.Command{
+		Id: id,
+		CommandUnion: devfilev1.CommandUnion{
+			Exec: &devfilev1.ExecCommand{
+				Component:   name,
+				CommandLine: commandLine,
+				WorkingDir:  workingDir,
+			},
+		},
+	})
+	return id, nil
+}
+
+// convertBuild adds an image component building the image of the service, and returns the name of the image
+func (o *converter) convertBuild(name string, service composeService) (string, error) {
+	buildContext := "."
+	dockerfile := "Dockerfile"
+	switch build := service.Build.(type) {
+	case string:
+		buildContext = build
+	case map[string]interface{}:
+		if ctx, ok := build["context"].(string); ok && ctx != "" {
+			buildContext = ctx
+		}
+		if df, ok := build["dockerfile"].(string); ok && df != "" {
+			dockerfile = df
+		}
+		if _, ok := build["args"]; ok {
+			o.warnings = append(o.warnings, fmt.Sprintf("build arguments of service %q ignored", name))
+		}
+	default:
+		return "", fmt.Errorf("invalid build %v", build)
+	}
+
+	imageName := service.Image
+	if imageName == "" {
+		imageName = name
+		o.warnings = append(o.warnings, fmt.Sprintf("the image built for service %q has no name, the relative name %q is used: set the ImageRegistry preference to push it to a registry accessible from the cluster", name, imageName))
+	}
+	o.components = append(o.components, devfilev1.Component{
+		Name: name + "-build",
+		ComponentUnion: devfilev1.ComponentUnion{
+			Image: &devfilev1.ImageComponent{
+				Image: devfilev1.Image{
+					ImageName: imageName,
+					ImageUnion: devfilev1.ImageUnion{
+						Dockerfile: &devfilev1.DockerfileImage{
+							DockerfileSrc: devfilev1.DockerfileSrc{
+								Uri: path.Join(buildContext, dockerfile),
+							},
+							Dockerfile: devfilev1.Dockerfile{
+								BuildContext: buildContext,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	return imageName, nil
+}
+
+// convertEnvironment converts the environment of the service, defined as a list of NAME=VALUE or as a map
+func (o *converter) convertEnvironment(serviceName string, environment interface{}) ([]devfilev1.EnvVar, error) {
+	var result []devfilev1.EnvVar
+	switch env := environment.(type) {
+	case nil:
+	case []interface{}:
+		for _, e := range env {
+			s := fmt.Sprint(e)
+			name, value, found := strings.Cut(s, "=")
+			if !found {
+				o.warnings = append(o.warnings, fmt.Sprintf("environment variable %q of service %q ignored, its value is taken from the host", name, serviceName))
+				continue
+			}
+			result = append(result, devfilev1.EnvVar{Name: name, Value: value})
+		}
+	case map[string]interface{}:
+		names := make([]string, 0, len(env))
+		for name := range env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if env[name] == nil {
+				o.warnings = append(o.warnings, fmt.Sprintf("environment variable %q of service %q ignored, its value is taken from the host", name, serviceName))
+				continue
+			}
+			result = append(result, devfilev1.EnvVar{Name: name, Value: fmt.Sprint(env[name])})
+		}
+	default:
+		return nil, fmt.Errorf("invalid environment %v", env)
+	}
+	return result, nil
+}
+
+// convertPorts converts the ports of the service into endpoints.
+// As the containers of a Devfile run in the same pod, a port already used by another service is ignored.
+func (o *converter) convertPorts(serviceName string, ports []interface{}, exposure devfilev1.EndpointExposure) []devfilev1.Endpoint {
+	var result []devfilev1.Endpoint
+	for _, p := range ports {
+		port, err := targetPort(p)
+		if err != nil {
+			o.warnings = append(o.warnings, fmt.Sprintf("port %v of service %q ignored: %v", p, serviceName, err))
+			continue
+		}
+		if other, found := o.ports[port]; found {
+			o.warnings = append(o.warnings, fmt.Sprintf("port %d of service %q ignored, it is already used by service %q", port, serviceName, other))
+			continue
+		}
+		o.ports[port] = serviceName
+		result = append(result, devfilev1.Endpoint{
+			Name:       fmt.Sprintf("port-%d", port),
+			TargetPort: port,
+			Exposure:   exposure,
+		})
+	}
+	return result
+}
+
+// convertVolumes adds the volume mounts of the named volumes to the container, and returns the volume components not already added.
+// The bind mount of the project directory defines the source mapping of the container, the other mounts are ignored.
+func (o *converter) convertVolumes(serviceName string, volumes []interface{}, container *devfilev1.Container) ([]devfilev1.Component, error) {
+	var result []devfilev1.Component
+	for _, v := range volumes {
+		var volumeType, source, target string
+		switch volume := v.(type) {
+		case string:
+			parts := strings.Split(volume, ":")
+			switch len(parts) {
+			case 1:
+				target = parts[0]
+			default:
+				source, target = parts[0], parts[1]
+			}
+			volumeType = "volume"
+			if source == "" {
+				volumeType = "anonymous"
+			} else if strings.HasPrefix(source, ".") || strings.HasPrefix(source, "/") || strings.HasPrefix(source, "~") || strings.HasPrefix(source, "$") {
+				volumeType = "bind"
+			}
+		case map[string]interface{}:
+			volumeType, _ = volume["type"].(string)
+			source, _ = volume["source"].(string)
+			target, _ = volume["target"].(string)
+		default:
+			return nil, fmt.Errorf("invalid volume %v", volume)
+		}
+
+		switch {
+		case volumeType == "volume" && source != "":
+			name := devfileName(source)
+			if _, found := o.services[name]; found {
+				// component names are shared by services and volumes
+				name += "-data"
+			}
+			container.VolumeMounts = append(container.VolumeMounts, devfilev1.VolumeMount{Name: name, Path: target})
+			if _, found := o.volumes[name]; !found {
+				o.volumes[name] = struct{}{}
+				result = append(result, devfilev1.Component{
+					Name: name,
+					ComponentUnion: devfilev1.ComponentUnion{
+						Volume: &devfilev1.VolumeComponent{},
+					},
+				})
+			}
+		case volumeType == "bind" && isProjectDir(source) && container.SourceMapping == "":
+			container.MountSources = pointer.Bool(true)
+			container.SourceMapping = target
+		default:
+			o.warnings = append(o.warnings, fmt.Sprintf("volume %v of service %q ignored", v, serviceName))
+		}
+	}
+	return result, nil
+}
+
+// addRunCommand adds the default run command, starting the services in order
+func (o *converter) addRunCommand(commands []string) {
+	switch len(commands) {
+	case 0:
+		o.warnings = append(o.warnings, "no service defines a command, no run command has been generated")
+	case 1:
+		for i := range o.commands {
+			if o.commands[i].Id == commands[0] {
+				o.commands[i].Exec.Group = &devfilev1.CommandGroup{
+					Kind:      devfilev1.RunCommandGroupKind,
+					IsDefault: pointer.Bool(true),
+				}
+			}
+		}
+	default:
+		o.commands = append(o.commands, devfilev1.Command{
+			Id: RunCommandId,
+			CommandUnion: devfilev1.CommandUnion{
+				Composite: &devfilev1.CompositeCommand{
+					LabeledCommand: devfilev1.LabeledCommand{
+						BaseCommand: devfilev1.BaseCommand{
+							Group: &devfilev1.CommandGroup{
+								Kind:      devfilev1.RunCommandGroupKind,
+								IsDefault: pointer.Bool(true),
+							},
+						},
+					},
+					Commands: commands,
+					Parallel: pointer.Bool(false),
+				},
+			},
+		})
+	}
+}
+
+// startOrder returns the names of the services, the dependencies of a service before the service
+func startOrder(services map[string]composeService, names []string) ([]string, error) {
+	var (
+		result  []string
+		visited = map[string]bool{}
+		visit   func(name string, path []string) error
+	)
+	visit = func(name string, path []string) error {
+		if done, found := visited[name]; found {
+			if !done {
+				return fmt.Errorf("circular dependency between services: %s", strings.Join(append(path, name), " -> "))
+			}
+			return nil
+		}
+		service, found := services[name]
+		if !found {
+			return fmt.Errorf("service %q not found", name)
+		}
+		visited[name] = false
+		dependencies, err := dependsOn(service.DependsOn)
+		if err != nil {
+			return fmt.Errorf("invalid depends_on of service %q: %w", name, err)
+		}
+		for _, dependency := range dependencies {
+			if err = visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		result = append(result, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// dependsOn returns the sorted names of the services a service depends on, defined as a list or as a map
+func dependsOn(value interface{}) ([]string, error) {
+	var result []string
+	switch v := value.(type) {
+	case nil:
+	case []interface{}:
+		for _, name := range v {
+			result = append(result, fmt.Sprint(name))
+		}
+	case map[string]interface{}:
+		for name := range v {
+			result = append(result, name)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected value %v", v)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// targetPort returns the container port of a port definition, in the short ([HOST:]CONTAINER[/PROTOCOL]) or long syntax
+func targetPort(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), nil
+	case string:
+		parts := strings.Split(v, ":")
+		port, _, _ := strings.Cut(parts[len(parts)-1], "/")
+		if strings.Contains(port, "-") {
+			return 0, errors.New("port ranges are not supported")
+		}
+		return strconv.Atoi(port)
+	case map[string]interface{}:
+		if target, ok := v["target"].(float64); ok {
+			return int(target), nil
+		}
+		return 0, errors.New("no target port")
+	default:
+		return 0, fmt.Errorf("unexpected value %v", v)
+	}
+}
+
+// stringOrList returns the elements of a value defined as a string or as a list of strings
+func stringOrList(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, e := range v {
+			result = append(result, shellQuote(fmt.Sprint(e)))
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unexpected value %v", v)
+	}
+}
+
+var shellSafe = regexp.MustCompile(`^[a-zA-Z0-9_./=:,@%+-]+$`)
+
+func shellQuote(s string) string {
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+func isProjectDir(source string) bool {
+	switch strings.TrimSuffix(source, "/") {
+	case ".", "", "${PWD}", "$PWD":
+		return true
+	}
+	return false
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// devfileName returns a valid name for a Devfile component, from the name of a Compose service or volume
+func devfileName(name string) string {
+	result := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(result) > 55 {
+		result = strings.TrimRight(result[:55], "-")
+	}
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = "c-" + result
+	}
+	return result
+}
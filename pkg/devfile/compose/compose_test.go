@@ -0,0 +1,251 @@
+package compose
+
+import (
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+)
+
+const composeContent = `services:
+  web:
+    build:
+      context: ./web
+      args:
+        VERSION: "1"
+    ports:
+    - "8080:3000"
+    - 9229
+    environment:
+      NODE_ENV: development
+      SECRET:
+    volumes:
+    - .:/app
+    - ./config:/config
+    depends_on:
+    - api
+    command: npm run dev
+  api:
+    image: my-api:latest
+    ports:
+    - target: 8000
+      published: 8000
+    - "3000"
+    environment:
+    - DB_HOST=db
+    depends_on:
+      db:
+        condition: service_healthy
+    entrypoint: ["python", "-m"]
+    command: ["uvicorn", "main:app", "--host", "0.0.0.0"]
+    working_dir: /srv
+  db:
+    image: postgres:15
+    expose:
+    - 5432
+    volumes:
+    - db:/var/lib/postgresql/data
+volumes:
+  db: {}
+`
+
+func TestConvert(t *testing.T) {
+	devfileData, warnings, err := Convert([]byte(composeContent))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantWarnings := []string{
+		`build arguments of service "web" ignored`,
+		`the image built for service "web" has no name, the relative name "web" is used: set the ImageRegistry preference to push it to a registry accessible from the cluster`,
+		`environment variable "SECRET" of service "web" ignored, its value is taken from the host`,
+		`port 3000 of service "web" ignored, it is already used by service "api"`,
+		`volume ./config:/config of service "web" ignored`,
+	}
+	if diff := cmp.Diff(wantWarnings, warnings); diff != "" {
+		t.Errorf("Convert() warnings mismatch (-want +got):\n%s", diff)
+	}
+
+	components, err := devfileData.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantComponents := []devfilev1.Component{
+		{
+			Name: "db",
+			ComponentUnion: devfilev1.ComponentUnion{Container: &devfilev1.ContainerComponent{
+				Container: devfilev1.Container{
+					Image:        "postgres:15",
+					MountSources: pointer.Bool(false),
+					VolumeMounts: []devfilev1.VolumeMount{{Name: "db-data", Path: "/var/lib/postgresql/data"}},
+				},
+				Endpoints: []devfilev1.Endpoint{{Name: "port-5432", TargetPort: 5432, Exposure: devfilev1.InternalEndpointExposure}},
+			}},
+		},
+		{
+			Name:           "db-data",
+			ComponentUnion: devfilev1.ComponentUnion{Volume: &devfilev1.VolumeComponent{}},
+		},
+		{
+			Name: "api",
+			ComponentUnion: devfilev1.ComponentUnion{Container: &devfilev1.ContainerComponent{
+				Container: devfilev1.Container{
+					Image:        "my-api:latest",
+					MountSources: pointer.Bool(false),
+					Env:          []devfilev1.EnvVar{{Name: "DB_HOST", Value: "db"}},
+				},
+				Endpoints: []devfilev1.Endpoint{
+					{Name: "port-8000", TargetPort: 8000, Exposure: devfilev1.PublicEndpointExposure},
+					{Name: "port-3000", TargetPort: 3000, Exposure: devfilev1.PublicEndpointExposure},
+				},
+			}},
+		},
+		{
+			Name: "web-build",
+			ComponentUnion: devfilev1.ComponentUnion{Image: &devfilev1.ImageComponent{Image: devfilev1.Image{
+				ImageName: "web",
+				ImageUnion: devfilev1.ImageUnion{Dockerfile: &devfilev1.DockerfileImage{
+					DockerfileSrc: devfilev1.DockerfileSrc{Uri: "web/Dockerfile"},
+					Dockerfile:    devfilev1.Dockerfile{BuildContext: "./web"},
+				}},
+			}}},
+		},
+		{
+			Name: "web",
+			ComponentUnion: devfilev1.ComponentUnion{Container: &devfilev1.ContainerComponent{
+				Container: devfilev1.Container{
+					Image:         "web",
+					MountSources:  pointer.Bool(true),
+					SourceMapping: "/app",
+					Env:           []devfilev1.EnvVar{{Name: "NODE_ENV", Value: "development"}},
+				},
+				Endpoints: []devfilev1.Endpoint{
+					{Name: "port-9229", TargetPort: 9229, Exposure: devfilev1.PublicEndpointExposure},
+				},
+			}},
+		},
+	}
+	if diff := cmp.Diff(wantComponents, components); diff != "" {
+		t.Errorf("Convert() components mismatch (-want +got):\n%s", diff)
+	}
+
+	commands, err := devfileData.GetCommands(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCommands := []devfilev1.Command{
+		{
+			Id: "run-api",
+			CommandUnion: devfilev1.CommandUnion{Exec: &devfilev1.ExecCommand{
+				Component:   "api",
+				CommandLine: "python -m uvicorn main:app --host 0.0.0.0",
+				WorkingDir:  "/srv",
+			}},
+		},
+		{
+			Id: "run-web",
+			CommandUnion: devfilev1.CommandUnion{Exec: &devfilev1.ExecCommand{
+				Component:   "web",
+				CommandLine: "npm run dev",
+				WorkingDir:  "/app",
+			}},
+		},
+		{
+			Id: "run",
+			CommandUnion: devfilev1.CommandUnion{Composite: &devfilev1.CompositeCommand{
+				LabeledCommand: devfilev1.LabeledCommand{BaseCommand: devfilev1.BaseCommand{
+					Group: &devfilev1.CommandGroup{Kind: devfilev1.RunCommandGroupKind, IsDefault: pointer.Bool(true)},
+				}},
+				Commands: []string{"run-api", "run-web"},
+				Parallel: pointer.Bool(false),
+			}},
+		},
+	}
+	if diff := cmp.Diff(wantCommands, commands); diff != "" {
+		t.Errorf("Convert() commands mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConvert_ServiceNamesCollision(t *testing.T) {
+	devfileData, warnings, err := Convert([]byte(`services:
+  web-app:
+    image: web:1
+    command: npm start
+  web_app:
+    image: web:2
+    command: npm start
+  Web-App-2:
+    image: web:3
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantWarnings := []string{
+		`service "web_app" converted into component "web-app-3", as its name is already used by another service`,
+	}
+	if diff := cmp.Diff(wantWarnings, warnings); diff != "" {
+		t.Errorf("Convert() warnings mismatch (-want +got):\n%s", diff)
+	}
+
+	components, err := devfileData.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	images := map[string]string{}
+	for _, component := range components {
+		images[component.Name] = component.Container.Image
+	}
+	wantImages := map[string]string{"web-app": "web:1", "web-app-3": "web:2", "web-app-2": "web:3"}
+	if diff := cmp.Diff(wantImages, images); diff != "" {
+		t.Errorf("Convert() components mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "no service",
+			content: "volumes: {}\n",
+		},
+		{
+			name: "circular dependency",
+			content: `services:
+  a:
+    image: a
+    depends_on: [b]
+  b:
+    image: b
+    depends_on: [a]
+`,
+		},
+		{
+			name: "unknown dependency",
+			content: `services:
+  a:
+    image: a
+    depends_on: [b]
+`,
+		},
+		{
+			name: "no image",
+			content: `services:
+  a:
+    command: run
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Convert([]byte(tt.content))
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package image
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -173,6 +174,47 @@ func (o *DockerCompatibleBackend) Push(image string) error {
 	return nil
 }
 
+// GetDigest returns the digest of a pushed image, from the repository digests known by the Docker compatible CLI
+func (o *DockerCompatibleBackend) GetDigest(image string) (string, error) {
+	klog.V(4).Infof("Running command: %s image inspect --format {{json .RepoDigests}} %s", o.name, image)
+
+	cmd := exec.Command(o.name, "image", "inspect", "--format", "{{json .RepoDigests}}", image)
+	cmd.Stderr = log.GetStderr()
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("error running %s command: %w", o.name, err)
+	}
+
+	var repoDigests []string
+	err = json.Unmarshal(out, &repoDigests)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the output of %s image inspect: %w", o.name, err)
+	}
+	return getDigestFromRepoDigests(image, repoDigests)
+}
+
+// getDigestFromRepoDigests returns the digest of the image from the list of its repository digests
+// (in the form repository@digest), preferring the one of the repository of the image.
+// The CLIs can shorten the names of the repositories of the default registry, so the first digest is used
+// if none is found for the repository of the image.
+func getDigestFromRepoDigests(image string, repoDigests []string) (string, error) {
+	if len(repoDigests) == 0 {
+		return "", fmt.Errorf("no digest found for image %q", image)
+	}
+	repository := getRepository(image)
+	for _, repoDigest := range repoDigests {
+		repo, digest, found := strings.Cut(repoDigest, "@")
+		if found && repo == repository {
+			return digest, nil
+		}
+	}
+	_, digest, found := strings.Cut(repoDigests[0], "@")
+	if !found {
+		return "", fmt.Errorf("invalid repository digest %q for image %q", repoDigests[0], image)
+	}
+	return digest, nil
+}
+
 // String return the name of the docker compatible CLI used
 func (o *DockerCompatibleBackend) String() string {
 	return o.name
@@ -253,3 +253,42 @@ func Test_resolveAndDownloadDockerfile(t *testing.T) {
 		})
 	}
 }
+
+func Test_getDigestFromRepoDigests(t *testing.T) {
+	tests := []struct {
+		name        string
+		image       string
+		repoDigests []string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "digest of the repository of the image",
+			image:       "quay.io/user/app:v1",
+			repoDigests: []string{"quay.io/other/app@sha256:other", "quay.io/user/app@sha256:expected"},
+			want:        "sha256:expected",
+		},
+		{
+			name:        "repository shortened by the CLI",
+			image:       "docker.io/user/app:v1",
+			repoDigests: []string{"user/app@sha256:expected"},
+			want:        "sha256:expected",
+		},
+		{
+			name:    "no digest",
+			image:   "quay.io/user/app:v1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getDigestFromRepoDigests(tt.image, tt.repoDigests)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getDigestFromRepoDigests() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getDigestFromRepoDigests() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
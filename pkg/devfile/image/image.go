@@ -6,6 +6,7 @@ import (
 	"errors"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
@@ -24,15 +25,45 @@ type Backend interface {
 	Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error
 	// Push the image to its registry as defined in the devfile
 	Push(image string) error
+	// GetDigest returns the digest of the image in its registry, once pushed
+	GetDigest(image string) (string, error)
 	// Return the name of the backend
 	String() string
 }
 
 var lookPathCmd = exec.LookPath
 
+// PushedImage is an image built from an Image component and pushed to its registry
+type PushedImage struct {
+	// Component is the name of the Image component
+	Component string `json:"component"`
+	// Image is the name of the image, including its tag
+	Image string `json:"image"`
+	// Digest is the digest of the image in its registry, empty if it could not be determined
+	Digest string `json:"digest"`
+}
+
+// pushRetryPolicy configures how the pushes of images failing are retried
+type pushRetryPolicy struct {
+	// Retries is the maximum number of times a push is retried
+	Retries int
+	// Backoff is the delay before the first retry, doubled before each new retry
+	Backoff time.Duration
+}
+
+// getPushRetryPolicy returns the push retry policy defined in the environment configuration
+func getPushRetryPolicy(ctx context.Context) pushRetryPolicy {
+	envConfig := envcontext.GetEnvConfig(ctx)
+	return pushRetryPolicy{
+		Retries: envConfig.OdoImagePushRetries,
+		Backoff: envConfig.OdoImagePushRetryBackoff,
+	}
+}
+
 // BuildPushImages build all images defined in the devfile with the detected backend
-// If push is true, also push the images to their registries
-func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesystem, push bool) error {
+// If push is true, also push the images to their registries, and return the pushed images with their digests.
+// The tag of the images is replaced depending on tagStrategy.
+func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesystem, push bool, tagStrategy TagStrategy) ([]PushedImage, error) {
 	var (
 		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
 		devfilePath = odocontext.GetDevfilePath(ctx)
@@ -41,7 +72,7 @@ func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesys
 
 	if backend == nil {
 		//revive:disable:error-strings This is a top-level error message displayed as is to the end user
-		return errors.New("odo requires either Podman or Docker to be installed in your environment. Please install one of them and try again.")
+		return nil, errors.New("odo requires either Podman or Docker to be installed in your environment. Please install one of them and try again.")
 		//revive:enable:error-strings
 	}
 
@@ -49,19 +80,44 @@ func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesys
 		ComponentOptions: common.ComponentOptions{ComponentType: devfile.ImageComponentType},
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(components) == 0 {
-		return libdevfile.NewComponentTypeNotFoundError(devfile.ImageComponentType)
+		return nil, libdevfile.NewComponentTypeNotFoundError(devfile.ImageComponentType)
+	}
+
+	tag, err := GetTag(tagStrategy, path, time.Now())
+	if err != nil {
+		return nil, err
 	}
 
+	var pushed []PushedImage
 	for _, component := range components {
-		err = buildPushImage(backend, fs, component.Image, path, push)
+		img := component.Image.DeepCopy()
+		if tag != "" {
+			img.ImageName, err = WithTag(img.ImageName, tag)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = buildPushImage(backend, fs, img, path, push, getPushRetryPolicy(ctx))
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if !push {
+			continue
 		}
+		digest, digestErr := backend.GetDigest(img.ImageName)
+		if digestErr != nil {
+			log.Warningf("Unable to get the digest of image %s: %v", img.ImageName, digestErr)
+		}
+		pushed = append(pushed, PushedImage{
+			Component: component.Name,
+			Image:     img.ImageName,
+			Digest:    digest,
+		})
 	}
-	return nil
+	return pushed, nil
 }
 
 // BuildPushSpecificImage build an image defined in the devfile present in devfilePath
@@ -78,12 +134,12 @@ func BuildPushSpecificImage(ctx context.Context, backend Backend, fs filesystem.
 		//revive:enable:error-strings
 	}
 
-	return buildPushImage(backend, fs, component.Image, path, push)
+	return buildPushImage(backend, fs, component.Image, path, push, getPushRetryPolicy(ctx))
 }
 
 // buildPushImage build an image using the provided backend
-// If push is true, also push the image to its registry
-func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool) error {
+// If push is true, also push the image to its registry, retrying as defined by retryPolicy if the push fails
+func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool, retryPolicy pushRetryPolicy) error {
 	if image == nil {
 		return errors.New("image should not be nil")
 	}
@@ -99,7 +155,7 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 		return err
 	}
 	if push {
-		err = backend.Push(image.ImageName)
+		err = pushWithRetries(backend, image.ImageName, retryPolicy)
 		if err != nil {
 			return err
 		}
@@ -107,6 +163,20 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 	return nil
 }
 
+// pushWithRetries pushes the image using the provided backend, and retries as defined by retryPolicy if the push fails
+func pushWithRetries(backend Backend, image string, retryPolicy pushRetryPolicy) error {
+	backoff := retryPolicy.Backoff
+	for attempt := 0; ; attempt++ {
+		err := backend.Push(image)
+		if err == nil || attempt >= retryPolicy.Retries {
+			return err
+		}
+		log.Warningf("Pushing image %s failed, retrying in %s (%d/%d): %v", image, backoff, attempt+1, retryPolicy.Retries, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
 // SelectBackend selects the container backend to use for building and pushing images
 // It will detect podman and docker CLIs (in this order),
 // or return nil if none are present locally
@@ -109,7 +109,7 @@ func TestBuildPushImage(t *testing.T) {
 			} else {
 				backend.EXPECT().Push(nil).Times(0)
 			}
-			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push)
+			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push, pushRetryPolicy{})
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
@@ -119,6 +119,55 @@ func TestBuildPushImage(t *testing.T) {
 	}
 }
 
+func TestPushWithRetries(t *testing.T) {
+	tests := []struct {
+		name string
+		// pushErrors are the errors returned by the successive calls to Push
+		pushErrors []error
+		retries    int
+		wantErr    bool
+	}{
+		{
+			name:       "push succeeding the first time",
+			pushErrors: []error{nil},
+			retries:    3,
+		},
+		{
+			name:       "push succeeding after retries",
+			pushErrors: []error{errors.New("connection reset"), errors.New("connection reset"), nil},
+			retries:    3,
+		},
+		{
+			name:       "push failing more than the number of retries",
+			pushErrors: []error{errors.New("connection reset"), errors.New("connection reset"), errors.New("connection reset")},
+			retries:    2,
+			wantErr:    true,
+		},
+		{
+			name:       "retries disabled",
+			pushErrors: []error{errors.New("connection reset")},
+			retries:    0,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			backend := NewMockBackend(ctrl)
+			var calls []*gomock.Call
+			for _, pushErr := range tt.pushErrors {
+				calls = append(calls, backend.EXPECT().Push("my-image").Return(pushErr))
+			}
+			gomock.InOrder(calls...)
+
+			err := pushWithRetries(backend, "my-image", pushRetryPolicy{Retries: tt.retries})
+			if tt.wantErr != (err != nil) {
+				t.Errorf("expected error: %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestSelectBackend(t *testing.T) {
 	tests := []struct {
 		name        string
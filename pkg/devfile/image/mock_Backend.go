@@ -49,6 +49,21 @@ func (mr *MockBackendMockRecorder) Build(fs, image, devfilePath interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), fs, image, devfilePath)
 }
 
+// GetDigest mocks base method.
+func (m *MockBackend) GetDigest(image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigest", image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigest indicates an expected call of GetDigest.
+func (mr *MockBackendMockRecorder) GetDigest(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigest", reflect.TypeOf((*MockBackend)(nil).GetDigest), image)
+}
+
 // Push mocks base method.
 func (m *MockBackend) Push(image string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,72 @@
+package image
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// TagStrategy defines how the tags of the images built are computed
+type TagStrategy string
+
+const (
+	// TagStrategyNone keeps the tags defined in the Devfile
+	TagStrategyNone TagStrategy = ""
+	// TagStrategyLatest tags the images with "latest"
+	TagStrategyLatest TagStrategy = "latest"
+	// TagStrategyGitSHA tags the images with the short SHA of the commit checked out in the component directory
+	TagStrategyGitSHA TagStrategy = "git-sha"
+	// TagStrategyTimestamp tags the images with the current UTC time, in the YYYYMMDDHHMMSS format
+	TagStrategyTimestamp TagStrategy = "timestamp"
+)
+
+// TagStrategies are the valid values for a TagStrategy, other than TagStrategyNone
+var TagStrategies = []TagStrategy{TagStrategyLatest, TagStrategyGitSHA, TagStrategyTimestamp}
+
+// GetTag returns the tag to use for the images depending on the strategy.
+// An empty tag is returned for TagStrategyNone.
+func GetTag(strategy TagStrategy, componentDir string, now time.Time) (string, error) {
+	switch strategy {
+	case TagStrategyNone:
+		return "", nil
+	case TagStrategyLatest:
+		return "latest", nil
+	case TagStrategyTimestamp:
+		return now.UTC().Format("20060102150405"), nil
+	case TagStrategyGitSHA:
+		repo, err := git.PlainOpenWithOptions(componentDir, &git.PlainOpenOptions{DetectDotGit: true})
+		if err != nil {
+			return "", fmt.Errorf("unable to use the %q tag strategy: %w", strategy, err)
+		}
+		head, err := repo.Head()
+		if err != nil {
+			return "", fmt.Errorf("unable to use the %q tag strategy: %w", strategy, err)
+		}
+		return head.Hash().String()[:7], nil
+	default:
+		return "", fmt.Errorf("unknown tag strategy %q", strategy)
+	}
+}
+
+// WithTag returns the image name with its tag replaced (or added) with tag.
+// An error is returned if the image is referenced by its digest.
+func WithTag(imageName string, tag string) (string, error) {
+	if strings.Contains(imageName, "@") {
+		return "", fmt.Errorf("cannot tag image %q referenced by its digest", imageName)
+	}
+	return getRepository(imageName) + ":" + tag, nil
+}
+
+// getRepository returns the image name without its tag or digest.
+// A colon is part of the tag only if it is placed after the last slash, as it can also separate the registry host and port.
+func getRepository(imageName string) string {
+	if i := strings.Index(imageName, "@"); i != -1 {
+		imageName = imageName[:i]
+	}
+	if i := strings.LastIndex(imageName, ":"); i != -1 && i > strings.LastIndex(imageName, "/") {
+		imageName = imageName[:i]
+	}
+	return imageName
+}
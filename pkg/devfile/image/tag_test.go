@@ -0,0 +1,99 @@
+package image
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetTag(t *testing.T) {
+	now := time.Date(2023, 7, 14, 10, 11, 12, 0, time.FixedZone("CEST", 2*60*60))
+	tests := []struct {
+		name     string
+		strategy TagStrategy
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "no strategy",
+			strategy: TagStrategyNone,
+			want:     "",
+		},
+		{
+			name:     "latest",
+			strategy: TagStrategyLatest,
+			want:     "latest",
+		},
+		{
+			name:     "timestamp in UTC",
+			strategy: TagStrategyTimestamp,
+			want:     "20230714081112",
+		},
+		{
+			name:     "git-sha outside of a git repository",
+			strategy: TagStrategyGitSHA,
+			wantErr:  true,
+		},
+		{
+			name:     "unknown strategy",
+			strategy: "semver",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetTag(tt.strategy, t.TempDir(), now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTag(t *testing.T) {
+	tests := []struct {
+		name      string
+		imageName string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "image without tag",
+			imageName: "quay.io/user/app",
+			want:      "quay.io/user/app:abc1234",
+		},
+		{
+			name:      "image with tag",
+			imageName: "quay.io/user/app:v1",
+			want:      "quay.io/user/app:abc1234",
+		},
+		{
+			name:      "registry with port",
+			imageName: "localhost:5000/app",
+			want:      "localhost:5000/app:abc1234",
+		},
+		{
+			name:      "registry with port and tag",
+			imageName: "localhost:5000/app:v1",
+			want:      "localhost:5000/app:abc1234",
+		},
+		{
+			name:      "image with digest",
+			imageName: "quay.io/user/app@sha256:0123456789",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WithTag(tt.imageName, "abc1234")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("WithTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Package profile handles the profiles defined in a Devfile, selecting the commands and environment
+// used by odo dev, and the profile active for the component.
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// ProfilesAttribute is the Devfile attribute defining the profiles of the component, indexed by name
+	ProfilesAttribute = "odo.dev/profiles"
+
+	// activeProfileFile is the file, in the .odo directory of the component, containing the name of the active profile
+	activeProfileFile = "profile"
+)
+
+// Profile is a set of commands and environment variables used by odo dev
+type Profile struct {
+	// Name of the profile
+	Name string `json:"-"`
+	// BuildCommand is the name of the build command; the default one is used if empty
+	BuildCommand string `json:"buildCommand,omitempty"`
+	// RunCommand is the name of the run command; the default one is used if empty
+	RunCommand string `json:"runCommand,omitempty"`
+	// Debug indicates to execute the debug command instead of the run command
+	Debug bool `json:"debug,omitempty"`
+	// DebugCommand is the name of the debug command; the default one is used if empty
+	DebugCommand string `json:"debugCommand,omitempty"`
+	// Env are environment variables to add to the containers running the run (or debug) command
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// List returns the profiles defined in the Devfile, sorted by name
+func List(devfileObj parser.DevfileObj) ([]Profile, error) {
+	if devfileObj.Data == nil || devfileObj.Data.GetSchemaVersion() == string(data.APISchemaVersion200) {
+		// attributes are not supported by 2.0.0
+		return nil, nil
+	}
+	attributes, err := devfileObj.Data.GetAttributes()
+	if err != nil {
+		return nil, err
+	}
+	if !attributes.Exists(ProfilesAttribute) {
+		return nil, nil
+	}
+	var profiles map[string]Profile
+	err = attributes.GetInto(ProfilesAttribute, &profiles)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %q attribute in the Devfile: %w", ProfilesAttribute, err)
+	}
+	result := make([]Profile, 0, len(profiles))
+	for name, p := range profiles {
+		p.Name = name
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// Get returns the profile with the given name defined in the Devfile
+func Get(devfileObj parser.DevfileObj, name string) (Profile, error) {
+	profiles, err := List(devfileObj)
+	if err != nil {
+		return Profile{}, err
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Profile{}, NewNotFoundError(name, profiles)
+}
+
+// Next returns the name of the profile following current in the profiles defined in the Devfile,
+// or the first profile if current is not found. An empty string is returned if no profile is defined.
+func Next(devfileObj parser.DevfileObj, current string) (string, error) {
+	profiles, err := List(devfileObj)
+	if err != nil || len(profiles) == 0 {
+		return "", err
+	}
+	for i, p := range profiles {
+		if p.Name == current {
+			return profiles[(i+1)%len(profiles)].Name, nil
+		}
+	}
+	return profiles[0].Name, nil
+}
+
+// NotFoundError is returned when a profile is not defined in the Devfile
+type NotFoundError struct {
+	Name      string
+	Available []string
+}
+
+func NewNotFoundError(name string, profiles []Profile) NotFoundError {
+	available := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		available = append(available, p.Name)
+	}
+	return NotFoundError{
+		Name:      name,
+		Available: available,
+	}
+}
+
+func (e NotFoundError) Error() string {
+	if len(e.Available) == 0 {
+		return fmt.Sprintf("profile %q not found, no profile is defined in the %q attribute of the Devfile", e.Name, ProfilesAttribute)
+	}
+	return fmt.Sprintf("profile %q not found in the Devfile, available profiles: %s", e.Name, strings.Join(e.Available, ", "))
+}
+
+// GetActiveFilePath returns the path of the file containing the name of the active profile of the component in workingDir
+func GetActiveFilePath(workingDir string) string {
+	return filepath.Join(workingDir, util.DotOdoDirectory, activeProfileFile)
+}
+
+// GetActive returns the name of the active profile of the component in workingDir, or an empty string if no profile is active
+func GetActive(fsys filesystem.Filesystem, workingDir string) (string, error) {
+	content, err := fsys.ReadFile(GetActiveFilePath(workingDir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// SetActive sets the profile as the active profile of the component in workingDir
+func SetActive(fsys filesystem.Filesystem, workingDir string, name string) error {
+	path := GetActiveFilePath(workingDir)
+	err := fsys.MkdirAll(filepath.Dir(path), 0750)
+	if err != nil {
+		return err
+	}
+	return fsys.WriteFile(path, []byte(name+"\n"), 0644)
+}
@@ -0,0 +1,144 @@
+package profile
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devfile/library/v2/pkg/devfile"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func parseDevfile(t *testing.T, attributes string) parser.DevfileObj {
+	content := "schemaVersion: 2.2.0\nmetadata:\n  name: my-component\n"
+	if attributes != "" {
+		content += "attributes:" + attributes
+	}
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{
+		Data:             []byte(content),
+		FlattenedDevfile: pointer.Bool(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return devfileObj
+}
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes string
+		want       []Profile
+		wantErr    bool
+	}{
+		{
+			name: "no profiles",
+			want: nil,
+		},
+		{
+			name: "profiles sorted by name",
+			attributes: `
+  odo.dev/profiles:
+    test:
+      buildCommand: build
+      runCommand: test
+    dev-debug:
+      debug: true
+      env:
+        LOG_LEVEL: debug
+`,
+			want: []Profile{
+				{
+					Name:  "dev-debug",
+					Debug: true,
+					Env:   map[string]string{"LOG_LEVEL": "debug"},
+				},
+				{
+					Name:         "test",
+					BuildCommand: "build",
+					RunCommand:   "test",
+				},
+			},
+		},
+		{
+			name: "invalid profiles",
+			attributes: `
+  odo.dev/profiles:
+    - test
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := List(parseDevfile(t, tt.attributes))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAndNext(t *testing.T) {
+	devfileObj := parseDevfile(t, `
+  odo.dev/profiles:
+    dev: {}
+    dev-debug:
+      debug: true
+    test:
+      runCommand: test
+`)
+
+	got, err := Get(devfileObj, "test")
+	if err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+	if got.RunCommand != "test" {
+		t.Errorf("Get() = %+v", got)
+	}
+	_, err = Get(devfileObj, "prod")
+	var notFoundErr NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Fatalf("Get() error = %v, want a NotFoundError", err)
+	}
+	if want := `profile "prod" not found in the Devfile, available profiles: dev, dev-debug, test`; err.Error() != want {
+		t.Errorf("Get() error = %q, want %q", err.Error(), want)
+	}
+
+	for current, want := range map[string]string{
+		"":          "dev",
+		"dev":       "dev-debug",
+		"dev-debug": "test",
+		"test":      "dev",
+		"removed":   "dev",
+	} {
+		got, err := Next(devfileObj, current)
+		if err != nil || got != want {
+			t.Errorf("Next(%q) = %q, %v, want %q", current, got, err, want)
+		}
+	}
+}
+
+func TestActive(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	dir := "/path/to/component"
+
+	got, err := GetActive(fs, dir)
+	if err != nil || got != "" {
+		t.Errorf("GetActive() = %q, %v, want no active profile", got, err)
+	}
+	err = SetActive(fs, dir, "dev-debug")
+	if err != nil {
+		t.Fatalf("SetActive() unexpected error: %v", err)
+	}
+	got, err = GetActive(fs, dir)
+	if err != nil || got != "dev-debug" {
+		t.Errorf("GetActive() = %q, %v, want %q", got, err, "dev-debug")
+	}
+}
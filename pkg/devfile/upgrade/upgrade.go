@@ -0,0 +1,443 @@
+// Package upgrade upgrades a project Devfile to a more recent version of the stack it has been created from
+package upgrade
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/blang/semver"
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+// Upgrade applies on the project Devfile the changes made to the stack between its base and target versions.
+// The elements (components and commands) of the project Devfile different from the base version have been customized by the user:
+// they are preserved, and the related changes are returned with Preserved set to true.
+// base is nil when the version of the stack the project Devfile is based on is unknown:
+// in this case, the elements of the project Devfile different from the target version are all considered as customized.
+// effective is the project Devfile with its parent flattened, if known: the elements added to the stack
+// and already inherited from the parent are not added to the project Devfile, to not duplicate them.
+// The project Devfile is modified in memory only, it is the responsibility of the caller to write it.
+func Upgrade(project parser.DevfileObj, effective *parser.DevfileObj, base *parser.DevfileObj, target parser.DevfileObj) ([]api.DevfileChange, error) {
+	var baseData, effectiveData data.DevfileData
+	if base != nil {
+		baseData = base.Data
+	}
+	if effective != nil {
+		effectiveData = effective.Data
+	}
+
+	var changes []api.DevfileChange
+	parentChange, err := upgradeParent(project.Data, baseData, target.Data)
+	if err != nil {
+		return nil, err
+	}
+	if parentChange != nil {
+		changes = append(changes, *parentChange)
+	}
+
+	componentsUpgrade, err := newComponentsUpgrade(project.Data, effectiveData, baseData, target.Data)
+	if err != nil {
+		return nil, err
+	}
+	componentChanges, err := componentsUpgrade.apply()
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, componentChanges...)
+
+	commandsUpgrade, err := newCommandsUpgrade(project.Data, effectiveData, baseData, target.Data)
+	if err != nil {
+		return nil, err
+	}
+	commandChanges, err := commandsUpgrade.apply()
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, commandChanges...)
+
+	metadata := project.Data.GetMetadata()
+	metadata.Version = target.Data.GetMetadata().Version
+	project.Data.SetMetadata(metadata)
+
+	if isMoreRecent(target.Data.GetSchemaVersion(), project.Data.GetSchemaVersion()) {
+		project.Data.SetSchemaVersion(target.Data.GetSchemaVersion())
+	}
+
+	return changes, nil
+}
+
+// elementsUpgrade contains the elements of the same type (components or commands)
+// of the project, base and target Devfiles, indexed by name, and the functions to modify the project Devfile
+type elementsUpgrade struct {
+	kind func(name string) api.DevfileElementKind
+
+	projectElements map[string]interface{}
+	baseElements    map[string]interface{}
+	targetElements  map[string]interface{}
+	// inheritedNames are the names of the elements inherited from the parent of the project Devfile
+	inheritedNames map[string]struct{}
+	// targetNames and baseNames are the names of the elements, in the order of the Devfiles
+	targetNames []string
+	baseNames   []string
+
+	add    func(element interface{}) error
+	update func(element interface{}) error
+	remove func(name string) error
+}
+
+func newComponentsUpgrade(project, effective, base, target data.DevfileData) (elementsUpgrade, error) {
+	getComponents := func(d data.DevfileData) (map[string]interface{}, []string, error) {
+		if d == nil {
+			return nil, nil, nil
+		}
+		components, err := d.GetComponents(parsercommon.DevfileOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		result := make(map[string]interface{}, len(components))
+		names := make([]string, 0, len(components))
+		for _, component := range components {
+			result[component.Name] = component
+			names = append(names, component.Name)
+		}
+		return result, names, nil
+	}
+
+	var (
+		res elementsUpgrade
+		err error
+	)
+	if res.projectElements, _, err = getComponents(project); err != nil {
+		return res, err
+	}
+	if res.baseElements, res.baseNames, err = getComponents(base); err != nil {
+		return res, err
+	}
+	if res.targetElements, res.targetNames, err = getComponents(target); err != nil {
+		return res, err
+	}
+	effectiveElements, _, err := getComponents(effective)
+	if err != nil {
+		return res, err
+	}
+	res.inheritedNames = inheritedNames(res.projectElements, effectiveElements)
+	res.kind = func(name string) api.DevfileElementKind {
+		for _, elements := range []map[string]interface{}{res.targetElements, res.baseElements} {
+			if component, ok := elements[name]; ok && component.(devfilev1.Component).Image != nil {
+				return api.DevfileElementImage
+			}
+		}
+		return api.DevfileElementComponent
+	}
+	res.add = func(element interface{}) error {
+		return project.AddComponents([]devfilev1.Component{element.(devfilev1.Component)})
+	}
+	res.update = func(element interface{}) error {
+		return project.UpdateComponent(element.(devfilev1.Component))
+	}
+	res.remove = project.DeleteComponent
+	return res, nil
+}
+
+func newCommandsUpgrade(project, effective, base, target data.DevfileData) (elementsUpgrade, error) {
+	getCommands := func(d data.DevfileData) (map[string]interface{}, []string, error) {
+		if d == nil {
+			return nil, nil, nil
+		}
+		commands, err := d.GetCommands(parsercommon.DevfileOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		result := make(map[string]interface{}, len(commands))
+		names := make([]string, 0, len(commands))
+		for _, command := range commands {
+			result[command.Id] = command
+			names = append(names, command.Id)
+		}
+		return result, names, nil
+	}
+
+	var (
+		res elementsUpgrade
+		err error
+	)
+	if res.projectElements, _, err = getCommands(project); err != nil {
+		return res, err
+	}
+	if res.baseElements, res.baseNames, err = getCommands(base); err != nil {
+		return res, err
+	}
+	if res.targetElements, res.targetNames, err = getCommands(target); err != nil {
+		return res, err
+	}
+	effectiveElements, _, err := getCommands(effective)
+	if err != nil {
+		return res, err
+	}
+	res.inheritedNames = inheritedNames(res.projectElements, effectiveElements)
+	res.kind = func(string) api.DevfileElementKind {
+		return api.DevfileElementCommand
+	}
+	res.add = func(element interface{}) error {
+		return project.AddCommands([]devfilev1.Command{element.(devfilev1.Command)})
+	}
+	res.update = func(element interface{}) error {
+		return project.UpdateCommand(element.(devfilev1.Command))
+	}
+	res.remove = project.DeleteCommand
+	return res, nil
+}
+
+// apply modifies the project Devfile and returns the changes between the base and target versions
+func (o elementsUpgrade) apply() ([]api.DevfileChange, error) {
+	var changes []api.DevfileChange
+
+	for _, name := range o.targetNames {
+		targetElement := o.targetElements[name]
+		baseElement, inBase := o.baseElements[name]
+		projectElement, inProject := o.projectElements[name]
+
+		if inBase && reflect.DeepEqual(baseElement, targetElement) {
+			// not changed in the stack
+			continue
+		}
+		if inProject && reflect.DeepEqual(projectElement, targetElement) {
+			// already up to date
+			continue
+		}
+
+		change := api.DevfileChange{
+			Kind:   o.kind(name),
+			Name:   name,
+			Change: api.DevfileChangeModified,
+		}
+		if !inBase && (o.baseElements != nil || !inProject) {
+			change.Change = api.DevfileChangeAdded
+		}
+		if change.Change == api.DevfileChangeModified {
+			previousElement := baseElement
+			if !inBase {
+				previousElement = projectElement
+			}
+			fields, err := diffFields(previousElement, targetElement)
+			if err != nil {
+				return nil, err
+			}
+			change.Fields = fields
+		}
+		_, inherited := o.inheritedNames[name]
+		switch {
+		case !inProject && inBase:
+			// removed by the user
+			change.Preserved = true
+		case !inProject && inherited:
+			// already defined by the parent, adding it would duplicate it
+			change.Inherited = true
+		case !inProject:
+			if err := o.add(targetElement); err != nil {
+				return nil, err
+			}
+		case inBase && reflect.DeepEqual(projectElement, baseElement):
+			if err := o.update(targetElement); err != nil {
+				return nil, err
+			}
+		default:
+			// customized by the user, or unknown base
+			change.Preserved = true
+		}
+		changes = append(changes, change)
+	}
+
+	for _, name := range o.baseNames {
+		if _, inTarget := o.targetElements[name]; inTarget {
+			continue
+		}
+		projectElement, inProject := o.projectElements[name]
+		if !inProject {
+			continue
+		}
+		change := api.DevfileChange{
+			Kind:   o.kind(name),
+			Name:   name,
+			Change: api.DevfileChangeRemoved,
+		}
+		if reflect.DeepEqual(projectElement, o.baseElements[name]) {
+			if err := o.remove(name); err != nil {
+				return nil, err
+			}
+		} else {
+			change.Preserved = true
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// inheritedNames returns the names of the effective elements not defined in the project elements
+func inheritedNames(projectElements, effectiveElements map[string]interface{}) map[string]struct{} {
+	res := map[string]struct{}{}
+	for name := range effectiveElements {
+		if _, ok := projectElements[name]; !ok {
+			res[name] = struct{}{}
+		}
+	}
+	return res
+}
+
+// upgradeParent applies on the project Devfile the change of the parent between the base and target versions, if any.
+// As a Devfile has at most one parent, a parent different from the base version is preserved, and the parent of the target version
+// is never added if the project Devfile already has one.
+func upgradeParent(project, base, target data.DevfileData) (*api.DevfileChange, error) {
+	var (
+		projectParent = project.GetParent()
+		targetParent  = target.GetParent()
+		baseParent    *devfilev1.Parent
+	)
+	if base != nil {
+		baseParent = base.GetParent()
+		if reflect.DeepEqual(baseParent, targetParent) {
+			// not changed in the stack
+			return nil, nil
+		}
+	}
+	if reflect.DeepEqual(projectParent, targetParent) {
+		// already up to date
+		return nil, nil
+	}
+
+	change := api.DevfileChange{
+		Kind:   api.DevfileElementParent,
+		Change: api.DevfileChangeModified,
+	}
+	switch {
+	case targetParent == nil:
+		change.Change = api.DevfileChangeRemoved
+		change.Name = parentName(projectParent)
+	case (base != nil && baseParent == nil) || (base == nil && projectParent == nil):
+		change.Change = api.DevfileChangeAdded
+		change.Name = parentName(targetParent)
+	default:
+		change.Name = parentName(targetParent)
+		previousParent := baseParent
+		if previousParent == nil {
+			previousParent = projectParent
+		}
+		fields, err := diffFields(previousParent, targetParent)
+		if err != nil {
+			return nil, err
+		}
+		change.Fields = fields
+	}
+
+	switch {
+	case projectParent == nil && baseParent != nil:
+		// removed by the user
+		change.Preserved = true
+	case projectParent == nil:
+		project.SetParent(targetParent)
+	case base != nil && reflect.DeepEqual(projectParent, baseParent):
+		project.SetParent(targetParent)
+	default:
+		// customized by the user, or unknown base
+		change.Preserved = true
+	}
+	return &change, nil
+}
+
+// parentName returns the reference of the parent
+func parentName(parent *devfilev1.Parent) string {
+	switch {
+	case parent == nil:
+		return ""
+	case parent.Id != "":
+		if parent.Version != "" {
+			return parent.Id + ":" + parent.Version
+		}
+		return parent.Id
+	case parent.Uri != "":
+		return parent.Uri
+	case parent.Kubernetes != nil:
+		return parent.Kubernetes.Name
+	}
+	return ""
+}
+
+// diffFields returns the changes of the fields between the old and new versions of an element, sorted by path
+func diffFields(oldElement, newElement interface{}) ([]api.DevfileFieldChange, error) {
+	oldValue, err := toGeneric(oldElement)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toGeneric(newElement)
+	if err != nil {
+		return nil, err
+	}
+	var res []api.DevfileFieldChange
+	diffValues("", oldValue, newValue, &res)
+	return res, nil
+}
+
+// toGeneric returns the JSON representation of value as maps, slices and scalars
+func toGeneric(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	err = json.Unmarshal(b, &res)
+	return res, err
+}
+
+func diffValues(path string, oldValue, newValue interface{}, changes *[]api.DevfileFieldChange) {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if !oldIsMap || !newIsMap {
+		*changes = append(*changes, api.DevfileFieldChange{
+			Path: path,
+			Old:  oldValue,
+			New:  newValue,
+		})
+		return
+	}
+
+	keys := make([]string, 0, len(oldMap)+len(newMap))
+	for key := range oldMap {
+		keys = append(keys, key)
+	}
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		subPath := key
+		if path != "" {
+			subPath = path + "." + key
+		}
+		diffValues(subPath, oldMap[key], newMap[key], changes)
+	}
+}
+
+// isMoreRecent returns true if version a is more recent than version b
+func isMoreRecent(a, b string) bool {
+	va, err := semver.ParseTolerant(a)
+	if err != nil {
+		return false
+	}
+	vb, err := semver.ParseTolerant(b)
+	if err != nil {
+		return true
+	}
+	return va.GT(vb)
+}
@@ -0,0 +1,367 @@
+package upgrade
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile"
+)
+
+const baseDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+  version: 2.1.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-14:latest
+- name: prod-image
+  image:
+    imageName: my-image
+    dockerfile:
+      uri: Dockerfile
+- name: cache
+  volume:
+    size: 1Gi
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+    group:
+      kind: build
+      isDefault: true
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+- id: legacy
+  exec:
+    component: runtime
+    commandLine: npm run legacy
+`
+
+const targetDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+  version: 2.2.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+- name: prod-image
+  image:
+    imageName: my-image
+    dockerfile:
+      uri: docker/Dockerfile
+- name: cache
+  volume:
+    size: 1Gi
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm ci
+    group:
+      kind: build
+      isDefault: true
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+- id: debug
+  exec:
+    component: runtime
+    commandLine: npm run debug
+    group:
+      kind: debug
+      isDefault: true
+`
+
+// projectDevfile is based on baseDevfile, with the install command customized by the user
+const projectDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+  version: 2.1.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-14:latest
+- name: prod-image
+  image:
+    imageName: my-image
+    dockerfile:
+      uri: Dockerfile
+- name: cache
+  volume:
+    size: 1Gi
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install --no-audit
+    group:
+      kind: build
+      isDefault: true
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+- id: legacy
+  exec:
+    component: runtime
+    commandLine: npm run legacy
+`
+
+func parseDevfile(t *testing.T, content string) parser.DevfileObj {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	err := os.WriteFile(devfilePath, []byte(content), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return devObj
+}
+
+func TestUpgrade(t *testing.T) {
+	tests := []struct {
+		name            string
+		withBase        bool
+		wantChanges     []api.DevfileChange
+		wantRuntime     string
+		wantInstall     string
+		wantCommandsIds []string
+	}{
+		{
+			name:     "known base version",
+			withBase: true,
+			wantChanges: []api.DevfileChange{
+				{Kind: api.DevfileElementComponent, Name: "runtime", Change: api.DevfileChangeModified, Fields: []api.DevfileFieldChange{
+					{Path: "container.image", Old: "registry.access.redhat.com/ubi8/nodejs-14:latest", New: "registry.access.redhat.com/ubi8/nodejs-16:latest"},
+				}},
+				{Kind: api.DevfileElementImage, Name: "prod-image", Change: api.DevfileChangeModified, Fields: []api.DevfileFieldChange{
+					{Path: "image.dockerfile.uri", Old: "Dockerfile", New: "docker/Dockerfile"},
+				}},
+				{Kind: api.DevfileElementCommand, Name: "install", Change: api.DevfileChangeModified, Preserved: true, Fields: []api.DevfileFieldChange{
+					{Path: "exec.commandLine", Old: "npm install", New: "npm ci"},
+				}},
+				{Kind: api.DevfileElementCommand, Name: "debug", Change: api.DevfileChangeAdded},
+				{Kind: api.DevfileElementCommand, Name: "legacy", Change: api.DevfileChangeRemoved},
+			},
+			wantRuntime:     "registry.access.redhat.com/ubi8/nodejs-16:latest",
+			wantInstall:     "npm install --no-audit",
+			wantCommandsIds: []string{"install", "run", "debug"},
+		},
+		{
+			name:     "unknown base version",
+			withBase: false,
+			wantChanges: []api.DevfileChange{
+				{Kind: api.DevfileElementComponent, Name: "runtime", Change: api.DevfileChangeModified, Preserved: true, Fields: []api.DevfileFieldChange{
+					{Path: "container.image", Old: "registry.access.redhat.com/ubi8/nodejs-14:latest", New: "registry.access.redhat.com/ubi8/nodejs-16:latest"},
+				}},
+				{Kind: api.DevfileElementImage, Name: "prod-image", Change: api.DevfileChangeModified, Preserved: true, Fields: []api.DevfileFieldChange{
+					{Path: "image.dockerfile.uri", Old: "Dockerfile", New: "docker/Dockerfile"},
+				}},
+				{Kind: api.DevfileElementCommand, Name: "install", Change: api.DevfileChangeModified, Preserved: true, Fields: []api.DevfileFieldChange{
+					{Path: "exec.commandLine", Old: "npm install --no-audit", New: "npm ci"},
+				}},
+				{Kind: api.DevfileElementCommand, Name: "debug", Change: api.DevfileChangeAdded},
+			},
+			wantRuntime:     "registry.access.redhat.com/ubi8/nodejs-14:latest",
+			wantInstall:     "npm install --no-audit",
+			wantCommandsIds: []string{"install", "run", "legacy", "debug"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := parseDevfile(t, projectDevfile)
+			target := parseDevfile(t, targetDevfile)
+			var base *parser.DevfileObj
+			if tt.withBase {
+				b := parseDevfile(t, baseDevfile)
+				base = &b
+			}
+
+			got, err := Upgrade(project, nil, base, target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantChanges, got); diff != "" {
+				t.Errorf("Upgrade() changes mismatch (-want +got):\n%s", diff)
+			}
+
+			if v := project.Data.GetSchemaVersion(); v != "2.2.0" {
+				t.Errorf("expected schema version 2.2.0, got %q", v)
+			}
+			metadata := project.Data.GetMetadata()
+			if metadata.Name != "my-app" || metadata.Version != "2.2.0" {
+				t.Errorf("unexpected metadata %+v", metadata)
+			}
+
+			components, err := project.Data.GetComponents(parsercommon.DevfileOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range components {
+				if c.Name == "runtime" && c.Container.Image != tt.wantRuntime {
+					t.Errorf("expected runtime image %q, got %q", tt.wantRuntime, c.Container.Image)
+				}
+			}
+
+			commands, err := project.Data.GetCommands(parsercommon.DevfileOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, c := range commands {
+				ids = append(ids, c.Id)
+				if c.Id == "install" && c.Exec.CommandLine != tt.wantInstall {
+					t.Errorf("expected install command %q, got %q", tt.wantInstall, c.Exec.CommandLine)
+				}
+			}
+			if diff := cmp.Diff(tt.wantCommandsIds, ids); diff != "" {
+				t.Errorf("commands mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgrade_Parent(t *testing.T) {
+	const (
+		parentV1 = "parent:\n  id: nodejs-base\n  version: 1.0.0\n"
+		parentV2 = "parent:\n  id: nodejs-base\n  version: 2.0.0\n"
+		parentV3 = "parent:\n  id: nodejs-base\n  version: 3.0.0\n"
+	)
+	withParent := func(content, parent string) string {
+		return strings.Replace(content, "components:\n", parent+"components:\n", 1)
+	}
+	tests := []struct {
+		name           string
+		project        string
+		base           string
+		target         string
+		wantChanges    []api.DevfileChange
+		wantParentVers string
+	}{
+		{
+			name:           "parent not customized is upgraded",
+			project:        withParent(projectDevfile, parentV1),
+			base:           withParent(baseDevfile, parentV1),
+			target:         withParent(baseDevfile, parentV2),
+			wantParentVers: "2.0.0",
+			wantChanges: []api.DevfileChange{
+				{Kind: api.DevfileElementParent, Name: "nodejs-base:2.0.0", Change: api.DevfileChangeModified, Fields: []api.DevfileFieldChange{
+					{Path: "version", Old: "1.0.0", New: "2.0.0"},
+				}},
+			},
+		},
+		{
+			name:           "parent already up to date is not added again",
+			project:        withParent(projectDevfile, parentV2),
+			base:           baseDevfile,
+			target:         withParent(baseDevfile, parentV2),
+			wantParentVers: "2.0.0",
+		},
+		{
+			name:           "parent customized is preserved",
+			project:        withParent(projectDevfile, parentV3),
+			base:           baseDevfile,
+			target:         withParent(baseDevfile, parentV2),
+			wantParentVers: "3.0.0",
+			wantChanges: []api.DevfileChange{
+				{Kind: api.DevfileElementParent, Name: "nodejs-base:2.0.0", Change: api.DevfileChangeAdded, Preserved: true},
+			},
+		},
+		{
+			name:           "parent added to the stack",
+			project:        projectDevfile,
+			base:           baseDevfile,
+			target:         withParent(baseDevfile, parentV2),
+			wantParentVers: "2.0.0",
+			wantChanges: []api.DevfileChange{
+				{Kind: api.DevfileElementParent, Name: "nodejs-base:2.0.0", Change: api.DevfileChangeAdded},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := parseDevfile(t, tt.project)
+			base := parseDevfile(t, tt.base)
+			target := parseDevfile(t, tt.target)
+
+			got, err := Upgrade(project, nil, &base, target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var parentChanges []api.DevfileChange
+			for _, change := range got {
+				if change.Kind == api.DevfileElementParent {
+					parentChanges = append(parentChanges, change)
+				}
+			}
+			if diff := cmp.Diff(tt.wantChanges, parentChanges); diff != "" {
+				t.Errorf("Upgrade() parent changes mismatch (-want +got):\n%s", diff)
+			}
+			parent := project.Data.GetParent()
+			if parent == nil || parent.Version != tt.wantParentVers {
+				t.Errorf("expected parent version %q, got %+v", tt.wantParentVers, parent)
+			}
+		})
+	}
+}
+
+func TestUpgrade_Inherited(t *testing.T) {
+	project := parseDevfile(t, projectDevfile)
+	base := parseDevfile(t, baseDevfile)
+	target := parseDevfile(t, targetDevfile)
+	// the debug command added to the stack is already defined by the parent of the project Devfile
+	effective := parseDevfile(t, projectDevfile+`- id: debug
+  exec:
+    component: runtime
+    commandLine: npm run debug:parent
+`)
+
+	got, err := Upgrade(project, &effective, &base, target)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var debugChange *api.DevfileChange
+	for i := range got {
+		if got[i].Name == "debug" {
+			debugChange = &got[i]
+		}
+	}
+	want := &api.DevfileChange{Kind: api.DevfileElementCommand, Name: "debug", Change: api.DevfileChangeAdded, Inherited: true}
+	if diff := cmp.Diff(want, debugChange); diff != "" {
+		t.Errorf("Upgrade() debug change mismatch (-want +got):\n%s", diff)
+	}
+
+	commands, err := project.Data.GetCommands(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range commands {
+		if c.Id == "debug" {
+			t.Errorf("the debug command inherited from the parent should not be added to the project Devfile")
+		}
+	}
+}
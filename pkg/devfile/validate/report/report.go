@@ -0,0 +1,407 @@
+// Package report validates a Devfile and reports all the problems found, with the line of the Devfile they relate to
+package report
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/devfile/api/v2/pkg/validation/variables"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	devfilevalidate "github.com/devfile/library/v2/pkg/devfile/validate"
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v3"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odovalidate "github.com/redhat-developer/odo/pkg/devfile/validate"
+)
+
+// latestSupportedSchemaVersion is the most recent Devfile schema version supported by odo
+var latestSupportedSchemaVersion = semver.MustParse(string(data.APISchemaVersion220))
+
+var yamlErrorLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// ValidateFile parses and validates the Devfile at devfilePath, and returns all the problems found,
+// with the line of the Devfile they relate to, when it can be determined.
+// Contrary to the parsing done before running the other commands, it does not stop at the first problem.
+// An error is returned only if the file cannot be read.
+func ValidateFile(devfilePath string) (api.DevfileValidation, error) {
+	content, err := os.ReadFile(devfilePath)
+	if err != nil {
+		return api.DevfileValidation{}, err
+	}
+
+	r := &reporter{}
+
+	var root yaml.Node
+	err = yaml.Unmarshal(content, &root)
+	if err != nil {
+		r.add(api.DevfileProblemError, lineFromYamlError(err), "invalid YAML: %v", err)
+		return r.result(devfilePath), nil
+	}
+	lines := devfileLines{root: documentContent(&root)}
+
+	if !r.checkSchemaVersion(lines) {
+		return r.result(devfilePath), nil
+	}
+
+	devfileObj, err := parser.ParseDevfile(parser.ParserArgs{
+		Path:                          devfilePath,
+		FlattenedDevfile:              pointer.Bool(true),
+		ConvertKubernetesContentInUri: pointer.Bool(false),
+		SetBooleanDefaults:            pointer.Bool(false),
+	})
+	if err != nil {
+		parentLine := lines.key("parent")
+		if parentLine == 0 {
+			r.add(api.DevfileProblemError, lines.locate(err.Error()), "%v", err)
+			return r.result(devfilePath), nil
+		}
+		// check if the Devfile itself is correct, to know if the problem comes from the parent
+		_, rawErr := parser.ParseDevfile(parser.ParserArgs{
+			Path:                          devfilePath,
+			FlattenedDevfile:              pointer.Bool(false),
+			ConvertKubernetesContentInUri: pointer.Bool(false),
+			SetBooleanDefaults:            pointer.Bool(false),
+		})
+		if rawErr != nil {
+			r.add(api.DevfileProblemError, lines.locate(rawErr.Error()), "%v", rawErr)
+		} else {
+			r.add(api.DevfileProblemError, parentLine, "unable to resolve parent: %v", err)
+		}
+		return r.result(devfilePath), nil
+	}
+
+	if devfileObj.Data.GetSchemaVersion() != string(data.APISchemaVersion200) {
+		// variables are not supported by 2.0.0
+		varWarnings := variables.ValidateAndReplaceGlobalVariable(devfileObj.Data.GetDevfileWorkspaceSpec())
+		r.addVariableWarnings(lines, varWarnings)
+	}
+
+	for _, e := range flattenErrors(devfilevalidate.ValidateDevfileData(devfileObj.Data)) {
+		severity := api.DevfileProblemError
+		var missingDefault *validation.MissingDefaultCmdWarning
+		if errors.As(e, &missingDefault) {
+			severity = api.DevfileProblemWarning
+		}
+		r.add(severity, lines.locate(e.Error()), "%v", e)
+	}
+
+	if err = odovalidate.ValidateDevfileData(devfileObj.Data); err != nil {
+		r.add(api.DevfileProblemError, lines.locate(err.Error()), "%v", err)
+	}
+
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return api.DevfileValidation{}, err
+	}
+	commands, err := devfileObj.Data.GetCommands(parsercommon.DevfileOptions{})
+	if err != nil {
+		return api.DevfileValidation{}, err
+	}
+	r.checkRunCommand(lines, commands)
+	r.checkEndpoints(lines, components)
+	r.checkOverrides(lines, devfileObj, components)
+
+	return r.result(devfilePath), nil
+}
+
+type reporter struct {
+	problems []api.DevfileProblem
+}
+
+func (o *reporter) add(severity api.DevfileProblemSeverity, line int, format string, a ...interface{}) {
+	o.problems = append(o.problems, api.DevfileProblem{
+		Severity: severity,
+		Line:     line,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// result returns the problems found, ordered by line
+func (o *reporter) result(devfilePath string) api.DevfileValidation {
+	sort.SliceStable(o.problems, func(i, j int) bool {
+		return o.problems[i].Line < o.problems[j].Line
+	})
+	res := api.DevfileValidation{
+		DevfilePath: devfilePath,
+		Problems:    o.problems,
+	}
+	res.Valid = !res.HasErrors()
+	return res
+}
+
+// checkSchemaVersion checks that the schema version of the Devfile is supported by odo,
+// and returns false if the Devfile cannot be parsed
+func (o *reporter) checkSchemaVersion(lines devfileLines) bool {
+	node := lines.value("schemaVersion")
+	if node == nil {
+		o.add(api.DevfileProblemError, 0, "schemaVersion is missing")
+		return false
+	}
+	version, err := semver.Parse(node.Value)
+	if err != nil {
+		o.add(api.DevfileProblemError, node.Line, "invalid schemaVersion %q: %v", node.Value, err)
+		return false
+	}
+	if version.Major != latestSupportedSchemaVersion.Major {
+		o.add(api.DevfileProblemError, node.Line, "schemaVersion %s is not supported, only versions %d.x.x are supported", version, latestSupportedSchemaVersion.Major)
+		return false
+	}
+	if version.Minor > latestSupportedSchemaVersion.Minor {
+		o.add(api.DevfileProblemWarning, node.Line, "schemaVersion %s is more recent than the latest version supported by odo (%s), some features may be ignored",
+			version, latestSupportedSchemaVersion)
+	}
+	return true
+}
+
+func (o *reporter) addVariableWarnings(lines devfileLines, warnings variables.VariableWarning) {
+	sections := []struct {
+		name     string
+		warnings map[string][]string
+	}{
+		{"components", warnings.Components},
+		{"commands", warnings.Commands},
+		{"projects", warnings.Projects},
+		{"starterProjects", warnings.StarterProjects},
+	}
+	for _, section := range sections {
+		names := make([]string, 0, len(section.warnings))
+		for name := range section.warnings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			o.add(api.DevfileProblemWarning, lines.item(section.name, name), "unresolved variable(s) %s in %s %q",
+				strings.Join(section.warnings[name], ", "), section.name, name)
+		}
+	}
+}
+
+// checkRunCommand checks that a command of kind run is defined, needed by `odo dev`
+func (o *reporter) checkRunCommand(lines devfileLines, commands []devfilev1.Command) {
+	for _, command := range commands {
+		group := parsercommon.GetGroup(command)
+		if group != nil && group.Kind == devfilev1.RunCommandGroupKind {
+			return
+		}
+	}
+	o.add(api.DevfileProblemWarning, lines.key("commands"), "no command of kind %q is defined, the component cannot be run with `odo dev`", devfilev1.RunCommandGroupKind)
+}
+
+// checkEndpoints checks that a container does not expose the same port through several endpoints,
+// as the port is forwarded only once
+func (o *reporter) checkEndpoints(lines devfileLines, components []devfilev1.Component) {
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		endpointsByPort := map[int]string{}
+		for _, endpoint := range component.Container.Endpoints {
+			other, found := endpointsByPort[endpoint.TargetPort]
+			if !found {
+				endpointsByPort[endpoint.TargetPort] = endpoint.Name
+				continue
+			}
+			o.add(api.DevfileProblemWarning, lines.endpoint(component.Name, endpoint.Name),
+				"endpoints %q and %q of container %q use the same port %d, it will be forwarded only once",
+				other, endpoint.Name, component.Name, endpoint.TargetPort)
+		}
+	}
+}
+
+// checkOverrides checks that the container-overrides and pod-overrides attributes can be applied
+// to the pod generated for the container components
+func (o *reporter) checkOverrides(lines devfileLines, devfileObj parser.DevfileObj, components []devfilev1.Component) {
+	var withOverrides []devfilev1.Component
+	for _, component := range components {
+		if component.Container != nil &&
+			(component.Attributes.Exists(generator.ContainerOverridesAttribute) || component.Attributes.Exists(generator.PodOverridesAttribute)) {
+			withOverrides = append(withOverrides, component)
+		}
+	}
+	topLevelPodOverrides := lines.attribute("", generator.PodOverridesAttribute)
+	if len(withOverrides) == 0 && topLevelPodOverrides == 0 {
+		return
+	}
+
+	_, err := generator.GetPodTemplateSpec(devfileObj, generator.PodTemplateParams{})
+	if err == nil {
+		return
+	}
+	// the library reports the errors of a component as "... on component <name>: ..." or "... (component <name>)",
+	// the delimiters prevent confusing components whose names share a prefix (e.g. web and web-app)
+	line := topLevelPodOverrides
+	for _, component := range withOverrides {
+		if attribute := overridesAttributeInError(err.Error(), component.Name); attribute != "" {
+			line = lines.attribute(component.Name, attribute)
+			break
+		}
+	}
+	o.add(api.DevfileProblemError, line, "%v", err)
+}
+
+// overridesAttributeInError returns the overrides attribute of the component reported by the message
+// of an error returned by the Devfile library, or an empty string if the message is not related to the component
+func overridesAttributeInError(message string, component string) string {
+	for _, attribute := range []string{generator.ContainerOverridesAttribute, generator.PodOverridesAttribute} {
+		if strings.Contains(message, fmt.Sprintf("%s attribute on component %s:", attribute, component)) {
+			return attribute
+		}
+	}
+	if strings.Contains(message, fmt.Sprintf("%s to override pod", generator.PodOverridesAttribute)) &&
+		strings.Contains(message, fmt.Sprintf("(component %s)", component)) {
+		return generator.PodOverridesAttribute
+	}
+	return ""
+}
+
+// flattenErrors returns the individual errors aggregated in err
+func flattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	var merr *multierror.Error
+	if errors.As(multierror.Flatten(err), &merr) {
+		return merr.Errors
+	}
+	return []error{err}
+}
+
+func lineFromYamlError(err error) int {
+	matches := yamlErrorLineRegex.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return 0
+	}
+	line, _ := strconv.Atoi(matches[1])
+	return line
+}
+
+// documentContent returns the top-level mapping of a YAML document
+func documentContent(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		return node.Content[0]
+	}
+	return node
+}
+
+// devfileLines locates the elements of a Devfile in its YAML representation
+type devfileLines struct {
+	root *yaml.Node
+}
+
+// mappingValue returns the key and value nodes of key in the mapping node, or nil if not found
+func mappingValue(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// namedItem returns the item of the sequence node with the given name or id
+func namedItem(node *yaml.Node, name string) *yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for _, item := range node.Content {
+		for _, key := range []string{"name", "id"} {
+			if _, v := mappingValue(item, key); v != nil && v.Value == name {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
+func (o devfileLines) value(key string) *yaml.Node {
+	_, v := mappingValue(o.root, key)
+	return v
+}
+
+// key returns the line of a top-level key
+func (o devfileLines) key(key string) int {
+	k, _ := mappingValue(o.root, key)
+	if k == nil {
+		return 0
+	}
+	return k.Line
+}
+
+// item returns the line of the element with the given name (or id) in a top-level section
+func (o devfileLines) item(section, name string) int {
+	item := namedItem(o.value(section), name)
+	if item == nil {
+		return 0
+	}
+	return item.Line
+}
+
+// endpoint returns the line of an endpoint of a container component
+func (o devfileLines) endpoint(component, endpoint string) int {
+	comp := namedItem(o.value("components"), component)
+	_, container := mappingValue(comp, "container")
+	_, endpoints := mappingValue(container, "endpoints")
+	if item := namedItem(endpoints, endpoint); item != nil {
+		return item.Line
+	}
+	if comp != nil {
+		return comp.Line
+	}
+	return 0
+}
+
+// attribute returns the line of an attribute of a component, or of a top-level attribute if component is empty
+func (o devfileLines) attribute(component, attribute string) int {
+	node := o.root
+	if component != "" {
+		node = namedItem(o.value("components"), component)
+	}
+	_, attributes := mappingValue(node, "attributes")
+	if k, _ := mappingValue(attributes, attribute); k != nil {
+		return k.Line
+	}
+	if component != "" && node != nil {
+		return node.Line
+	}
+	return 0
+}
+
+// locate returns the line of the first component, command or project whose name appears in message,
+// as the errors returned by the Devfile library do not include the position of the problem
+func (o devfileLines) locate(message string) int {
+	for _, section := range []string{"components", "commands", "projects", "starterProjects", "events"} {
+		node := o.value(section)
+		if node == nil || node.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, item := range node.Content {
+			for _, key := range []string{"name", "id"} {
+				_, v := mappingValue(item, key)
+				if v == nil || v.Value == "" {
+					continue
+				}
+				re := regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(v.Value) + `($|[^\w-])`)
+				if re.MatchString(message) {
+					return item.Line
+				}
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,243 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+const validDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+    endpoints:
+    - name: http
+      targetPort: 3000
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+`
+
+func TestValidateFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantValid bool
+		want      []api.DevfileProblem
+		// wantLines are the lines of the expected problems, when their messages depend on the environment
+		wantLines []int
+	}{
+		{
+			name:      "valid Devfile",
+			content:   validDevfile,
+			wantValid: true,
+		},
+		{
+			name:    "invalid YAML",
+			content: "schemaVersion: 2.2.0\nmetadata:\n  name: [a\n",
+			want: []api.DevfileProblem{
+				{
+					Severity: api.DevfileProblemError,
+					Line:     2,
+					Message:  "invalid YAML: yaml: line 2: did not find expected ',' or ']'",
+				},
+			},
+		},
+		{
+			name:    "unsupported schema version",
+			content: "schemaVersion: 3.0.0\nmetadata:\n  name: my-app\n",
+			want: []api.DevfileProblem{
+				{
+					Severity: api.DevfileProblemError,
+					Line:     1,
+					Message:  "schemaVersion 3.0.0 is not supported, only versions 2.x.x are supported",
+				},
+			},
+		},
+		{
+			name: "unresolved variable, duplicate port and no run command",
+			content: `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: runtime
+  container:
+    image: "{{IMAGE}}"
+    endpoints:
+    - name: http
+      targetPort: 3000
+    - name: http-alt
+      targetPort: 3000
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: npm install
+    group:
+      kind: build
+`,
+			wantValid: true,
+			want: []api.DevfileProblem{
+				{
+					Severity: api.DevfileProblemWarning,
+					Line:     5,
+					Message:  `unresolved variable(s) IMAGE in components "runtime"`,
+				},
+				{
+					Severity: api.DevfileProblemWarning,
+					Line:     11,
+					Message:  `endpoints "http" and "http-alt" of container "runtime" use the same port 3000, it will be forwarded only once`,
+				},
+				{
+					Severity: api.DevfileProblemWarning,
+					Line:     13,
+					Message:  `no command of kind "run" is defined, the component cannot be run with ` + "`odo dev`",
+				},
+			},
+		},
+		{
+			name: "several default commands",
+			content: validDevfile + `- id: run2
+  exec:
+    component: runtime
+    commandLine: npm run dev
+    group:
+      kind: run
+      isDefault: true
+`,
+			want: []api.DevfileProblem{
+				{
+					Severity: api.DevfileProblemError,
+					Line:     12,
+					Message:  "command group run error - there should be exactly one default command, currently there are multiple default commands; command: run; command: run2",
+				},
+			},
+		},
+		{
+			name: "invalid container-overrides",
+			content: `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: runtime
+  attributes:
+    container-overrides:
+      image: other-image
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+`,
+			want: []api.DevfileProblem{
+				{
+					Severity: api.DevfileProblemError,
+					Line:     7,
+					Message:  "failed to parse container-overrides attribute on component runtime: cannot use container-overrides to override container image",
+				},
+			},
+		},
+		{
+			name: "invalid overrides of a component, another component being named as a word of the error",
+			content: `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: pod
+  attributes:
+    container-overrides:
+      resources:
+        limits:
+          memory: 512Mi
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+- name: web-app
+  attributes:
+    pod-overrides:
+      spec:
+        volumes:
+        - name: data
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+commands:
+- id: run
+  exec:
+    component: pod
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+`,
+			wantLines: []int{15},
+		},
+		{
+			name: "valid pod-overrides",
+			content: `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+attributes:
+  pod-overrides:
+    spec:
+      serviceAccountName: my-sa
+` + validDevfile[len("schemaVersion: 2.2.0\nmetadata:\n  name: my-app\n"):],
+			wantValid: true,
+		},
+		{
+			name: "unreachable parent",
+			content: `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+parent:
+  uri: ./does-not-exist/devfile.yaml
+`,
+			wantLines: []int{4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+			err := os.WriteFile(devfilePath, []byte(tt.content), 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := ValidateFile(devfilePath)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Valid != tt.wantValid {
+				t.Errorf("Valid = %v, want %v (problems: %v)", got.Valid, tt.wantValid, got.Problems)
+			}
+			if tt.wantLines != nil {
+				var lines []int
+				for _, problem := range got.Problems {
+					lines = append(lines, problem.Line)
+				}
+				if diff := cmp.Diff(tt.wantLines, lines); diff != "" {
+					t.Errorf("ValidateFile() problem lines mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.want, got.Problems); diff != "" {
+				t.Errorf("ValidateFile() problems mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package exec
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -36,7 +37,10 @@ func (o ExecClient) ExecuteCommand(ctx context.Context, command []string, podNam
 	stdoutCompleteChannel := startReaderGoroutine(soutReader, show, &stdout, stdoutWriter)
 	stderrCompleteChannel := startReaderGoroutine(serrReader, show, &stderr, stderrWriter)
 
-	err = o.platformClient.ExecCMDInContainer(ctx, containerName, podName, command, soutWriter, serrWriter, nil, false)
+	err = o.Exec(ctx, command, podName, containerName, ExecOptions{
+		Stdout: soutWriter,
+		Stderr: serrWriter,
+	})
 
 	// Block until we have received all the container output from each stream
 	_ = soutWriter.Close()
@@ -62,6 +66,48 @@ func (o ExecClient) ExecuteCommand(ctx context.Context, command []string, podNam
 	return stdout, stderr, err
 }
 
+// Exec executes the given command in the pod's container, streaming its standard streams as configured by options.
+// The execution is stopped when ctx is cancelled.
+// If the command exits with a non-zero exit code, an *ExitCodeError is returned.
+func (o ExecClient) Exec(ctx context.Context, command []string, podName string, containerName string, options ExecOptions) error {
+	klog.V(4).Infof("Exec command %v for pod: %v in container: %v (tty: %v)", command, podName, containerName, options.TTY)
+
+	stderr := options.Stderr
+	if options.TTY {
+		// The terminal merges the standard error into the standard output
+		stderr = nil
+	}
+	err := o.platformClient.ExecCMDInContainer(ctx, containerName, podName, command, options.Stdout, stderr, options.Stdin, options.TTY)
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("execution of command %v stopped: %w", command, ctxErr)
+	}
+	if code, ok := getExitCode(err); ok {
+		return &ExitCodeError{
+			Command: command,
+			Code:    code,
+			Err:     err,
+		}
+	}
+	return err
+}
+
+// getExitCode returns the exit code carried by the error returned by the platform,
+// which is a CodeExitError for a cluster and an exec.ExitError for Podman
+func getExitCode(err error) (int, bool) {
+	var statusErr interface{ ExitStatus() int }
+	if errors.As(err, &statusErr) {
+		return statusErr.ExitStatus(), true
+	}
+	var codeErr interface{ ExitCode() int }
+	if errors.As(err, &codeErr) && codeErr.ExitCode() > 0 {
+		return codeErr.ExitCode(), true
+	}
+	return 0, false
+}
+
 // This goroutine will automatically pipe the output from the writer (passed into ExecCMDInContainer) to
 // the loggers.
 // The returned channel will contain a single nil entry once the reader has closed.
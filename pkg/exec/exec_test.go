@@ -1,14 +1,17 @@
 package exec
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sexec "k8s.io/client-go/util/exec"
 )
 
 const (
@@ -101,3 +104,109 @@ func TestExecuteCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestExecClient_Exec(t *testing.T) {
+	for _, tt := range []struct {
+		name               string
+		ctx                func() context.Context
+		tty                bool
+		execCMDInContainer func(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error
+		wantErr            bool
+		wantExitCode       int
+		wantCanceled       bool
+		wantStdout         string
+		wantStderr         string
+	}{
+		{
+			name: "command writing to stdout and stderr",
+			execCMDInContainer: func(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				_, _ = stdout.Write([]byte("some output\n"))
+				_, _ = stderr.Write([]byte("some error\n"))
+				return nil
+			},
+			wantStdout: "some output\n",
+			wantStderr: "some error\n",
+		},
+		{
+			name: "command exiting with a non-zero exit code on a cluster",
+			execCMDInContainer: func(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				_, _ = stderr.Write([]byte("not found\n"))
+				return fmt.Errorf("error while streaming command: %w", k8sexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127})
+			},
+			wantErr:      true,
+			wantExitCode: 127,
+			wantStderr:   "not found\n",
+		},
+		{
+			name: "error not related to the command",
+			execCMDInContainer: func(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				return errors.New("unable to upgrade connection")
+			},
+			wantErr: true,
+		},
+		{
+			name: "context canceled",
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			execCMDInContainer: func(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				return errors.New("stream closed")
+			},
+			wantErr:      true,
+			wantCanceled: true,
+		},
+		{
+			name: "standard error not requested with a TTY",
+			tty:  true,
+			execCMDInContainer: func(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				if !tty || stderr != nil {
+					return errors.New("stderr should not be requested with a TTY")
+				}
+				_, _ = stdout.Write([]byte("some output\r\n"))
+				return nil
+			},
+			wantStdout: "some output\r\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx()
+			}
+			platformClient := fakePlatform{
+				execCMDInContainer: tt.execCMDInContainer,
+			}
+			var stdout, stderr bytes.Buffer
+
+			execClient := NewExecClient(platformClient)
+			err := execClient.Exec(ctx, []string{"my-cmd"}, _podName, _containerName, ExecOptions{
+				Stdout: &stdout,
+				Stderr: &stderr,
+				TTY:    tt.tty,
+			})
+
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("unexpected error %v, wantErr %v", err, tt.wantErr)
+			}
+			var exitCodeErr *ExitCodeError
+			if errors.As(err, &exitCodeErr) {
+				if exitCodeErr.ExitCode() != tt.wantExitCode {
+					t.Errorf("expected exit code %d, got %d", tt.wantExitCode, exitCodeErr.ExitCode())
+				}
+			} else if tt.wantExitCode != 0 {
+				t.Errorf("expected an ExitCodeError with exit code %d, got %v", tt.wantExitCode, err)
+			}
+			if tt.wantCanceled != errors.Is(err, context.Canceled) {
+				t.Errorf("unexpected error %v, wantCanceled %v", err, tt.wantCanceled)
+			}
+			if diff := cmp.Diff(tt.wantStdout, stdout.String()); diff != "" {
+				t.Errorf("ExecClient.Exec() wantStdout mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantStderr, stderr.String()); diff != "" {
+				t.Errorf("ExecClient.Exec() wantStderr mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -9,4 +9,9 @@ type Client interface {
 	// ExecuteCommand executes the given command in the pod's container,
 	// writing the output to the specified respective pipe writers
 	ExecuteCommand(ctx context.Context, command []string, podName string, containerName string, show bool, stdoutWriter *io.PipeWriter, stderrWriter *io.PipeWriter) (stdout []string, stderr []string, err error)
+
+	// Exec executes the given command in the pod's container, streaming its standard streams as configured by options.
+	// The execution is stopped when ctx is cancelled.
+	// If the command exits with a non-zero exit code, an *ExitCodeError is returned.
+	Exec(ctx context.Context, command []string, podName string, containerName string, options ExecOptions) error
 }
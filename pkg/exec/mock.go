@@ -35,6 +35,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// Exec mocks base method.
+func (m *MockClient) Exec(ctx context.Context, command []string, podName, containerName string, options ExecOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", ctx, command, podName, containerName, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockClientMockRecorder) Exec(ctx, command, podName, containerName, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockClient)(nil).Exec), ctx, command, podName, containerName, options)
+}
+
 // ExecuteCommand mocks base method.
 func (m *MockClient) ExecuteCommand(ctx context.Context, command []string, podName, containerName string, show bool, stdoutWriter, stderrWriter *io.PipeWriter) ([]string, []string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,39 @@
+package exec
+
+import (
+	"fmt"
+	"io"
+)
+
+// ExecOptions configures the standard streams of a command executed with Exec
+type ExecOptions struct {
+	// Stdin is passed to the command if not nil
+	Stdin io.Reader
+	// Stdout receives the standard output of the command if not nil
+	Stdout io.Writer
+	// Stderr receives the standard error of the command if not nil.
+	// It is not used when TTY is true, as the standard error is then merged into the standard output by the terminal.
+	Stderr io.Writer
+	// TTY allocates a terminal for the command
+	TTY bool
+}
+
+// ExitCodeError is returned when a command executed in a container exits with a non-zero exit code
+type ExitCodeError struct {
+	Command []string
+	Code    int
+	Err     error
+}
+
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.Code)
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of the command
+func (e *ExitCodeError) ExitCode() int {
+	return e.Code
+}
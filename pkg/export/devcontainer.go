@@ -0,0 +1,301 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+// DevcontainerDockerfile is the name of the Dockerfile referenced by the devcontainer.json file, when generated
+const DevcontainerDockerfile = "Dockerfile"
+
+// Devcontainer is the content of a devcontainer.json file, as defined by https://containers.dev/implementors/json_reference/
+type Devcontainer struct {
+	Name             string                                `json:"name"`
+	Image            string                                `json:"image,omitempty"`
+	Build            *DevcontainerBuild                    `json:"build,omitempty"`
+	WorkspaceFolder  string                                `json:"workspaceFolder,omitempty"`
+	WorkspaceMount   string                                `json:"workspaceMount,omitempty"`
+	ContainerEnv     map[string]string                     `json:"containerEnv,omitempty"`
+	ForwardPorts     []int                                 `json:"forwardPorts,omitempty"`
+	PortsAttributes  map[string]DevcontainerPortAttributes `json:"portsAttributes,omitempty"`
+	Mounts           []string                              `json:"mounts,omitempty"`
+	PostStartCommand string                                `json:"postStartCommand,omitempty"`
+}
+
+// DevcontainerBuild describes how to build the image of the dev container
+type DevcontainerBuild struct {
+	Dockerfile string `json:"dockerfile"`
+}
+
+// DevcontainerPortAttributes are the attributes of a forwarded port
+type DevcontainerPortAttributes struct {
+	Label    string `json:"label,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// NewDevcontainer translates the container component of the default run command of the Devfile
+// (or the first container component if there is no run command) into a dev container.
+// A dev container has a single container: the other container components, and the postStart events
+// not running exec commands in this container, are ignored and reported as warnings.
+func NewDevcontainer(devfileObj parser.DevfileObj) (Devcontainer, []string, error) {
+	var warnings []string
+
+	container, err := mainContainer(devfileObj)
+	if err != nil {
+		return Devcontainer{}, nil, err
+	}
+
+	allContainers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return Devcontainer{}, nil, err
+	}
+	for _, c := range allContainers {
+		if c.Name != container.Name {
+			warnings = append(warnings, fmt.Sprintf("container component %q ignored, a dev container runs a single container", c.Name))
+		}
+	}
+
+	result := Devcontainer{
+		Name:  devfileObj.GetMetadataName(),
+		Image: container.Container.Image,
+	}
+
+	env, err := containerEnv(devfileObj, container.Name)
+	if err != nil {
+		return Devcontainer{}, nil, err
+	}
+	result.ContainerEnv = env
+
+	if container.Container.MountSources == nil || *container.Container.MountSources {
+		sourceMapping := container.Container.SourceMapping
+		if sourceMapping == "" {
+			sourceMapping = generator.DevfileSourceVolumeMount
+		}
+		result.WorkspaceFolder = sourceMapping
+		result.WorkspaceMount = fmt.Sprintf("source=${localWorkspaceFolder},target=%s,type=bind", sourceMapping)
+	}
+
+	for _, endpoint := range container.Container.Endpoints {
+		if endpoint.Exposure == devfilev1.NoneEndpointExposure {
+			continue
+		}
+		result.ForwardPorts = append(result.ForwardPorts, endpoint.TargetPort)
+		if result.PortsAttributes == nil {
+			result.PortsAttributes = map[string]DevcontainerPortAttributes{}
+		}
+		attributes := DevcontainerPortAttributes{Label: endpoint.Name}
+		if endpoint.Protocol == devfilev1.HTTPSEndpointProtocol {
+			attributes.Protocol = "https"
+		}
+		result.PortsAttributes[strconv.Itoa(endpoint.TargetPort)] = attributes
+	}
+
+	mounts, err := volumeMounts(devfileObj, container)
+	if err != nil {
+		return Devcontainer{}, nil, err
+	}
+	result.Mounts = mounts
+
+	handler := &commandsHandler{container: container.Name}
+	err = libdevfile.ExecPostStartEvents(context.Background(), devfileObj, handler)
+	if err != nil {
+		return Devcontainer{}, nil, err
+	}
+	result.PostStartCommand = strings.Join(handler.commandLines, " && ")
+	warnings = append(warnings, handler.warnings...)
+
+	return result, warnings, nil
+}
+
+// UseDockerfile replaces the image of the dev container with a Dockerfile based on this image
+// and defining its environment variables, and returns the content of the Dockerfile
+func (o *Devcontainer) UseDockerfile() []byte {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "FROM %s\n", o.Image)
+	if len(o.ContainerEnv) > 0 {
+		sb.WriteString("\n")
+		names := make([]string, 0, len(o.ContainerEnv))
+		for name := range o.ContainerEnv {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&sb, "ENV %s=%s\n", name, dockerfileQuote(o.ContainerEnv[name]))
+		}
+	}
+	o.Image = ""
+	o.ContainerEnv = nil
+	o.Build = &DevcontainerBuild{Dockerfile: DevcontainerDockerfile}
+	return []byte(sb.String())
+}
+
+// mainContainer returns the container component of the default run command,
+// or the first container component if there is no run command
+func mainContainer(devfileObj parser.DevfileObj) (devfilev1.Component, error) {
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return devfilev1.Component{}, err
+	}
+	if len(containers) == 0 {
+		return devfilev1.Component{}, fmt.Errorf("no container component found in the Devfile")
+	}
+
+	runCommand, found, err := libdevfile.GetCommand(devfileObj, "", devfilev1.RunCommandGroupKind)
+	if err != nil {
+		return devfilev1.Component{}, err
+	}
+	if found {
+		names, err := libdevfile.GetContainerComponentsForCommand(devfileObj, runCommand)
+		if err != nil {
+			return devfilev1.Component{}, err
+		}
+		for _, c := range containers {
+			if len(names) > 0 && c.Name == names[0] {
+				return c, nil
+			}
+		}
+	}
+	return containers[0], nil
+}
+
+// containerEnv returns the environment variables of the container, including the ones defined by odo
+func containerEnv(devfileObj parser.DevfileObj, name string) (map[string]string, error) {
+	containers, err := generator.GetContainers(devfileObj, parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range containers {
+		if c.Name != name {
+			continue
+		}
+		var env map[string]string
+		for _, e := range c.Env {
+			if env == nil {
+				env = map[string]string{}
+			}
+			env[e.Name] = e.Value
+		}
+		return env, nil
+	}
+	return nil, nil
+}
+
+// volumeMounts returns the mounts of the volume components used by the container:
+// named volumes for the persistent volumes, and tmpfs mounts for the ephemeral ones
+func volumeMounts(devfileObj parser.DevfileObj, container devfilev1.Component) ([]string, error) {
+	volumes, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.VolumeComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ephemeral := make(map[string]bool, len(volumes))
+	for _, v := range volumes {
+		ephemeral[v.Name] = v.Volume.Ephemeral != nil && *v.Volume.Ephemeral
+	}
+
+	var result []string
+	for _, vm := range container.Container.VolumeMounts {
+		path := vm.Path
+		if path == "" {
+			path = "/" + vm.Name
+		}
+		if ephemeral[vm.Name] {
+			result = append(result, fmt.Sprintf("target=%s,type=tmpfs", path))
+			continue
+		}
+		result = append(result, fmt.Sprintf("source=%s-%s,target=%s,type=volume", devfileObj.GetMetadataName(), vm.Name, path))
+	}
+	return result, nil
+}
+
+// commandsHandler is a libdevfile.Handler collecting the command lines of the exec commands running in a container
+type commandsHandler struct {
+	container string
+
+	// mu protects the fields below, the commands of a parallel composite command being executed concurrently
+	mu           sync.Mutex
+	commandLines []string
+	warnings     []string
+}
+
+var _ libdevfile.Handler = (*commandsHandler)(nil)
+
+func (o *commandsHandler) ApplyImage(image devfilev1.Component) error {
+	o.warn(fmt.Sprintf("image component %q of a postStart event ignored", image.Name))
+	return nil
+}
+
+func (o *commandsHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind devfilev1.CommandGroupKind) error {
+	o.warn(fmt.Sprintf("kubernetes component %q of a postStart event ignored", kubernetes.Name))
+	return nil
+}
+
+func (o *commandsHandler) ApplyOpenShift(openshift devfilev1.Component, kind devfilev1.CommandGroupKind) error {
+	o.warn(fmt.Sprintf("openshift component %q of a postStart event ignored", openshift.Name))
+	return nil
+}
+
+func (o *commandsHandler) ExecuteNonTerminatingCommand(ctx context.Context, command devfilev1.Command) error {
+	return o.ExecuteTerminatingCommand(ctx, command)
+}
+
+func (o *commandsHandler) ExecuteTerminatingCommand(ctx context.Context, command devfilev1.Command) error {
+	if command.Exec.Component != o.container {
+		o.warn(fmt.Sprintf("command %q of a postStart event ignored, it runs in the container component %q", command.Id, command.Exec.Component))
+		return nil
+	}
+	commandLine := command.Exec.CommandLine
+	if command.Exec.WorkingDir != "" {
+		commandLine = fmt.Sprintf("cd %s && %s", shellQuoteWorkingDir(command.Exec.WorkingDir), commandLine)
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.commandLines = append(o.commandLines, fmt.Sprintf("(%s)", commandLine))
+	return nil
+}
+
+func (o *commandsHandler) warn(warning string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.warnings = append(o.warnings, warning)
+}
+
+// dockerfileEscaper escapes the characters interpreted in a double-quoted value of a Dockerfile instruction,
+// so the value is not subject to the variable substitution of the Dockerfile
+var dockerfileEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`)
+
+// dockerfileQuote returns the value s double-quoted for an ENV instruction of a Dockerfile
+func dockerfileQuote(s string) string {
+	return `"` + dockerfileEscaper.Replace(s) + `"`
+}
+
+var shellSafeWorkingDir = regexp.MustCompile(`^[a-zA-Z0-9_./=:,@%+${}-]+$`)
+
+// shellDoubleQuoteEscaper escapes the characters interpreted in a double-quoted string by the shell,
+// except $, so the variables referenced by a working directory (e.g. ${PROJECT_SOURCE}) are still expanded
+var shellDoubleQuoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "`", "\\`")
+
+// shellQuoteWorkingDir returns the working directory dir quoted for the shell, when it contains spaces or special characters
+func shellQuoteWorkingDir(dir string) string {
+	if shellSafeWorkingDir.MatchString(dir) {
+		return dir
+	}
+	return `"` + shellDoubleQuoteEscaper.Replace(dir) + `"`
+}
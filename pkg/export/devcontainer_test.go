@@ -0,0 +1,248 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+)
+
+const devcontainerDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+    env:
+    - name: DEBUG
+      value: "true"
+    endpoints:
+    - name: http
+      targetPort: 3000
+    - name: https
+      targetPort: 8443
+      protocol: https
+    - name: internal
+      targetPort: 9000
+      exposure: none
+    volumeMounts:
+    - name: cache
+      path: /cache
+    - name: tmp
+- name: db
+  container:
+    image: postgres:15
+- name: cache
+  volume:
+    size: 1Gi
+- name: tmp
+  volume:
+    ephemeral: true
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+    workingDir: ${PROJECT_SOURCE}
+- id: init-db
+  exec:
+    component: db
+    commandLine: psql -f init.sql
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+events:
+  postStart:
+  - install
+  - init-db
+`
+
+func TestNewDevcontainer(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	if err := os.WriteFile(devfilePath, []byte(devcontainerDevfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, warnings, err := NewDevcontainer(devObj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Devcontainer{
+		Name:            "my-app",
+		Image:           "registry.access.redhat.com/ubi8/nodejs-16:latest",
+		WorkspaceFolder: "/projects",
+		WorkspaceMount:  "source=${localWorkspaceFolder},target=/projects,type=bind",
+		ContainerEnv: map[string]string{
+			"DEBUG":          "true",
+			"PROJECTS_ROOT":  "/projects",
+			"PROJECT_SOURCE": "/projects",
+		},
+		ForwardPorts: []int{3000, 8443},
+		PortsAttributes: map[string]DevcontainerPortAttributes{
+			"3000": {Label: "http"},
+			"8443": {Label: "https", Protocol: "https"},
+		},
+		Mounts: []string{
+			"source=my-app-cache,target=/cache,type=volume",
+			"target=/tmp,type=tmpfs",
+		},
+		PostStartCommand: "(cd ${PROJECT_SOURCE} && npm install)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewDevcontainer() mismatch (-want +got):\n%s", diff)
+	}
+	wantWarnings := []string{
+		`container component "db" ignored, a dev container runs a single container`,
+		`command "init-db" of a postStart event ignored, it runs in the container component "db"`,
+	}
+	if diff := cmp.Diff(wantWarnings, warnings); diff != "" {
+		t.Errorf("NewDevcontainer() warnings mismatch (-want +got):\n%s", diff)
+	}
+
+	dockerfile := got.UseDockerfile()
+	wantDockerfile := `FROM registry.access.redhat.com/ubi8/nodejs-16:latest
+
+ENV DEBUG="true"
+ENV PROJECTS_ROOT="/projects"
+ENV PROJECT_SOURCE="/projects"
+`
+	if diff := cmp.Diff(wantDockerfile, string(dockerfile)); diff != "" {
+		t.Errorf("UseDockerfile() mismatch (-want +got):\n%s", diff)
+	}
+	if got.Image != "" || got.ContainerEnv != nil || got.Build == nil || got.Build.Dockerfile != "Dockerfile" {
+		t.Errorf("unexpected devcontainer after UseDockerfile(): %+v", got)
+	}
+}
+
+const parallelPostStartDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+- name: db
+  container:
+    image: postgres:15
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+- id: build
+  exec:
+    component: runtime
+    commandLine: npm run build
+- id: init-db
+  exec:
+    component: db
+    commandLine: psql -f init.sql
+- id: init
+  composite:
+    parallel: true
+    commands:
+    - install
+    - build
+    - init-db
+events:
+  postStart:
+  - init
+`
+
+func TestNewDevcontainer_ParallelPostStart(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	if err := os.WriteFile(devfilePath, []byte(parallelPostStartDevfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, warnings, err := NewDevcontainer(devObj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the commands of a parallel composite command are collected in any order
+	commandLines := strings.Split(got.PostStartCommand, " && ")
+	sort.Strings(commandLines)
+	wantCommandLines := []string{"(npm install)", "(npm run build)"}
+	if diff := cmp.Diff(wantCommandLines, commandLines); diff != "" {
+		t.Errorf("NewDevcontainer() postStart command mismatch (-want +got):\n%s", diff)
+	}
+	sort.Strings(warnings)
+	wantWarnings := []string{
+		`command "init-db" of a postStart event ignored, it runs in the container component "db"`,
+		`container component "db" ignored, a dev container runs a single container`,
+	}
+	if diff := cmp.Diff(wantWarnings, warnings); diff != "" {
+		t.Errorf("NewDevcontainer() warnings mismatch (-want +got):\n%s", diff)
+	}
+}
+
+const quotingDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+    env:
+    - name: GREETING
+      value: say "hello" to $USER
+    - name: WINDOWS_PATH
+      value: C:\Program Files
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+    workingDir: ${PROJECT_SOURCE}/my "web" app
+events:
+  postStart:
+  - install
+`
+
+func TestNewDevcontainer_Quoting(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	if err := os.WriteFile(devfilePath, []byte(quotingDevfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _, err := NewDevcontainer(devObj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantPostStartCommand := `(cd "${PROJECT_SOURCE}/my \"web\" app" && npm install)`
+	if got.PostStartCommand != wantPostStartCommand {
+		t.Errorf("NewDevcontainer() postStart command = %q, want %q", got.PostStartCommand, wantPostStartCommand)
+	}
+
+	dockerfile := string(got.UseDockerfile())
+	for _, want := range []string{
+		`ENV GREETING="say \"hello\" to \$USER"` + "\n",
+		`ENV WINDOWS_PATH="C:\\Program Files"` + "\n",
+	} {
+		if !strings.Contains(dockerfile, want) {
+			t.Errorf("UseDockerfile() = %q, expected to contain %q", dockerfile, want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// helmChartVersion is the version of the generated Helm charts
+const helmChartVersion = "0.1.0"
+
+// WriteManifests writes each resource in a <kind>-<name>.yaml file in dir, and returns the paths of the written files.
+// An existing file is overwritten only if force is true.
+func WriteManifests(fs filesystem.Filesystem, resources []unstructured.Unstructured, dir string, force bool) ([]string, error) {
+	files := make(map[string][]byte, len(resources))
+	var paths []string
+	for _, u := range resources {
+		content, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(dir, manifestFileName(u))
+		files[path] = content
+		paths = append(paths, path)
+	}
+	return paths, writeFiles(fs, dir, paths, files, force)
+}
+
+// WriteHelmChart writes a Helm chart skeleton named chartName in dir, containing the resources as templates,
+// and returns the paths of the written files.
+// An existing file is overwritten only if force is true.
+func WriteHelmChart(fs filesystem.Filesystem, resources []unstructured.Unstructured, dir string, chartName string, appVersion string, force bool) ([]string, error) {
+	chart := map[string]interface{}{
+		"apiVersion":  "v2",
+		"name":        chartName,
+		"description": fmt.Sprintf("A Helm chart for the %s component", chartName),
+		"type":        "application",
+		"version":     helmChartVersion,
+	}
+	if appVersion != "" {
+		chart["appVersion"] = appVersion
+	}
+	chartContent, err := yaml.Marshal(chart)
+	if err != nil {
+		return nil, err
+	}
+
+	chartPath := filepath.Join(dir, "Chart.yaml")
+	valuesPath := filepath.Join(dir, "values.yaml")
+	files := map[string][]byte{
+		chartPath:  chartContent,
+		valuesPath: []byte("# Default values for " + chartName + ".\n"),
+	}
+	paths := []string{chartPath, valuesPath}
+	templatesDir := filepath.Join(dir, "templates")
+	for _, u := range resources {
+		content, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(templatesDir, manifestFileName(u))
+		files[path] = content
+		paths = append(paths, path)
+	}
+
+	err = fs.MkdirAll(templatesDir, 0750)
+	if err != nil {
+		return nil, err
+	}
+	return paths, writeFiles(fs, dir, paths, files, force)
+}
+
+// WriteDevcontainer writes the devcontainer.json file in dir, and the Dockerfile if not nil,
+// and returns the paths of the written files.
+// An existing file is overwritten only if force is true.
+func WriteDevcontainer(fs filesystem.Filesystem, devcontainer Devcontainer, dockerfile []byte, dir string, force bool) ([]string, error) {
+	content, err := json.MarshalIndent(devcontainer, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	devcontainerPath := filepath.Join(dir, "devcontainer.json")
+	files := map[string][]byte{
+		devcontainerPath: append(content, '\n'),
+	}
+	paths := []string{devcontainerPath}
+	if dockerfile != nil {
+		dockerfilePath := filepath.Join(dir, DevcontainerDockerfile)
+		files[dockerfilePath] = dockerfile
+		paths = append(paths, dockerfilePath)
+	}
+	return paths, writeFiles(fs, dir, paths, files, force)
+}
+
+// writeFiles checks that none of the files exists before writing them, unless force is true
+func writeFiles(fs filesystem.Filesystem, dir string, paths []string, files map[string][]byte, force bool) error {
+	if !force {
+		for _, path := range paths {
+			if _, err := fs.Stat(path); err == nil {
+				return fmt.Errorf("file %q already exists, use --force to overwrite it", path)
+			}
+		}
+	}
+	err := fs.MkdirAll(dir, 0750)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		err = fs.WriteFile(path, files[path], 0640)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func manifestFileName(u unstructured.Unstructured) string {
+	return strings.ToLower(u.GetKind()) + "-" + u.GetName() + ".yaml"
+}
@@ -0,0 +1,287 @@
+// Package export exports the resources created by odo for a component, to be used without odo
+package export
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/dev/kubedev/resources"
+	"github.com/redhat-developer/odo/pkg/dev/kubedev/storage"
+	"github.com/redhat-developer/odo/pkg/dev/kubedev/utils"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	storagepkg "github.com/redhat-developer/odo/pkg/storage"
+)
+
+// KubernetesOptions are the options of the generation of the Kubernetes resources of a component
+type KubernetesOptions struct {
+	// Mode is the mode of the resources, odolabels.ComponentDevMode or odolabels.ComponentDeployMode
+	Mode string
+	// KubeClient, if not nil, is used to adapt the resources of the Dev mode to the current namespace
+	// (Pod Security level, LimitRanges and ResourceQuotas)
+	KubeClient kclient.ClientInterface
+	// ServiceAccount is the service account of the pod in the Dev mode, taking precedence over the pod-overrides
+	ServiceAccount string
+	// DefaultServiceAccount is the service account of the pod in the Dev mode when neither ServiceAccount nor the pod-overrides set one
+	DefaultServiceAccount string
+	// ImagePullSecrets are the names of the secrets used to pull the images in the Dev mode
+	ImagePullSecrets []string
+	// ForwardLocalhost adds to the pod of the Dev mode the container relaying the traffic to the endpoints listening on the loopback interface
+	ForwardLocalhost bool
+}
+
+// KubernetesResources returns the Kubernetes resources created by odo for the component, in the Dev or Deploy mode.
+// In the Deploy mode, the resources are the ones defined by the Kubernetes and OpenShift components applied by the default deploy command.
+// In the Dev mode, the resources are the Deployment and Service built as by odo dev, the PersistentVolumeClaims created for the
+// volume components, and the Kubernetes and OpenShift components not referenced by commands;
+// the sources are stored in an ephemeral volume.
+// The resources are returned without namespace, and path is the directory containing the Devfile.
+func KubernetesResources(ctx context.Context, devfileObj parser.DevfileObj, componentName, appName, path string, options KubernetesOptions) ([]unstructured.Unstructured, error) {
+	extraLabels, extraAnnotations, err := component.GetExtraMetadata(ctx, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+	extra := extraMetadata{labels: extraLabels, annotations: extraAnnotations}
+
+	switch options.Mode {
+	case odolabels.ComponentDeployMode:
+		return deployResources(ctx, devfileObj, componentName, appName, path, extra)
+	case odolabels.ComponentDevMode:
+		return devResources(ctx, devfileObj, componentName, appName, path, extra, options)
+	default:
+		return nil, fmt.Errorf("unknown mode %q", options.Mode)
+	}
+}
+
+// extraMetadata contains the extra labels and annotations added to all the resources
+type extraMetadata struct {
+	labels      map[string]string
+	annotations map[string]string
+}
+
+// collectHandler is a libdevfile.Handler collecting the Kubernetes and OpenShift components applied by a command
+type collectHandler struct {
+	// mu protects the fields below, the commands of a parallel composite command being executed concurrently
+	mu         sync.Mutex
+	components []devfilev1.Component
+	applied    map[string]struct{}
+}
+
+var _ libdevfile.Handler = (*collectHandler)(nil)
+
+func (o *collectHandler) ApplyImage(image devfilev1.Component) error {
+	return nil
+}
+
+func (o *collectHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind devfilev1.CommandGroupKind) error {
+	o.collect(kubernetes)
+	return nil
+}
+
+func (o *collectHandler) ApplyOpenShift(openshift devfilev1.Component, kind devfilev1.CommandGroupKind) error {
+	o.collect(openshift)
+	return nil
+}
+
+func (o *collectHandler) ExecuteNonTerminatingCommand(ctx context.Context, command devfilev1.Command) error {
+	klog.V(2).Infof("exec command %q is not exported", command.Id)
+	return nil
+}
+
+func (o *collectHandler) ExecuteTerminatingCommand(ctx context.Context, command devfilev1.Command) error {
+	klog.V(2).Infof("exec command %q is not exported", command.Id)
+	return nil
+}
+
+func (o *collectHandler) collect(c devfilev1.Component) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, found := o.applied[c.Name]; found {
+		return
+	}
+	o.applied[c.Name] = struct{}{}
+	o.components = append(o.components, c)
+}
+
+func deployResources(ctx context.Context, devfileObj parser.DevfileObj, componentName, appName, path string, extra extraMetadata) ([]unstructured.Unstructured, error) {
+	handler := &collectHandler{
+		applied: map[string]struct{}{},
+	}
+	err := libdevfile.Deploy(ctx, devfileObj, handler)
+	if err != nil {
+		return nil, err
+	}
+	return componentsResources(devfileObj, handler.components, componentName, appName, path, odolabels.ComponentDeployMode, extra)
+}
+
+// componentsResources returns the resources defined by the Kubernetes and OpenShift components,
+// with the labels and annotations added by odo
+func componentsResources(devfileObj parser.DevfileObj, components []devfilev1.Component, componentName, appName, path, mode string, extra extraMetadata) ([]unstructured.Unstructured, error) {
+	componentRuntime := component.GetComponentRuntimeFromDevfileMetadata(devfileObj.Data.GetMetadata())
+	labels := odolabels.AddExtra(odolabels.GetLabels(componentName, appName, componentRuntime, mode, false), extra.labels)
+	annotations := make(map[string]string)
+	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	odolabels.SetLanguage(annotations, devfileObj.Data.GetMetadata().Language)
+	annotations = odolabels.AddExtra(annotations, extra.annotations)
+
+	var result []unstructured.Unstructured
+	for _, c := range components {
+		uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfileObj, c.Name, path, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range uList {
+			u.SetLabels(mergeMaps(u.GetLabels(), labels))
+			u.SetAnnotations(mergeMaps(u.GetAnnotations(), annotations))
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
+func devResources(ctx context.Context, devfileObj parser.DevfileObj, componentName, appName, path string, extra extraMetadata, options KubernetesOptions) ([]unstructured.Unstructured, error) {
+	componentRuntime := component.GetComponentRuntimeFromDevfileMetadata(devfileObj.Data.GetMetadata())
+
+	policy, err := component.GetPodSecurityPolicy(ctx, options.KubeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var pvcs []corev1.PersistentVolumeClaim
+	params := resources.Params{
+		Devfile:                    devfileObj,
+		ComponentName:              componentName,
+		AppName:                    appName,
+		Path:                       path,
+		ExtraLabels:                extra.labels,
+		ExtraAnnotations:           extra.annotations,
+		PodSecurityAdmissionPolicy: policy,
+		ServiceAccount:             options.ServiceAccount,
+		DefaultServiceAccount:      options.DefaultServiceAccount,
+		ImagePullSecrets:           options.ImagePullSecrets,
+		ForwardLocalhost:           options.ForwardLocalhost,
+		BuildVolumes: func(containers, initContainers []corev1.Container) (volumes []corev1.Volume, err error) {
+			pvcs, volumes, err = devVolumes(devfileObj, componentName, appName, componentRuntime, containers, initContainers, extra)
+			return volumes, err
+		},
+		ConstraintsClient: options.KubeClient,
+		// The resources are not created, the constraints of the namespace may be different when they are applied
+		ConstraintsWarningsOnly: true,
+	}
+	deployment, svc, err := resources.BuildDeploymentAndService(params)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []runtime.Object{deployment}
+	if len(svc.Spec.Ports) > 0 {
+		objects = append(objects, svc)
+	}
+	for i := range pvcs {
+		objects = append(objects, &pvcs[i])
+	}
+
+	var result []unstructured.Unstructured
+	for _, obj := range objects {
+		u, err := toUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, u)
+	}
+
+	k8sComponents, err := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, false)
+	if err != nil {
+		return nil, err
+	}
+	others, err := componentsResources(devfileObj, k8sComponents, componentName, appName, path, odolabels.ComponentDevMode, extra)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, others...), nil
+}
+
+// devVolumes returns the PVCs for the persistent volumes of the Devfile, and the volumes of the pod template,
+// and adds the volume mounts to the containers
+func devVolumes(devfileObj parser.DevfileObj, componentName, appName, componentRuntime string, containers, initContainers []corev1.Container, extra extraMetadata) ([]corev1.PersistentVolumeClaim, []corev1.Volume, error) {
+	localStorage, err := storagepkg.ListStorage(devfileObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	var pvcs []corev1.PersistentVolumeClaim
+	ephemerals := map[string]storagepkg.Storage{}
+	for _, st := range storagepkg.ConvertListLocalToMachine(localStorage).Items {
+		if st.Spec.Ephemeral != nil && *st.Spec.Ephemeral {
+			ephemerals[st.Name] = st
+			continue
+		}
+		pvc, pvcErr := storagepkg.GetPVC(st, componentName, appName, componentRuntime, "")
+		if pvcErr != nil {
+			return nil, nil, pvcErr
+		}
+		pvc.TypeMeta = generator.GetTypeMeta("PersistentVolumeClaim", "v1")
+		pvc.Labels = odolabels.AddExtra(pvc.Labels, extra.labels)
+		pvc.Annotations = odolabels.AddExtra(pvc.Annotations, extra.annotations)
+		pvcs = append(pvcs, *pvc)
+	}
+
+	_, volumeNameToVolInfo, err := storage.GetVolumeInfos(pvcs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the sources are stored in an ephemeral volume, as with the default value of the Ephemeral preference
+	volumes := utils.GetOdoContainerVolumes("")
+	utils.AddOdoProjectVolume(containers)
+	utils.AddOdoMandatoryVolume(containers)
+
+	pvcVolumes, err := storage.GetPersistentVolumesAndVolumeMounts(devfileObj, containers, initContainers, volumeNameToVolInfo, parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	volumes = append(volumes, pvcVolumes...)
+
+	ephemeralVolumes, err := storage.GetEphemeralVolumesAndVolumeMounts(devfileObj, containers, initContainers, ephemerals, parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	volumes = append(volumes, ephemeralVolumes...)
+
+	return pvcs, volumes, nil
+}
+
+// toUnstructured converts a typed object to an unstructured one, without the fields set by the cluster
+func toUnstructured(obj runtime.Object) (unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	u := unstructured.Unstructured{Object: content}
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "spec", "template", "metadata", "creationTimestamp")
+	return u, nil
+}
+
+func mergeMaps(maps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
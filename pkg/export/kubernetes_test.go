@@ -0,0 +1,358 @@
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sethvargo/go-envconfig"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const devfileContent = `schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+  projectType: Node.js
+  language: JavaScript
+  version: 2.1.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+    endpoints:
+    - name: http
+      targetPort: 3000
+    volumeMounts:
+    - name: cache
+      path: /cache
+    - name: tmp
+      path: /tmp/cache
+- name: cache
+  volume:
+    size: 1Gi
+- name: tmp
+  volume:
+    ephemeral: true
+- name: config
+  kubernetes:
+    uri: config.yaml
+- name: outerloop-deploy
+  kubernetes:
+    inlined: |
+      apiVersion: apps/v1
+      kind: Deployment
+      metadata:
+        name: my-app
+      spec:
+        replicas: 1
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+- id: deploy-k8s
+  apply:
+    component: outerloop-deploy
+- id: deploy
+  composite:
+    commands:
+    - deploy-k8s
+    group:
+      kind: deploy
+      isDefault: true
+`
+
+const configContent = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: value
+`
+
+func parseDevfile(t *testing.T) (parser.DevfileObj, string) {
+	dir := t.TempDir()
+	devfilePath := filepath.Join(dir, "devfile.yaml")
+	if err := os.WriteFile(devfilePath, []byte(devfileContent), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(configContent), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return devObj, dir
+}
+
+func newContext(t *testing.T) context.Context {
+	envConfig, err := config.GetConfigurationWith(envconfig.MapLookuper(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return envcontext.WithEnvConfig(context.Background(), *envConfig)
+}
+
+func kindsAndNames(resources []unstructured.Unstructured) []string {
+	var result []string
+	for _, u := range resources {
+		result = append(result, u.GetKind()+"/"+u.GetName())
+	}
+	return result
+}
+
+func TestKubernetesResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      string
+		want      []string
+		wantMode  string
+		wantError bool
+	}{
+		{
+			name: "deploy mode",
+			mode: odolabels.ComponentDeployMode,
+			want: []string{"Deployment/my-app"},
+		},
+		{
+			name: "dev mode",
+			mode: odolabels.ComponentDevMode,
+			want: []string{
+				"Deployment/my-component-app",
+				"Service/my-component-app",
+				"PersistentVolumeClaim/cache-my-component-app",
+				"ConfigMap/my-config",
+			},
+		},
+		{
+			name:      "unknown mode",
+			mode:      "unknown",
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devObj, dir := parseDevfile(t)
+			got, err := KubernetesResources(newContext(t), devObj, "my-component", "app", dir, KubernetesOptions{Mode: tt.mode})
+			if tt.wantError != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantError, err)
+			}
+			if tt.wantError {
+				return
+			}
+			if diff := cmp.Diff(tt.want, kindsAndNames(got)); diff != "" {
+				t.Errorf("KubernetesResources() mismatch (-want +got):\n%s", diff)
+			}
+			for _, u := range got {
+				if mode := odolabels.GetMode(u.GetLabels()); mode != tt.mode {
+					t.Errorf("expected mode %q for %s/%s, got %q", tt.mode, u.GetKind(), u.GetName(), mode)
+				}
+				if u.GetNamespace() != "" {
+					t.Errorf("expected no namespace for %s/%s, got %q", u.GetKind(), u.GetName(), u.GetNamespace())
+				}
+				if _, found := u.Object["status"]; found {
+					t.Errorf("expected no status for %s/%s", u.GetKind(), u.GetName())
+				}
+			}
+		})
+	}
+}
+
+func TestKubernetesResources_DevDeployment(t *testing.T) {
+	devObj, dir := parseDevfile(t)
+	got, err := KubernetesResources(newContext(t), devObj, "my-component", "app", dir, KubernetesOptions{
+		Mode:             odolabels.ComponentDevMode,
+		ServiceAccount:   "my-sa",
+		ImagePullSecrets: []string{"my-secret"},
+		ForwardLocalhost: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var deployment appsv1.Deployment
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(got[0].Object, &deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := deployment.Spec.Template.Spec
+	if spec.ServiceAccountName != "my-sa" {
+		t.Errorf("expected service account %q, got %q", "my-sa", spec.ServiceAccountName)
+	}
+	if diff := cmp.Diff([]corev1.LocalObjectReference{{Name: "my-secret"}}, spec.ImagePullSecrets); diff != "" {
+		t.Errorf("image pull secrets mismatch (-want +got):\n%s", diff)
+	}
+	var names []string
+	for _, c := range spec.Containers {
+		names = append(names, c.Name)
+		// without cluster, the containers comply with the restricted Pod Security level
+		if c.SecurityContext == nil || c.SecurityContext.AllowPrivilegeEscalation == nil || *c.SecurityContext.AllowPrivilegeEscalation {
+			t.Errorf("expected container %q to not allow privilege escalation, got %+v", c.Name, c.SecurityContext)
+		}
+	}
+	if diff := cmp.Diff([]string{"runtime", common.PortForwardingHelperContainerName}, names); diff != "" {
+		t.Errorf("containers mismatch (-want +got):\n%s", diff)
+	}
+}
+
+const parallelDeployDevfileContent = `schemaVersion: 2.2.0
+metadata:
+  name: nodejs
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+- name: config-a
+  kubernetes:
+    inlined: |
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: config-a
+- name: config-b
+  kubernetes:
+    inlined: |
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: config-b
+- name: config-c
+  kubernetes:
+    inlined: |
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: config-c
+commands:
+- id: apply-a
+  apply:
+    component: config-a
+- id: apply-b
+  apply:
+    component: config-b
+- id: apply-c
+  apply:
+    component: config-c
+- id: apply-a-again
+  apply:
+    component: config-a
+- id: deploy
+  composite:
+    parallel: true
+    commands:
+    - apply-a
+    - apply-b
+    - apply-c
+    - apply-a-again
+    group:
+      kind: deploy
+      isDefault: true
+`
+
+func TestKubernetesResources_ParallelDeploy(t *testing.T) {
+	dir := t.TempDir()
+	devfilePath := filepath.Join(dir, "devfile.yaml")
+	if err := os.WriteFile(devfilePath, []byte(parallelDeployDevfileContent), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := KubernetesResources(newContext(t), devObj, "my-component", "app", dir, KubernetesOptions{Mode: odolabels.ComponentDeployMode})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the commands of a parallel composite command are applied in any order
+	names := kindsAndNames(got)
+	sort.Strings(names)
+	want := []string{"ConfigMap/config-a", "ConfigMap/config-b", "ConfigMap/config-c"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("KubernetesResources() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteManifests(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "my-config"},
+		}},
+	}
+
+	paths, err := WriteManifests(fs, resources, "k8s", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{filepath.Join("k8s", "configmap-my-config.yaml")}, paths); diff != "" {
+		t.Errorf("WriteManifests() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = WriteManifests(fs, resources, "k8s", false)
+	if err == nil {
+		t.Errorf("expected an error when the file already exists")
+	}
+	_, err = WriteManifests(fs, resources, "k8s", true)
+	if err != nil {
+		t.Errorf("unexpected error with force: %v", err)
+	}
+}
+
+func TestWriteHelmChart(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": "my-svc"},
+		}},
+	}
+
+	paths, err := WriteHelmChart(fs, resources, "chart", "my-component", "1.0.0", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		filepath.Join("chart", "Chart.yaml"),
+		filepath.Join("chart", "values.yaml"),
+		filepath.Join("chart", "templates", "service-my-svc.yaml"),
+	}
+	if diff := cmp.Diff(want, paths); diff != "" {
+		t.Errorf("WriteHelmChart() mismatch (-want +got):\n%s", diff)
+	}
+	content, err := fs.ReadFile(filepath.Join("chart", "Chart.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantChart := `apiVersion: v2
+appVersion: 1.0.0
+description: A Helm chart for the my-component component
+name: my-component
+type: application
+version: 0.1.0
+`
+	if diff := cmp.Diff(wantChart, string(content)); diff != "" {
+		t.Errorf("Chart.yaml mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,102 @@
+package i18n
+
+// fr is the French catalog
+var fr = map[string]string{
+	// log
+	"Experimental mode enabled. Use at your own risk.":                            "Mode expérimental activé. Utilisez-le à vos risques et périls.",
+	"More details on https://odo.dev/docs/user-guides/advanced/experimental-mode": "Plus de détails sur https://odo.dev/docs/user-guides/advanced/experimental-mode",
+	"%s Deprecated": "%s est obsolète",
+
+	// init
+	"Interactive mode enabled, please answer the following questions:":                               "Mode interactif activé, veuillez répondre aux questions suivantes :",
+	"Initializing a new component":                                                                   "Initialisation d'un nouveau composant",
+	"Files: Source code detected, a Devfile will be determined based upon source code autodetection": "Fichiers : code source détecté, un Devfile sera déterminé à partir de l'analyse du code source",
+	"Files: No source code detected, a starter project will be created in the current directory":     "Fichiers : aucun code source détecté, un projet de démarrage sera créé dans le répertoire courant",
+	"Dev mode ran, but no Devfile was found. Initializing a component in the current directory":      "Le mode Dev a été lancé, mais aucun Devfile n'a été trouvé. Initialisation d'un composant dans le répertoire courant",
+	"Deploy mode ran, but no Devfile was found. Initializing a component in the current directory":   "Le mode Deploy a été lancé, mais aucun Devfile n'a été trouvé. Initialisation d'un composant dans le répertoire courant",
+	"odo version: %s": "version d'odo : %s",
+	"Could not determine a Devfile based on the files in the current directory: %v": "Impossible de déterminer un Devfile à partir des fichiers du répertoire courant : %v",
+	"Downloading devfile from %q":                                 "Téléchargement du devfile depuis %q",
+	"Copying devfile from %q":                                     "Copie du devfile depuis %q",
+	"Converting Compose file %q":                                  "Conversion du fichier Compose %q",
+	"Downloading devfile %q":                                      "Téléchargement du devfile %q",
+	"Downloading devfile %q from registry %q":                     "Téléchargement du devfile %q depuis le registre %q",
+	"Downloading starter project %q":                              "Téléchargement du projet de démarrage %q",
+	"\nYou can automate this command by executing:\n   %s":        "\nVous pouvez automatiser cette commande en exécutant :\n   %s",
+	"\nTo deploy your component to a cluster use \"odo deploy\".": "\nPour déployer votre composant sur un cluster, utilisez \"odo deploy\".",
+	`
+Your new component '%s' is ready in the current directory.
+To start editing your component, use 'odo dev' and open this folder in your favorite IDE.
+Changes will be directly reflected on the cluster.`: `
+Votre nouveau composant '%s' est prêt dans le répertoire courant.
+Pour commencer à modifier votre composant, utilisez 'odo dev' et ouvrez ce répertoire dans votre IDE préféré.
+Les modifications seront directement répercutées sur le cluster.`,
+
+	// dev
+	"Platform: %s":                                     "Plateforme : %s",
+	"Namespace: %s":                                    "Namespace : %s",
+	"the cluster":                                      "le cluster",
+	"Developing using the %q Devfile":                  "Développement avec le Devfile %q",
+	"Running on %s in Dev mode":                        "Exécution sur %s en mode Dev",
+	"Syncing files into the container":                 "Synchronisation des fichiers dans le conteneur",
+	"Waiting for the application to be ready":          "En attente de la disponibilité de l'application",
+	"Port forwarding might not work correctly: %v":     "La redirection de ports pourrait ne pas fonctionner correctement : %v",
+	"Deploying pod":                                    "Déploiement du pod",
+	"Cleaning resources, please wait":                  "Nettoyage des ressources, veuillez patienter",
+	"Cleaning up resources":                            "Nettoyage des ressources",
+	"Pushing files...":                                 "Envoi des fichiers...",
+	"Updating Component...":                            "Mise à jour du composant...",
+	"File %s changed":                                  "Fichier %s modifié",
+	"Dev mode":                                         "Mode Dev",
+	"Status:":                                          "État :",
+	"Watching for changes in the current directory %s": "Surveillance des modifications dans le répertoire courant %s",
+	"Keyboard Commands:":                               "Commandes clavier :",
+	`
+[Ctrl+c] - Exit and delete resources from the cluster
+     [p] - Manually apply local changes to the application on the cluster
+`: `
+[Ctrl+c] - Quitter et supprimer les ressources du cluster
+     [p] - Appliquer manuellement les modifications locales à l'application sur le cluster
+`,
+	`     [s] - Switch to the next profile defined in the Devfile
+`: `     [s] - Passer au profil suivant défini dans le Devfile
+`,
+	"Switching to profile %q...":      "Passage au profil %q...",
+	"Using profile %q":                "Utilisation du profil %q",
+	"Tests passed (iteration %d)":     "Tests réussis (itération %d)",
+	"Tests failed (iteration %d): %v": "Échec des tests (itération %d) : %v",
+
+	"No activity for %s, suspending the component...":               "Aucune activité depuis %s, suspension du composant...",
+	"The component is suspended, it will resume on the next change": "Le composant est suspendu, il reprendra à la prochaine modification",
+	"Resuming the component...":                                     "Reprise du composant...",
+
+	"\nThe starter project defines commands to run after its download, you can run them with the --run-post-init flag:": "\nLe projet de démarrage définit des commandes à exécuter après son téléchargement, vous pouvez les exécuter avec l'option --run-post-init :",
+	"Running post-init command %q": "Exécution de la commande post-initialisation %q",
+
+	"No previous working state has been recorded, the component cannot be rolled back":         "Aucun état fonctionnel précédent n'a été enregistré, le composant ne peut pas être restauré",
+	"Rolling back the component to its last working state...":                                  "Restauration du composant à son dernier état fonctionnel...",
+	"The component has been rolled back, it will be updated on the next change of the Devfile": "Le composant a été restauré, il sera mis à jour à la prochaine modification du Devfile",
+	"Press 'r' to roll the component back to its last working state":                           "Appuyez sur 'r' pour restaurer le composant à son dernier état fonctionnel",
+	`     [r] - Roll back the component to its last working state
+`: `     [r] - Restaurer le composant à son dernier état fonctionnel
+`,
+	"Unable to reload the preferences: %v":                                                  "Impossible de recharger les préférences : %v",
+	"Preferences have been reloaded, they will be used on the next update of the component": "Les préférences ont été rechargées, elles seront utilisées à la prochaine mise à jour du composant",
+
+	"The %s request of the container %q has been adjusted from %s to %s to fit the LimitRange %q": "La requête %s du conteneur %q a été ajustée de %s à %s pour respecter le LimitRange %q",
+	"The component does not fit in the resource constraints of the namespace: %s":                 "Le composant ne respecte pas les contraintes de ressources du namespace : %s",
+
+	"The previous session (PID %d) ended at %s without cleaning up its resources: %s":   "La session précédente (PID %d) s'est terminée à %s sans nettoyer ses ressources : %s",
+	"Do you want to delete the resources left by the previous session before starting?": "Voulez-vous supprimer les ressources laissées par la session précédente avant de démarrer ?",
+	"The resources left by the previous session will be updated by this session":        "Les ressources laissées par la session précédente seront mises à jour par cette session",
+
+	"Unable to use a TTY, as the standard input is not a terminal": "Impossible d'utiliser un TTY, car l'entrée standard n'est pas un terminal",
+
+	`
+[Ctrl+c] - Exit and delete resources from podman
+     [p] - Manually apply local changes to the application on podman
+`: `
+[Ctrl+c] - Quitter et supprimer les ressources de podman
+     [p] - Appliquer manuellement les modifications locales à l'application sur podman
+`,
+}
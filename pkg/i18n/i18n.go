@@ -0,0 +1,88 @@
+// Package i18n translates the messages displayed by odo into the language of the user.
+//
+// Messages are identified by their English text: T returns the translation of a message
+// from the catalog of the current language, or the message itself if it is not translated.
+// Format strings are translated before being formatted, so that translations can reorder their arguments
+// with explicit argument indexes (%[1]s).
+package i18n
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/config"
+)
+
+// catalogs are the translations of the messages, indexed by language, then by English message
+var catalogs = map[language.Tag]map[string]string{
+	language.French: fr,
+}
+
+// supportedLanguages are the languages into which messages are translated, English being the default one
+var supportedLanguages = []language.Tag{language.English, language.French}
+
+var matcher = language.NewMatcher(supportedLanguages)
+
+var (
+	current   = language.English
+	currentMu sync.RWMutex
+)
+
+// SetLanguage sets the language of the messages returned by T
+func SetLanguage(tag language.Tag) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	current = tag
+}
+
+// T returns the translation of msg into the current language, or msg if no translation exists
+func T(msg string) string {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	if translated, ok := catalogs[current][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+// GetLanguage returns the supported language closest to the locale defined by the preferences or, if not defined,
+// by the LC_ALL, LC_MESSAGES and LANG environment variables, in this order. English is returned by default.
+func GetLanguage(preferenceLocale string, envConfig config.Configuration) language.Tag {
+	for _, locale := range []string{
+		preferenceLocale,
+		pointer.StringDeref(envConfig.LcAll, ""),
+		pointer.StringDeref(envConfig.LcMessages, ""),
+		pointer.StringDeref(envConfig.Lang, ""),
+	} {
+		if locale != "" {
+			return matchLocale(locale)
+		}
+	}
+	return language.English
+}
+
+// ParseLocale parses a BCP 47 language tag (fr-FR) or a POSIX locale (fr_FR.UTF-8)
+func ParseLocale(locale string) (language.Tag, error) {
+	if i := strings.IndexAny(locale, ".@"); i != -1 {
+		locale = locale[:i]
+	}
+	if locale == "C" || locale == "POSIX" {
+		return language.English, nil
+	}
+	return language.Parse(strings.ReplaceAll(locale, "_", "-"))
+}
+
+func matchLocale(locale string) language.Tag {
+	tag, err := ParseLocale(locale)
+	if err != nil {
+		return language.English
+	}
+	_, index, confidence := matcher.Match(tag)
+	if confidence == language.No {
+		return language.English
+	}
+	return supportedLanguages[index]
+}
@@ -0,0 +1,122 @@
+package i18n
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/text/language"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
+)
+
+func TestGetLanguage(t *testing.T) {
+	tests := []struct {
+		name             string
+		preferenceLocale string
+		envConfig        config.Configuration
+		want             language.Tag
+	}{
+		{
+			name: "nothing defined",
+			want: language.English,
+		},
+		{
+			name:      "POSIX locale in LANG",
+			envConfig: config.Configuration{Lang: pointer.String("fr_FR.UTF-8")},
+			want:      language.French,
+		},
+		{
+			name:      "C locale",
+			envConfig: config.Configuration{Lang: pointer.String("C.UTF-8")},
+			want:      language.English,
+		},
+		{
+			name: "LC_ALL takes precedence over LC_MESSAGES and LANG",
+			envConfig: config.Configuration{
+				LcAll:      pointer.String("en_US.UTF-8"),
+				LcMessages: pointer.String("fr_FR.UTF-8"),
+				Lang:       pointer.String("fr_FR.UTF-8"),
+			},
+			want: language.English,
+		},
+		{
+			name:             "preference takes precedence over environment",
+			preferenceLocale: "fr-CA",
+			envConfig:        config.Configuration{LcAll: pointer.String("en_US.UTF-8")},
+			want:             language.French,
+		},
+		{
+			name:      "unsupported language",
+			envConfig: config.Configuration{Lang: pointer.String("ja_JP.UTF-8")},
+			want:      language.English,
+		},
+		{
+			name:             "invalid locale",
+			preferenceLocale: "not a language",
+			want:             language.English,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetLanguage(tt.preferenceLocale, tt.envConfig)
+			if got != tt.want {
+				t.Errorf("GetLanguage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	defer SetLanguage(language.English)
+
+	if got := T("Dev mode"); got != "Dev mode" {
+		t.Errorf("T() in English = %q, want %q", got, "Dev mode")
+	}
+	SetLanguage(language.French)
+	if got := T("Dev mode"); got != "Mode Dev" {
+		t.Errorf("T() in French = %q, want %q", got, "Mode Dev")
+	}
+	if got := T("untranslated message"); got != "untranslated message" {
+		t.Errorf("T() of an untranslated message = %q, want %q", got, "untranslated message")
+	}
+}
+
+var formatVerbs = regexp.MustCompile(`%(\[\d+\])?([a-zA-Z])`)
+
+// getFormatVerbs returns the sorted format verbs of msg, without their explicit argument indexes
+func getFormatVerbs(msg string) []string {
+	var result []string
+	for _, match := range formatVerbs.FindAllStringSubmatch(msg, -1) {
+		result = append(result, match[2])
+	}
+	sort.Strings(result)
+	return result
+}
+
+// TestCatalogs checks that the translations use the same format verbs as the messages, and translate the messages shared with the tests
+func TestCatalogs(t *testing.T) {
+	shared := []string{
+		messages.InteractiveModeEnabled,
+		messages.InitializingNewComponent,
+		messages.SourceCodeDetected,
+		messages.NoSourceCodeDetected,
+		messages.DevInitializeExistingComponent,
+		messages.DeployInitializeExistingComponent,
+	}
+	for tag, catalog := range catalogs {
+		for msg, translated := range catalog {
+			if diff := cmp.Diff(getFormatVerbs(msg), getFormatVerbs(translated)); diff != "" {
+				t.Errorf("%v: format verbs of the translation of %q mismatch (-want +got):\n%s", tag, msg, diff)
+			}
+		}
+		for _, msg := range shared {
+			if _, ok := catalog[msg]; !ok {
+				t.Errorf("%v: no translation of %q", tag, msg)
+			}
+		}
+	}
+}
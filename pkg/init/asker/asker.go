@@ -5,10 +5,9 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/AlecAivazis/survey/v2"
-
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	"github.com/redhat-developer/odo/pkg/registry"
 )
 
@@ -16,37 +15,32 @@ const (
 	GOBACK = "** GO BACK **"
 )
 
-type Survey struct{}
+// PromptAsker asks the questions of the interactive mode using a prompt client
+type PromptAsker struct {
+	promptClient prompt.Client
+}
 
-var _ Asker = (*Survey)(nil)
+var _ Asker = (*PromptAsker)(nil)
 
-func NewSurveyAsker() *Survey {
-	return &Survey{}
+func NewPromptAsker(promptClient prompt.Client) *PromptAsker {
+	return &PromptAsker{
+		promptClient: promptClient,
+	}
 }
 
-func (o *Survey) AskLanguage(langs []string) (string, error) {
+func (o *PromptAsker) AskLanguage(langs []string) (string, error) {
 	sort.Strings(langs)
-	question := &survey.Select{
-		Message: "Select language:",
-		Options: langs,
-	}
-	var answer string
-	err := survey.AskOne(question, &answer)
+	answer, err := o.promptClient.Select(prompt.Question{Name: "language", Message: "Select language:"}, langs, "")
 	if err != nil {
 		return "", err
 	}
-	return answer, nil
+	return langs[answer], nil
 }
 
-func (o *Survey) AskType(types registry.TypesWithDetails) (back bool, _ api.DevfileStack, _ error) {
+func (o *PromptAsker) AskType(types registry.TypesWithDetails) (back bool, _ api.DevfileStack, _ error) {
 	stringTypes := types.GetOrderedLabels()
 	stringTypes = append(stringTypes, GOBACK)
-	question := &survey.Select{
-		Message: "Select project type:",
-		Options: stringTypes,
-	}
-	var answerPos int
-	err := survey.AskOne(question, &answerPos)
+	answerPos, err := o.promptClient.Select(prompt.Question{Name: "projectType", Message: "Select project type:"}, stringTypes, "")
 	if err != nil {
 		return false, api.DevfileStack{}, err
 	}
@@ -57,7 +51,7 @@ func (o *Survey) AskType(types registry.TypesWithDetails) (back bool, _ api.Devf
 	return false, compType, err
 }
 
-func (o *Survey) AskVersion(versions []api.DevfileStackVersion) (back bool, version string, _ error) {
+func (o *PromptAsker) AskVersion(versions []api.DevfileStackVersion) (back bool, version string, _ error) {
 	var stringVersions []string
 	for _, version := range versions {
 		sVersion := version.Version
@@ -67,12 +61,7 @@ func (o *Survey) AskVersion(versions []api.DevfileStackVersion) (back bool, vers
 		stringVersions = append(stringVersions, sVersion)
 	}
 	stringVersions = append(stringVersions, GOBACK)
-	question := &survey.Select{
-		Message: "Select version: ",
-		Options: stringVersions,
-	}
-	var answerPos int
-	err := survey.AskOne(question, &answerPos)
+	answerPos, err := o.promptClient.Select(prompt.Question{Name: "version", Message: "Select version: "}, stringVersions, "")
 	if err != nil {
 		return false, "", err
 	}
@@ -82,14 +71,9 @@ func (o *Survey) AskVersion(versions []api.DevfileStackVersion) (back bool, vers
 	return false, strings.ReplaceAll(stringVersions[answerPos], " (default)", ""), err
 }
 
-func (o *Survey) AskStarterProject(projects []string) (bool, int, error) {
+func (o *PromptAsker) AskStarterProject(projects []string) (bool, int, error) {
 	projects = append(projects, "** NO STARTER PROJECT **")
-	question := &survey.Select{
-		Message: "Which starter project do you want to use?",
-		Options: projects,
-	}
-	var answer int
-	err := survey.AskOne(question, &answer)
+	answer, err := o.promptClient.Select(prompt.Question{Name: "starter", Message: "Which starter project do you want to use?"}, projects, "")
 	if err != nil {
 		return false, 0, err
 	}
@@ -99,42 +83,28 @@ func (o *Survey) AskStarterProject(projects []string) (bool, int, error) {
 	return true, answer, nil
 }
 
-func (o *Survey) AskName(defaultName string) (string, error) {
-	question := &survey.Input{
-		Message: "Enter component name:",
-		Default: defaultName,
-	}
-	var answer string
-	err := survey.AskOne(question, &answer)
-	if err != nil {
-		return "", err
-	}
-	return answer, nil
+func (o *PromptAsker) AskName(defaultName string) (string, error) {
+	return o.promptClient.Input(prompt.Question{Name: "name", Message: "Enter component name:"}, defaultName)
 }
 
-func (o *Survey) AskCorrect() (bool, error) {
-	question := &survey.Confirm{
-		Message: "Is this correct?",
-		Default: true,
-	}
-	var answer bool
-	err := survey.AskOne(question, &answer)
-	if err != nil {
-		return false, err
+func (o *PromptAsker) AskCorrect() (bool, error) {
+	return o.promptClient.Confirm(prompt.Question{Name: "correct", Message: "Is this correct?"}, true)
+}
+
+// AskRunPostInitCommands displays the post-init commands of the starter project and asks whether to run them.
+// The commands are not run by default, as they are defined by the starter project.
+func (o *PromptAsker) AskRunPostInitCommands(commands []string) (bool, error) {
+	log.Info("\nThe starter project defines commands to run after its download:")
+	for _, command := range commands {
+		log.Printf("%s", command)
 	}
-	return answer, nil
+	return o.promptClient.Confirm(prompt.Question{Name: "run-post-init", Message: "Do you want to run these commands on your machine now?"}, false)
 }
 
 // AskPersonalizeConfiguration asks the configuration user wants to change
-func (o *Survey) AskPersonalizeConfiguration(configuration ContainerConfiguration) (OperationOnContainer, error) {
+func (o *PromptAsker) AskPersonalizeConfiguration(configuration ContainerConfiguration) (OperationOnContainer, error) {
 	options, tracker := buildPersonalizedConfigurationOptions(configuration)
-	configChangeQuestion := &survey.Select{
-		Message: "What configuration do you want change?",
-		Default: options[0],
-		Options: options,
-	}
-	var configChangeIndex int
-	err := survey.AskOne(configChangeQuestion, &configChangeIndex)
+	configChangeIndex, err := o.promptClient.Select(prompt.Question{Name: "configuration", Message: "What configuration do you want change?"}, options, options[0])
 	if err != nil {
 		return OperationOnContainer{}, err
 	}
@@ -142,20 +112,15 @@ func (o *Survey) AskPersonalizeConfiguration(configuration ContainerConfiguratio
 }
 
 // AskAddEnvVar asks the key and value for env var
-func (o *Survey) AskAddEnvVar() (string, string, error) {
-	newEnvNameQuesion := &survey.Input{
-		Message: "Enter new environment variable name:",
-	}
-	var newEnvNameAnswer string
-	err := survey.AskOne(newEnvNameQuesion, &newEnvNameAnswer)
+func (o *PromptAsker) AskAddEnvVar() (string, string, error) {
+	newEnvNameAnswer, err := o.promptClient.Input(prompt.Question{Name: "envName", Message: "Enter new environment variable name:"}, "")
 	if err != nil {
 		return "", "", err
 	}
-	newEnvValueQuestion := &survey.Input{
+	newEnvValueAnswer, err := o.promptClient.Input(prompt.Question{
+		Name:    "envValue",
 		Message: fmt.Sprintf("Enter value for %q environment variable:", newEnvNameAnswer),
-	}
-	var newEnvValueAnswer string
-	err = survey.AskOne(newEnvValueQuestion, &newEnvValueAnswer)
+	}, "")
 	if err != nil {
 		return "", "", err
 	}
@@ -163,31 +128,20 @@ func (o *Survey) AskAddEnvVar() (string, string, error) {
 }
 
 // AskAddPort asks the container name and port that user wants to add
-func (o *Survey) AskAddPort() (string, error) {
-	newPortQuestion := &survey.Input{
-		Message: "Enter port number:",
-	}
-	var newPortAnswer string
+func (o *PromptAsker) AskAddPort() (string, error) {
 	log.Warning("Please ensure that you do not add a duplicate port number")
-	err := survey.AskOne(newPortQuestion, &newPortAnswer)
-	if err != nil {
-		return "", err
-	}
-	return newPortAnswer, nil
+	return o.promptClient.Input(prompt.Question{Name: "port", Message: "Enter port number:"}, "")
 }
 
-func (o *Survey) AskContainerName(containers []string) (string, error) {
-	selectContainerQuestion := &survey.Select{
+func (o *PromptAsker) AskContainerName(containers []string) (string, error) {
+	answer, err := o.promptClient.Select(prompt.Question{
+		Name:    "container",
 		Message: "Select container for which you want to change configuration?",
-		Default: containers[len(containers)-1],
-		Options: containers,
-	}
-	var selectContainerAnswer string
-	err := survey.AskOne(selectContainerQuestion, &selectContainerAnswer)
+	}, containers, containers[len(containers)-1])
 	if err != nil {
-		return selectContainerAnswer, err
+		return "", err
 	}
-	return selectContainerAnswer, nil
+	return containers[answer], nil
 }
 
 func (dc *DevfileConfiguration) GetContainers() []string {
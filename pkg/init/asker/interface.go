@@ -40,6 +40,9 @@ type Asker interface {
 
 	// AskAddPort asks the container name and port that user wants to add
 	AskAddPort() (string, error)
+
+	// AskRunPostInitCommands asks for confirmation before running the post-init commands of a starter project
+	AskRunPostInitCommands(commands []string) (bool, error)
 }
 
 type ContainerConfiguration struct {
@@ -141,6 +141,21 @@ func (mr *MockAskerMockRecorder) AskPersonalizeConfiguration(configuration inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskPersonalizeConfiguration", reflect.TypeOf((*MockAsker)(nil).AskPersonalizeConfiguration), configuration)
 }
 
+// AskRunPostInitCommands mocks base method.
+func (m *MockAsker) AskRunPostInitCommands(commands []string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskRunPostInitCommands", commands)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskRunPostInitCommands indicates an expected call of AskRunPostInitCommands.
+func (mr *MockAskerMockRecorder) AskRunPostInitCommands(commands interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskRunPostInitCommands", reflect.TypeOf((*MockAsker)(nil).AskRunPostInitCommands), commands)
+}
+
 // AskStarterProject mocks base method.
 func (m *MockAsker) AskStarterProject(projects []string) (bool, int, error) {
 	m.ctrl.T.Helper()
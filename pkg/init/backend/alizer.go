@@ -67,8 +67,8 @@ func (o *AlizerBackend) SelectDevfile(ctx context.Context, flags map[string]stri
 				msg += fmt.Sprintf("\nApplication ports: %s", strings.Join(appPortsAsString, ", "))
 			}
 
-			fmt.Println(msg)
-			fmt.Printf("The devfile \"%s:%s\" from the registry %q will be downloaded.\n", selected.Name, defaultVersion, registry.Name)
+			fmt.Fprintln(log.GetStdout(), msg)
+			fmt.Fprintf(log.GetStdout(), "The devfile \"%s:%s\" from the registry %q will be downloaded.\n", selected.Name, defaultVersion, registry.Name)
 			confirm, err := o.askerClient.AskCorrect()
 			if err != nil {
 				return nil, err
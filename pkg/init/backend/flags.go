@@ -24,6 +24,7 @@ const (
 	FLAG_STARTER          = "starter"
 	FLAG_DEVFILE_PATH     = "devfile-path"
 	FLAG_DEVFILE_VERSION  = "devfile-version"
+	FLAG_FROM_COMPOSE     = "from-compose"
 )
 
 // FlagsBackend is a backend that will extract all needed information from flags passed to the command
@@ -43,8 +44,19 @@ func (o *FlagsBackend) Validate(flags map[string]string, fs filesystem.Filesyste
 	if flags[FLAG_NAME] == "" {
 		return errors.New("missing --name parameter: please add --name <name> to specify a name for the component")
 	}
+	if flags[FLAG_FROM_COMPOSE] != "" {
+		for _, flag := range []string{FLAG_DEVFILE, FLAG_DEVFILE_PATH, FLAG_DEVFILE_REGISTRY, FLAG_DEVFILE_VERSION, FLAG_STARTER} {
+			if flags[flag] != "" {
+				return fmt.Errorf("--%s parameter cannot be used with --%s", flag, FLAG_FROM_COMPOSE)
+			}
+		}
+		if _, err := fs.Stat(flags[FLAG_FROM_COMPOSE]); err != nil {
+			return fmt.Errorf("unable to access the Compose file %q: %w", flags[FLAG_FROM_COMPOSE], err)
+		}
+		return dfutil.ValidateK8sResourceName("name", flags[FLAG_NAME])
+	}
 	if flags[FLAG_DEVFILE] == "" && flags[FLAG_DEVFILE_PATH] == "" {
-		return errors.New("either --devfile or --devfile-path parameter should be specified")
+		return errors.New("either --devfile, --devfile-path or --from-compose parameter should be specified")
 	}
 	if flags[FLAG_DEVFILE] != "" && flags[FLAG_DEVFILE_PATH] != "" {
 		return errors.New("only one of --devfile or --devfile-path parameter should be specified")
@@ -272,6 +272,57 @@ func TestFlagsBackend_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "from-compose passed",
+			args: args{
+				flags: map[string]string{
+					"name":         "aname",
+					"from-compose": "/tmp/docker-compose.yaml",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.WriteFile("/tmp/docker-compose.yaml", []byte("services: {}"), 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "from-compose passed with a missing file",
+			args: args{
+				flags: map[string]string{
+					"name":         "aname",
+					"from-compose": "/tmp/docker-compose.yaml",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "from-compose and devfile passed",
+			args: args{
+				flags: map[string]string{
+					"name":         "aname",
+					"devfile":      "adevfile",
+					"from-compose": "/tmp/docker-compose.yaml",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.WriteFile("/tmp/docker-compose.yaml", []byte("services: {}"), 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
@@ -315,7 +315,7 @@ func PrintConfiguration(config asker.DevfileConfiguration) {
 	}
 
 	// Make sure we add a newline at the end
-	fmt.Println()
+	log.Println()
 }
 
 func getPortsAndEnvVar(obj parser.DevfileObj) (asker.DevfileConfiguration, error) {
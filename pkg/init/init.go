@@ -12,15 +12,21 @@ import (
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	dfutil "github.com/devfile/library/v2/pkg/util"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
 
 	"github.com/redhat-developer/odo/pkg/alizer"
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/credentials"
 	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/compose"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/init/asker"
 	"github.com/redhat-developer/odo/pkg/init/backend"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	"github.com/redhat-developer/odo/pkg/registry"
 	"github.com/redhat-developer/odo/pkg/segment"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -36,13 +42,17 @@ type InitClient struct {
 	fsys             filesystem.Filesystem
 	preferenceClient preference.Client
 	registryClient   registry.Client
+	askerClient      asker.Asker
+
+	// runCommand runs a command locally, in the dir directory
+	runCommand func(dir string, command string) error
 }
 
 var _ Client = (*InitClient)(nil)
 
-func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Client, registryClient registry.Client, alizerClient alizer.Client) *InitClient {
+func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Client, registryClient registry.Client, alizerClient alizer.Client, promptClient prompt.Client) *InitClient {
 	// We create the asker client and the backends here and not at the CLI level, as we want to hide these details to the CLI
-	askerClient := asker.NewSurveyAsker()
+	askerClient := asker.NewPromptAsker(promptClient)
 	return &InitClient{
 		flagsBackend:       backend.NewFlagsBackend(registryClient),
 		interactiveBackend: backend.NewInteractiveBackend(askerClient, registryClient, alizerClient),
@@ -50,6 +60,8 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 		fsys:               fsys,
 		preferenceClient:   preferenceClient,
 		registryClient:     registryClient,
+		askerClient:        askerClient,
+		runCommand:         runShellCommand,
 	}
 }
 
@@ -58,7 +70,7 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 func (o *InitClient) GetFlags(flags map[string]string) map[string]string {
 	initFlags := map[string]string{}
 	for flag, value := range flags {
-		if flag == backend.FLAG_NAME || flag == backend.FLAG_DEVFILE || flag == backend.FLAG_DEVFILE_REGISTRY || flag == backend.FLAG_STARTER || flag == backend.FLAG_DEVFILE_PATH || flag == backend.FLAG_DEVFILE_VERSION {
+		if flag == backend.FLAG_NAME || flag == backend.FLAG_DEVFILE || flag == backend.FLAG_DEVFILE_REGISTRY || flag == backend.FLAG_STARTER || flag == backend.FLAG_DEVFILE_PATH || flag == backend.FLAG_DEVFILE_VERSION || flag == backend.FLAG_FROM_COMPOSE {
 			initFlags[flag] = value
 		}
 	}
@@ -96,10 +108,11 @@ func (o *InitClient) SelectDevfile(ctx context.Context, flags map[string]string,
 		if backend == o.alizerBackend {
 			// Fallback to the Interactive Mode if Alizer could not determine the Devfile.
 			if err != nil {
-				if errors.Is(err, context.Canceled) || errors.Is(err, terminal.InterruptErr) {
+				var noTerminalErr *prompt.NoTerminalError
+				if errors.Is(err, context.Canceled) || errors.Is(err, terminal.InterruptErr) || errors.As(err, &noTerminalErr) {
 					return nil, err
 				}
-				log.Warningf("Could not determine a Devfile based on the files in the current directory: %v", err)
+				log.Warningf(i18n.T("Could not determine a Devfile based on the files in the current directory: %v"), err)
 			}
 			return o.interactiveBackend.SelectDevfile(ctx, flags, fs, dir)
 		}
@@ -132,14 +145,26 @@ func (o *InitClient) downloadDirect(URL string, dest string) error {
 		return err
 	}
 	if strings.HasPrefix(parsedURL.Scheme, "http") {
-		downloadSpinner := log.Spinnerf("Downloading devfile from %q", URL)
+		downloadSpinner := log.Spinnerf(i18n.T("Downloading devfile from %q"), URL)
 		defer downloadSpinner.End(false)
 		params := dfutil.HTTPRequestParams{
 			URL: URL,
 		}
 		devfileData, err := o.registryClient.DownloadFileInMemory(params)
 		if err != nil {
-			return err
+			// The file may require authentication, try again with the credentials found for the URL
+			creds, lookupErr := credentials.Lookup(URL)
+			if lookupErr != nil {
+				klog.V(4).Infof("unable to get the credentials for %q: %v", URL, lookupErr)
+			}
+			if creds == nil {
+				return err
+			}
+			params.Token = creds.Password
+			devfileData, err = o.registryClient.DownloadFileInMemory(params)
+			if err != nil {
+				return err
+			}
 		}
 		err = o.fsys.WriteFile(dest, devfileData, 0644)
 		if err != nil {
@@ -147,7 +172,7 @@ func (o *InitClient) downloadDirect(URL string, dest string) error {
 		}
 		downloadSpinner.End(true)
 	} else {
-		downloadSpinner := log.Spinnerf("Copying devfile from %q", URL)
+		downloadSpinner := log.Spinnerf(i18n.T("Copying devfile from %q"), URL)
 		defer downloadSpinner.End(false)
 		content, err := o.fsys.ReadFile(URL)
 		if err != nil {
@@ -167,6 +192,41 @@ func (o *InitClient) downloadDirect(URL string, dest string) error {
 	return nil
 }
 
+// convertComposeFile converts the Compose file into a Devfile saved in destDir, and returns the path of the Devfile
+func (o *InitClient) convertComposeFile(composeFile string, destDir string) (string, error) {
+	convertSpinner := log.Spinnerf(i18n.T("Converting Compose file %q"), composeFile)
+	defer convertSpinner.End(false)
+
+	content, err := o.fsys.ReadFile(composeFile)
+	if err != nil {
+		return "", err
+	}
+	devfileData, warnings, err := compose.Convert(content)
+	if err != nil {
+		return "", err
+	}
+	metadata := devfileData.GetMetadata()
+	metadata.Name = filepath.Base(destDir)
+	metadata.Description = fmt.Sprintf("Converted from %s", filepath.Base(composeFile))
+	devfileData.SetMetadata(metadata)
+
+	devfileContent, err := yaml.Marshal(devfileData)
+	if err != nil {
+		return "", err
+	}
+	destDevfile := filepath.Join(destDir, "devfile.yaml")
+	err = o.fsys.WriteFile(destDevfile, devfileContent, 0644)
+	if err != nil {
+		return "", err
+	}
+	convertSpinner.End(true)
+
+	for _, warning := range warnings {
+		log.Warningf("%s", warning)
+	}
+	return destDevfile, nil
+}
+
 // downloadFromRegistry downloads a devfile from the provided registry and saves it in dest
 // If registryName is empty, will try to download the devfile from the list of registries in preferences
 func (o *InitClient) downloadFromRegistry(ctx context.Context, registryName string, devfile string, dest string) error {
@@ -177,10 +237,10 @@ func (o *InitClient) downloadFromRegistry(ctx context.Context, registryName stri
 	var downloadSpinner *log.Status
 	var forceRegistry bool
 	if registryName == "" {
-		downloadSpinner = log.Spinnerf("Downloading devfile %q", devfile)
+		downloadSpinner = log.Spinnerf(i18n.T("Downloading devfile %q"), devfile)
 		forceRegistry = false
 	} else {
-		downloadSpinner = log.Spinnerf("Downloading devfile %q from registry %q", devfile, registryName)
+		downloadSpinner = log.Spinnerf(i18n.T("Downloading devfile %q from registry %q"), devfile, registryName)
 		forceRegistry = true
 	}
 	defer downloadSpinner.End(false)
@@ -225,7 +285,7 @@ func (o *InitClient) SelectStarterProject(devfile parser.DevfileObj, flags map[s
 }
 
 func (o *InitClient) DownloadStarterProject(starter *v1alpha2.StarterProject, dest string) (containsDevfile bool, err error) {
-	downloadSpinner := log.Spinnerf("Downloading starter project %q", starter.Name)
+	downloadSpinner := log.Spinnerf(i18n.T("Downloading starter project %q"), starter.Name)
 	containsDevfile, err = o.registryClient.DownloadStarterProject(starter, "", dest, false)
 	if err != nil {
 		downloadSpinner.End(false)
@@ -277,14 +337,27 @@ func (o *InitClient) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flag
 }
 
 func (o *InitClient) SelectAndPersonalizeDevfile(ctx context.Context, flags map[string]string, contextDir string) (parser.DevfileObj, string, *api.DetectionResult, error) {
-	devfileLocation, err := o.SelectDevfile(ctx, flags, o.fsys, contextDir)
-	if err != nil {
-		return parser.DevfileObj{}, "", nil, err
-	}
+	var (
+		devfileLocation *api.DetectionResult
+		devfilePath     string
+		err             error
+	)
+	if composeFile := flags[backend.FLAG_FROM_COMPOSE]; composeFile != "" {
+		devfileLocation = &api.DetectionResult{}
+		devfilePath, err = o.convertComposeFile(composeFile, contextDir)
+		if err != nil {
+			return parser.DevfileObj{}, "", nil, fmt.Errorf("unable to convert Compose file: %w", err)
+		}
+	} else {
+		devfileLocation, err = o.SelectDevfile(ctx, flags, o.fsys, contextDir)
+		if err != nil {
+			return parser.DevfileObj{}, "", nil, err
+		}
 
-	devfilePath, err := o.DownloadDevfile(ctx, devfileLocation, contextDir)
-	if err != nil {
-		return parser.DevfileObj{}, "", nil, fmt.Errorf("unable to download devfile: %w", err)
+		devfilePath, err = o.DownloadDevfile(ctx, devfileLocation, contextDir)
+		if err != nil {
+			return parser.DevfileObj{}, "", nil, fmt.Errorf("unable to download devfile: %w", err)
+		}
 	}
 
 	devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", false)
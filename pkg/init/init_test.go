@@ -6,11 +6,14 @@ import (
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	dfutil "github.com/devfile/library/v2/pkg/util"
 	"github.com/golang/mock/gomock"
+	"github.com/zalando/go-keyring"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/config"
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/credentials"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/registry"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -293,9 +296,46 @@ func TestInitClient_downloadDirect(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "URL requiring authentication",
+			fields: fields{
+				fsys: func(fs filesystem.Filesystem) filesystem.Filesystem {
+					return fs
+				},
+				registryClient: func(ctrl *gomock.Controller) registry.Client {
+					client := registry.NewMockClient(ctrl)
+					gomock.InOrder(
+						client.EXPECT().DownloadFileInMemory(dfutil.HTTPRequestParams{URL: "https://private.example.com/devfile.yaml"}).
+							Return(nil, errors.New("failed to retrieve https://private.example.com/devfile.yaml, 404: Not Found")),
+						client.EXPECT().DownloadFileInMemory(dfutil.HTTPRequestParams{URL: "https://private.example.com/devfile.yaml", Token: "a-token"}).
+							Return([]byte("a content"), nil),
+					)
+					return client
+				},
+			},
+			args: args{
+				URL:  "https://private.example.com/devfile.yaml",
+				dest: "/dest/devfile.yaml",
+			},
+			want: func(fs filesystem.Filesystem) error {
+				content, err := fs.ReadFile("/dest/devfile.yaml")
+				if err != nil {
+					return errors.New("error reading dest file")
+				}
+				if string(content) != "a content" {
+					return errors.New("unexpected file content")
+				}
+				return nil
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			keyring.MockInit()
+			if err := credentials.Save("private.example.com", credentials.Credentials{Password: "a-token"}); err != nil {
+				t.Fatal(err)
+			}
 			fs := filesystem.NewFakeFs()
 			ctrl := gomock.NewController(t)
 			o := &InitClient{
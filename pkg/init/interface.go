@@ -51,6 +51,11 @@ type Client interface {
 	// WARNING: This will first remove all the content of dest.
 	DownloadStarterProject(project *v1alpha2.StarterProject, dest string) (bool, error)
 
+	// RunPostInitCommands runs locally, in the dest directory, the post-init commands defined by the starter project.
+	// If run is false, the user is asked for confirmation in interactive mode (when flags is empty)
+	// and the commands are only listed otherwise.
+	RunPostInitCommands(project *v1alpha2.StarterProject, flags map[string]string, run bool, dest string) error
+
 	// PersonalizeName returns the customized Devfile Metadata Name.
 	// Depending on the flags, it may return a name set interactively or not.
 	PersonalizeName(devfile parser.DevfileObj, flags map[string]string) (string, error)
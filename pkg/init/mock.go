@@ -141,6 +141,20 @@ func (mr *MockClientMockRecorder) PersonalizeName(devfile, flags interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PersonalizeName", reflect.TypeOf((*MockClient)(nil).PersonalizeName), devfile, flags)
 }
 
+// RunPostInitCommands mocks base method.
+func (m *MockClient) RunPostInitCommands(project *v1alpha2.StarterProject, flags map[string]string, run bool, dest string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunPostInitCommands", project, flags, run, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunPostInitCommands indicates an expected call of RunPostInitCommands.
+func (mr *MockClientMockRecorder) RunPostInitCommands(project, flags, run, dest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPostInitCommands", reflect.TypeOf((*MockClient)(nil).RunPostInitCommands), project, flags, run, dest)
+}
+
 // SelectAndPersonalizeDevfile mocks base method.
 func (m *MockClient) SelectAndPersonalizeDevfile(ctx context.Context, flags map[string]string, contextDir string) (parser.DevfileObj, string, *api.DetectionResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,90 @@
+package init
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/fatih/color"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// PostInitCommandsAttribute is the attribute of a starter project defining the commands
+// to run locally after the starter project is downloaded (for example to install the dependencies)
+const PostInitCommandsAttribute = "odo.dev/post-init-commands"
+
+// GetPostInitCommands returns the commands defined in the PostInitCommandsAttribute attribute of the starter project
+func GetPostInitCommands(starter *v1alpha2.StarterProject) ([]string, error) {
+	if starter == nil || !starter.Attributes.Exists(PostInitCommandsAttribute) {
+		return nil, nil
+	}
+	var commands []string
+	err := starter.Attributes.GetInto(PostInitCommandsAttribute, &commands)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %q attribute in the starter project %q: %w", PostInitCommandsAttribute, starter.Name, err)
+	}
+	return commands, nil
+}
+
+// RunPostInitCommands runs the post-init commands of the starter project in the dest directory.
+// If run is false, the user is asked for confirmation in interactive mode,
+// and the commands are only listed otherwise.
+func (o *InitClient) RunPostInitCommands(starter *v1alpha2.StarterProject, flags map[string]string, run bool, dest string) error {
+	commands, err := GetPostInitCommands(starter)
+	if err != nil {
+		return err
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+
+	if !run {
+		if len(flags) != 0 {
+			log.Info(i18n.T("\nThe starter project defines commands to run after its download, you can run them with the --run-post-init flag:"))
+			for _, command := range commands {
+				log.Printf("%s", command)
+			}
+			return nil
+		}
+		run, err = o.askerClient.AskRunPostInitCommands(commands)
+		if err != nil {
+			return err
+		}
+		if !run {
+			return nil
+		}
+	}
+
+	for _, command := range commands {
+		log.Sectionf(i18n.T("Running post-init command %q"), command)
+		err = o.runCommand(dest, command)
+		if err != nil {
+			return fmt.Errorf("post-init command %q failed: %w", command, err)
+		}
+	}
+	return nil
+}
+
+// runShellCommand runs the command with the shell of the system in the dir directory
+func runShellCommand(dir string, command string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	klog.V(4).Infof("Running command: %v", cmd.Args)
+	cmd.Dir = dir
+	cmd.Env = os.Environ()
+	cmd.Stdout = log.GetStdout()
+	cmd.Stderr = log.GetStderr()
+
+	color.Set(color.Italic)
+	defer color.Unset()
+	return cmd.Run()
+}
@@ -0,0 +1,155 @@
+package init
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/init/asker"
+)
+
+func starterWithPostInitCommands(commands ...string) *v1alpha2.StarterProject {
+	var err error
+	return &v1alpha2.StarterProject{
+		Name:       "starter",
+		Attributes: attributes.Attributes{}.Put(PostInitCommandsAttribute, commands, &err),
+	}
+}
+
+func TestGetPostInitCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		starter *v1alpha2.StarterProject
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "no starter project",
+		},
+		{
+			name:    "no attribute",
+			starter: &v1alpha2.StarterProject{Name: "starter"},
+		},
+		{
+			name:    "commands defined",
+			starter: starterWithPostInitCommands("npm install", "npm run build"),
+			want:    []string{"npm install", "npm run build"},
+		},
+		{
+			name: "invalid attribute",
+			starter: &v1alpha2.StarterProject{
+				Name:       "starter",
+				Attributes: attributes.Attributes{}.PutString(PostInitCommandsAttribute, "npm install"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetPostInitCommands(tt.starter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPostInitCommands() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetPostInitCommands() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitClient_RunPostInitCommands(t *testing.T) {
+	tests := []struct {
+		name        string
+		starter     *v1alpha2.StarterProject
+		flags       map[string]string
+		run         bool
+		askerClient func(ctrl *gomock.Controller) asker.Asker
+		commandErr  error
+		wantRun     []string
+		wantErr     bool
+	}{
+		{
+			name:    "no post-init commands",
+			starter: &v1alpha2.StarterProject{Name: "starter"},
+			askerClient: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+		},
+		{
+			name:    "flag set, commands are run without asking",
+			starter: starterWithPostInitCommands("npm install", "npm run build"),
+			flags:   map[string]string{"starter": "starter"},
+			run:     true,
+			askerClient: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+			wantRun: []string{"npm install", "npm run build"},
+		},
+		{
+			name:    "flag not set in non-interactive mode, commands are not run",
+			starter: starterWithPostInitCommands("npm install"),
+			flags:   map[string]string{"starter": "starter"},
+			askerClient: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+		},
+		{
+			name:    "interactive mode, user confirms",
+			starter: starterWithPostInitCommands("npm install"),
+			askerClient: func(ctrl *gomock.Controller) asker.Asker {
+				client := asker.NewMockAsker(ctrl)
+				client.EXPECT().AskRunPostInitCommands([]string{"npm install"}).Return(true, nil)
+				return client
+			},
+			wantRun: []string{"npm install"},
+		},
+		{
+			name:    "interactive mode, user declines",
+			starter: starterWithPostInitCommands("npm install"),
+			askerClient: func(ctrl *gomock.Controller) asker.Asker {
+				client := asker.NewMockAsker(ctrl)
+				client.EXPECT().AskRunPostInitCommands([]string{"npm install"}).Return(false, nil)
+				return client
+			},
+		},
+		{
+			name:    "failing command stops the next commands",
+			starter: starterWithPostInitCommands("npm install", "npm run build"),
+			run:     true,
+			askerClient: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+			commandErr: errors.New("exit status 1"),
+			wantRun:    []string{"npm install"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			var gotRun []string
+			o := &InitClient{
+				askerClient: tt.askerClient(ctrl),
+				runCommand: func(dir string, command string) error {
+					if dir != "dest" {
+						t.Errorf("command run in directory %q, expected %q", dir, "dest")
+					}
+					gotRun = append(gotRun, command)
+					return tt.commandErr
+				},
+			}
+			err := o.RunPostInitCommands(tt.starter, tt.flags, tt.run, "dest")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunPostInitCommands() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantRun, gotRun); diff != "" {
+				t.Errorf("RunPostInitCommands() commands run mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
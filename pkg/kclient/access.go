@@ -0,0 +1,29 @@
+package kclient
+
+import (
+	"context"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IsActionAllowed returns true if the current user is allowed to execute the verb on the resource of the API group in the namespace.
+// namespace is empty for cluster-scoped resources.
+func (c *Client) IsActionAllowed(verb, group, resource, namespace string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     group,
+				Resource:  resource,
+			},
+		},
+	}
+	result, err := c.KubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(context.TODO(), review, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("unable to check if %s %s is allowed: %w", verb, resource, err)
+	}
+	return result.Status.Allowed, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,3 +25,29 @@ func (c *Client) ListConfigMaps(labelSelector string) ([]corev1.ConfigMap, error
 
 	return cmList.Items, nil
 }
+
+// GetConfigMap returns the configmap with the given name in the current namespace
+func (c *Client) GetConfigMap(name string) (*corev1.ConfigMap, error) {
+	return c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// ApplyConfigMap creates the given configmap, or updates it if it already exists
+func (c *Client) ApplyConfigMap(cm corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	updated, err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Update(context.TODO(), &cm, metav1.UpdateOptions{FieldManager: FieldManager})
+	if err == nil {
+		return updated, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to update ConfigMap %s: %w", cm.Name, err)
+	}
+	created, err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Create(context.TODO(), &cm, metav1.CreateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create ConfigMap %s: %w", cm.Name, err)
+	}
+	return created, nil
+}
+
+// DeleteConfigMap deletes the configmap with the given name in the current namespace
+func (c *Client) DeleteConfigMap(name string) error {
+	return c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
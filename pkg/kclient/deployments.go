@@ -128,6 +128,16 @@ func (c *Client) ApplyDeployment(deploy appsv1.Deployment) (*appsv1.Deployment,
 	return deployment, nil
 }
 
+// ScaleDeployment sets the number of replicas of the deployment with the given name
+func (c *Client) ScaleDeployment(name string, replicas int32) error {
+	data := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))
+	_, err := c.KubeClient.AppsV1().Deployments(c.Namespace).Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{FieldManager: FieldManager})
+	if err != nil {
+		return fmt.Errorf("unable to scale Deployment %s: %w", name, err)
+	}
+	return nil
+}
+
 // removeDuplicateEnv removes duplicate environment variables from containers, due to a bug in Service Binding Operator:
 // https://github.com/redhat-developer/service-binding-operator/issues/983
 func (c *Client) removeDuplicateEnv(deploymentName string) error {
@@ -1,6 +1,7 @@
 package kclient
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ktesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 )
 
 // createFakeDeployment creates a fake deployment with the given pod name and labels
@@ -268,3 +270,54 @@ func TestUpdateDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestScaleDeployment(t *testing.T) {
+	tests := []struct {
+		name         string
+		existing     bool
+		wantErr      bool
+		wantReplicas int32
+	}{
+		{
+			name:         "scale down an existing deployment",
+			existing:     true,
+			wantReplicas: 0,
+		},
+		{
+			name:    "error if the deployment does not exist",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			fkclient.Namespace = "default"
+
+			if tt.existing {
+				_, err := fkclientset.Kubernetes.AppsV1().Deployments("default").Create(context.TODO(), &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-component-app"},
+					Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(1)},
+				}, metav1.CreateOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := fkclient.ScaleDeployment("my-component-app", 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ScaleDeployment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			deployment, err := fkclientset.Kubernetes.AppsV1().Deployments("default").Get(context.TODO(), "my-component-app", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *deployment.Spec.Replicas != tt.wantReplicas {
+				t.Errorf("ScaleDeployment() replicas = %d, want %d", *deployment.Spec.Replicas, tt.wantReplicas)
+			}
+		})
+	}
+}
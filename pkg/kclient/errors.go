@@ -30,3 +30,8 @@ func (e NoConnectionError) Error() string {
 	// could also be "cluster is non accessible"
 	return "unable to access the cluster"
 }
+
+// ErrorCode returns the code identifying the error in telemetry
+func (e NoConnectionError) ErrorCode() string {
+	return "cluster-not-accessible"
+}
@@ -2,9 +2,12 @@ package kclient
 
 import (
 	"context"
+	"sort"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
@@ -34,3 +37,22 @@ func (c *Client) PodWarningEventWatcher(ctx context.Context) (result watch.Inter
 	}
 	return result, false, nil
 }
+
+// ListPodEvents returns the events related to the pod in the current namespace, sorted by time of last occurrence
+func (c *Client) ListPodEvents(podName string) ([]corev1.Event, error) {
+	selector := fields.Set{
+		"involvedObject.kind": "Pod",
+		"involvedObject.name": podName,
+	}.AsSelector().String()
+	list, err := c.GetClient().CoreV1().Events(c.GetCurrentNamespace()).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := list.Items
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+	return events, nil
+}
@@ -2,10 +2,13 @@ package kclient
 
 import (
 	"context"
+	"errors"
 
 	v1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
 )
 
 var (
@@ -14,8 +17,92 @@ var (
 		Version: "v1",
 		Kind:    "Route",
 	}
+
+	IngressGVK = v1.SchemeGroupVersion.WithKind("Ingress")
 )
 
+// defaultIngressClassAnnotation is set to "true" on the IngressClass used by the Ingresses not specifying any class
+const defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+
+// ClusterCapabilities describes the resources supported by the cluster to expose and route traffic to the components
+type ClusterCapabilities struct {
+	// Routes is true if OpenShift Routes (route.openshift.io/v1) are supported
+	Routes bool
+	// Ingresses is true if networking.k8s.io/v1 Ingresses are supported
+	Ingresses bool
+	// IngressClasses are the names of the IngressClasses defined on the cluster;
+	// it is empty if they cannot be listed by the user
+	IngressClasses []string
+	// DefaultIngressClass is the name of the IngressClass marked as default, if any
+	DefaultIngressClass string
+}
+
+// ExposureGVK returns the kind of resource to create to expose an endpoint outside the cluster:
+// a Route if they are supported, or an Ingress otherwise
+func (o ClusterCapabilities) ExposureGVK() (schema.GroupVersionKind, error) {
+	switch {
+	case o.Routes:
+		return RouteGVK, nil
+	case o.Ingresses:
+		return IngressGVK, nil
+	default:
+		return schema.GroupVersionKind{}, errors.New("the cluster supports neither OpenShift Routes nor networking.k8s.io/v1 Ingresses")
+	}
+}
+
+// IngressClassName returns the class to set on the Ingresses created by odo:
+// empty if the cluster defines a default class (or if the classes are unknown), or the only class defined on the cluster
+func (o ClusterCapabilities) IngressClassName() string {
+	if o.DefaultIngressClass != "" || len(o.IngressClasses) != 1 {
+		return ""
+	}
+	return o.IngressClasses[0]
+}
+
+// GetClusterCapabilities detects the API groups available on the cluster to expose the components.
+// The detection is done once, and its result is cached for the lifetime of the client.
+func (c *Client) GetClusterCapabilities() (ClusterCapabilities, error) {
+	if c.capabilities != nil {
+		return *c.capabilities, nil
+	}
+
+	var (
+		capabilities ClusterCapabilities
+		err          error
+	)
+	capabilities.Routes, err = c.IsResourceSupported(RouteGVK.Group, RouteGVK.Version, "routes")
+	if err != nil {
+		return ClusterCapabilities{}, err
+	}
+	capabilities.Ingresses, err = c.IsResourceSupported(IngressGVK.Group, IngressGVK.Version, "ingresses")
+	if err != nil {
+		return ClusterCapabilities{}, err
+	}
+
+	if capabilities.Ingresses {
+		var classes *v1.IngressClassList
+		classes, err = c.KubeClient.NetworkingV1().IngressClasses().List(context.TODO(), metav1.ListOptions{})
+		switch {
+		case err == nil:
+			for _, class := range classes.Items {
+				capabilities.IngressClasses = append(capabilities.IngressClasses, class.GetName())
+				if class.GetAnnotations()[defaultIngressClassAnnotation] == "true" {
+					capabilities.DefaultIngressClass = class.GetName()
+				}
+			}
+		case kerrors.IsForbidden(err) || kerrors.IsNotFound(err):
+			// IngressClasses are cluster-scoped, and may not be readable by the user
+			klog.V(4).Infof("unable to list IngressClasses: %v", err)
+		default:
+			return ClusterCapabilities{}, err
+		}
+	}
+
+	klog.V(4).Infof("Cluster capabilities: %+v", capabilities)
+	c.capabilities = &capabilities
+	return capabilities, nil
+}
+
 func (c *Client) ListIngresses(namespace, selector string) (*v1.IngressList, error) {
 	if namespace == "" {
 		namespace = c.Namespace
@@ -0,0 +1,182 @@
+package kclient
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkingv1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ktesting "k8s.io/client-go/testing"
+
+	odoFake "github.com/redhat-developer/odo/pkg/kclient/fake"
+)
+
+func TestClient_GetClusterCapabilities(t *testing.T) {
+	routes := &metav1.APIResourceList{
+		GroupVersion: "route.openshift.io/v1",
+		APIResources: []metav1.APIResource{{Name: "routes", Namespaced: true, Kind: "Route"}},
+	}
+	ingresses := &metav1.APIResourceList{
+		GroupVersion: "networking.k8s.io/v1",
+		APIResources: []metav1.APIResource{{Name: "ingresses", Namespaced: true, Kind: "Ingress"}},
+	}
+	ingressClass := func(name string, isDefault bool) networkingv1.IngressClass {
+		class := networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if isDefault {
+			class.SetAnnotations(map[string]string{defaultIngressClassAnnotation: "true"})
+		}
+		return class
+	}
+
+	tests := []struct {
+		name           string
+		resources      []*metav1.APIResourceList
+		ingressClasses func() (*networkingv1.IngressClassList, error)
+		want           ClusterCapabilities
+		wantErr        bool
+	}{
+		{
+			name:      "OpenShift cluster",
+			resources: []*metav1.APIResourceList{routes, ingresses},
+			ingressClasses: func() (*networkingv1.IngressClassList, error) {
+				return &networkingv1.IngressClassList{Items: []networkingv1.IngressClass{ingressClass("openshift-default", false)}}, nil
+			},
+			want: ClusterCapabilities{
+				Routes:         true,
+				Ingresses:      true,
+				IngressClasses: []string{"openshift-default"},
+			},
+		},
+		{
+			name:      "Kubernetes cluster with a default IngressClass",
+			resources: []*metav1.APIResourceList{ingresses},
+			ingressClasses: func() (*networkingv1.IngressClassList, error) {
+				return &networkingv1.IngressClassList{Items: []networkingv1.IngressClass{ingressClass("nginx", true), ingressClass("traefik", false)}}, nil
+			},
+			want: ClusterCapabilities{
+				Ingresses:           true,
+				IngressClasses:      []string{"nginx", "traefik"},
+				DefaultIngressClass: "nginx",
+			},
+		},
+		{
+			name:      "IngressClasses not readable by the user",
+			resources: []*metav1.APIResourceList{ingresses},
+			ingressClasses: func() (*networkingv1.IngressClassList, error) {
+				return nil, kerrors.NewForbidden(schema.GroupResource{Group: "networking.k8s.io", Resource: "ingressclasses"}, "", nil)
+			},
+			want: ClusterCapabilities{
+				Ingresses: true,
+			},
+		},
+		{
+			name: "cluster supporting neither Routes nor Ingresses",
+			want: ClusterCapabilities{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			fd := odoFake.NewFakeDiscovery()
+			for _, resources := range tt.resources {
+				fd.AddResourceList(resources.GroupVersion, resources)
+			}
+			fkclient.SetDiscoveryInterface(fd)
+
+			calls := 0
+			fkclientset.Kubernetes.PrependReactor("list", "ingressclasses", func(action ktesting.Action) (bool, runtime.Object, error) {
+				calls++
+				if tt.ingressClasses == nil {
+					return true, &networkingv1.IngressClassList{}, nil
+				}
+				classes, err := tt.ingressClasses()
+				if err != nil {
+					return true, &networkingv1.IngressClassList{}, err
+				}
+				return true, classes, nil
+			})
+
+			for i := 0; i < 2; i++ {
+				got, err := fkclient.GetClusterCapabilities()
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("GetClusterCapabilities() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.want, got); diff != "" {
+					t.Errorf("GetClusterCapabilities() mismatch (-want +got):\n%s", diff)
+				}
+			}
+			if calls > 1 {
+				t.Errorf("expected the IngressClasses to be listed at most once, but got %d calls", calls)
+			}
+		})
+	}
+}
+
+func TestClusterCapabilities_ExposureGVK(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities ClusterCapabilities
+		want         schema.GroupVersionKind
+		wantErr      bool
+	}{
+		{
+			name:         "Routes are preferred",
+			capabilities: ClusterCapabilities{Routes: true, Ingresses: true},
+			want:         RouteGVK,
+		},
+		{
+			name:         "Ingresses without Routes",
+			capabilities: ClusterCapabilities{Ingresses: true},
+			want:         IngressGVK,
+		},
+		{
+			name:    "neither Routes nor Ingresses",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.capabilities.ExposureGVK()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExposureGVK() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ExposureGVK() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterCapabilities_IngressClassName(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities ClusterCapabilities
+		want         string
+	}{
+		{
+			name:         "default class",
+			capabilities: ClusterCapabilities{IngressClasses: []string{"nginx"}, DefaultIngressClass: "nginx"},
+			want:         "",
+		},
+		{
+			name:         "single class not marked as default",
+			capabilities: ClusterCapabilities{IngressClasses: []string{"nginx"}},
+			want:         "nginx",
+		},
+		{
+			name:         "several classes without default",
+			capabilities: ClusterCapabilities{IngressClasses: []string{"nginx", "traefik"}},
+			want:         "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.capabilities.IngressClassName(); got != tt.want {
+				t.Errorf("IngressClassName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -33,6 +33,9 @@ import (
 type ClientInterface interface {
 	platform.Client
 
+	// access.go
+	IsActionAllowed(verb, group, resource, namespace string) (bool, error)
+
 	// binding.go
 	IsServiceBindingSupported() (bool, error)
 	GetBindableKinds() (bindingApi.BindableKinds, error)
@@ -45,6 +48,9 @@ type ClientInterface interface {
 
 	// configmap.go
 	ListConfigMaps(labelSelector string) ([]corev1.ConfigMap, error)
+	GetConfigMap(name string) (*corev1.ConfigMap, error)
+	ApplyConfigMap(cm corev1.ConfigMap) (*corev1.ConfigMap, error)
+	DeleteConfigMap(name string) error
 
 	// deployment.go
 	GetDeploymentByName(name string) (*appsv1.Deployment, error)
@@ -54,6 +60,7 @@ type ClientInterface interface {
 	CreateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error)
 	UpdateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error)
 	ApplyDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error)
+	ScaleDeployment(name string, replicas int32) error
 	GetDeploymentAPIVersion() (schema.GroupVersionKind, error)
 	IsDeploymentExtensionsV1Beta1() (bool, error)
 	DeploymentWatcher(ctx context.Context, selector string) (watch.Interface, error)
@@ -67,6 +74,7 @@ type ClientInterface interface {
 
 	// events.go
 	PodWarningEventWatcher(ctx context.Context) (result watch.Interface, isForbidden bool, err error)
+	ListPodEvents(podName string) ([]corev1.Event, error)
 
 	// kclient.go
 	GetClient() kubernetes.Interface
@@ -77,6 +85,7 @@ type ClientInterface interface {
 	SetDiscoveryInterface(client discovery.DiscoveryInterface)
 	IsResourceSupported(apiGroup, apiVersion, resourceName string) (bool, error)
 	IsSSASupported() bool
+	PopRetriedErrors() []string
 	Refresh() (newConfig bool, err error)
 
 	// namespace.go
@@ -107,6 +116,9 @@ type ClientInterface interface {
 	GetGVKFromGVR(gvr schema.GroupVersionResource) (schema.GroupVersionKind, error)
 	GetGVRFromGVK(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error)
 
+	// metrics.go
+	GetPodMetrics(ctx context.Context, podName string) (map[string]corev1.ResourceList, error)
+
 	// owner_reference.go
 	TryWithBlockOwnerDeletion(ownerReference metav1.OwnerReference, exec func(ownerReference metav1.OwnerReference) error) error
 
@@ -118,8 +130,9 @@ type ClientInterface interface {
 	// port_forwarding.go
 	// SetupPortForwarding creates port-forwarding for the pod on the port pairs provided in the
 	// ["<localhost-port>":"<remote-pod-port>"] format. errOut is used by the client-go library to output any errors
-	// encountered while the port-forwarding is running
-	SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}, address string) error
+	// encountered while the port-forwarding is running. onConnection, if not nil, is called for each new connection
+	// accepted on a forwarded port
+	SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}, address string, onConnection func()) error
 
 	// projects.go
 	CreateNewProject(projectName string, wait bool) error
@@ -129,6 +142,10 @@ type ClientInterface interface {
 	IsProjectSupported() (bool, error)
 	ListProjectNames() ([]string, error)
 
+	// quotas.go
+	ListResourceQuotas() ([]corev1.ResourceQuota, error)
+	ListLimitRanges() ([]corev1.LimitRange, error)
+
 	// secrets.go
 	CreateTLSSecret(tlsCertificate []byte, tlsPrivKey []byte, objectMeta metav1.ObjectMeta) (*corev1.Secret, error)
 	GetSecret(name, namespace string) (*corev1.Secret, error)
@@ -160,6 +177,7 @@ type ClientInterface interface {
 	UpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error
 
 	// ingress_routes.go
+	GetClusterCapabilities() (ClusterCapabilities, error)
 	ListIngresses(namespace, selector string) (*v1.IngressList, error)
 
 	ListJobs(selector string) (*batchv1.JobList, error)
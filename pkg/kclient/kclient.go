@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/redhat-developer/odo/pkg/log"
 	"k8s.io/kubectl/pkg/util/term"
+	"net/http"
 	"strings"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog"
 
 	// api clientsets
@@ -63,6 +65,16 @@ type Client struct {
 	restmapper            *restmapper.DeferredDiscoveryRESTMapper
 
 	supportedResources map[string]bool
+	// configSelection is the kubeconfig file and context selected to create the client
+	configSelection ConfigSelection
+	// retryPolicy is the policy used to retry the requests failing with a transient error
+	retryPolicy RetryPolicy
+	// retriedErrors records the transient errors that caused requests to be retried
+	// Use PopRetriedErrors()
+	retriedErrors *retriedErrorsRecorder
+	// capabilities is the result of the detection of the resources available to expose the components
+	// Use GetClusterCapabilities()
+	capabilities *ClusterCapabilities
 	// Is server side apply supported by cluster
 	// Use IsSSASupported()
 	isSSASupported *bool
@@ -82,9 +94,61 @@ type Client struct {
 var _ ClientInterface = (*Client)(nil)
 var _ platform.Client = (*Client)(nil)
 
-// New creates a new client
+// New creates a new client, retrying the requests failing with a transient error with the DefaultRetryPolicy
 func New() (*Client, error) {
-	return NewForConfig(nil)
+	return NewWithRetryPolicy(DefaultRetryPolicy)
+}
+
+// NewWithRetryPolicy creates a new client, retrying the requests failing with a transient error with the given policy
+func NewWithRetryPolicy(policy RetryPolicy) (*Client, error) {
+	return NewForConfigSelection(ConfigSelection{}, policy)
+}
+
+// ConfigSelection selects the kubeconfig file and the context used by the client, instead of the defaults
+type ConfigSelection struct {
+	// Kubeconfig is the path of the kubeconfig file, used instead of the KUBECONFIG environment variable and ~/.kube/config
+	Kubeconfig string
+	// Context is the name of the context, used instead of the current context of the kubeconfig
+	Context string
+}
+
+func (o ConfigSelection) loadingRules() *clientcmd.ClientConfigLoadingRules {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = o.Kubeconfig
+	return loadingRules
+}
+
+func (o ConfigSelection) clientConfig() clientcmd.ClientConfig {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(o.loadingRules(), &clientcmd.ConfigOverrides{CurrentContext: o.Context})
+}
+
+// Namespace returns the namespace of the selected context of the selected kubeconfig file
+func (o ConfigSelection) Namespace() (string, error) {
+	namespace, _, err := o.clientConfig().Namespace()
+	return namespace, err
+}
+
+// NewForConfigSelection creates a new client using the selected kubeconfig file and context,
+// retrying the requests failing with a transient error with the given policy
+func NewForConfigSelection(selection ConfigSelection, policy RetryPolicy) (*Client, error) {
+	return newForConfig(selection.clientConfig(), selection, policy)
+}
+
+// GetCurrentContext returns the name of the context used by the client
+func (c *Client) GetCurrentContext() (string, error) {
+	rawConfig, err := c.KubeConfig.RawConfig()
+	if err != nil {
+		return "", err
+	}
+	return c.currentContextName(rawConfig), nil
+}
+
+// currentContextName returns the name of the context selected for the client, or the current context of the kubeconfig
+func (c *Client) currentContextName(rawConfig clientcmdapi.Config) string {
+	if c.configSelection.Context != "" {
+		return c.configSelection.Context
+	}
+	return rawConfig.CurrentContext
 }
 
 func (c *Client) GetClient() kubernetes.Interface {
@@ -105,15 +169,18 @@ func (c *Client) GetDynamicClient() dynamic.Interface {
 
 // NewForConfig creates a new client with the provided configuration or initializes the configuration if none is provided
 func NewForConfig(config clientcmd.ClientConfig) (client *Client, err error) {
+	return newForConfig(config, ConfigSelection{}, DefaultRetryPolicy)
+}
+
+func newForConfig(config clientcmd.ClientConfig, selection ConfigSelection, retryPolicy RetryPolicy) (client *Client, err error) {
 	if config == nil {
 		// initialize client-go clients
-		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-		configOverrides := &clientcmd.ConfigOverrides{}
-		config = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+		config = selection.clientConfig()
 	}
 
 	client = new(Client)
 	client.KubeConfig = config
+	client.configSelection = selection
 
 	client.KubeClientConfig, err = client.KubeConfig.ClientConfig()
 	if err != nil {
@@ -137,6 +204,13 @@ func NewForConfig(config clientcmd.ClientConfig) (client *Client, err error) {
 		Color: term.AllowsColorOutput(log.GetStderr()),
 	})
 
+	// Retry the idempotent requests failing with a transient error
+	client.retryPolicy = retryPolicy
+	client.retriedErrors = newRetriedErrorsRecorder()
+	client.KubeClientConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return newRetryTransport(rt, retryPolicy, client.retriedErrors)
+	})
+
 	client.KubeClient, err = kubernetes.NewForConfig(client.KubeClientConfig)
 	if err != nil {
 		return nil, err
@@ -176,6 +250,12 @@ func NewForConfig(config clientcmd.ClientConfig) (client *Client, err error) {
 	}
 
 	config_flags := genericclioptions.NewConfigFlags(true)
+	if selection.Kubeconfig != "" {
+		config_flags.KubeConfig = &selection.Kubeconfig
+	}
+	if selection.Context != "" {
+		config_flags.Context = &selection.Context
+	}
 	client.cachedDiscoveryClient, err = config_flags.ToDiscoveryClient()
 	if err != nil {
 		return nil, err
@@ -216,6 +296,14 @@ func (c *Client) GeneratePortForwardReq(podName string) *rest.Request {
 		SubResource("portforward")
 }
 
+// PopRetriedErrors returns a description of the transient errors that caused requests to be retried since the last call
+func (c *Client) PopRetriedErrors() []string {
+	if c.retriedErrors == nil {
+		return nil
+	}
+	return c.retriedErrors.pop()
+}
+
 func (c *Client) SetDiscoveryInterface(client discovery.DiscoveryInterface) {
 	c.discoveryClient = client
 }
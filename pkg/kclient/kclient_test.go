@@ -2,6 +2,8 @@ package kclient
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -83,3 +85,81 @@ func TestClient_IsSSASupported(t *testing.T) {
 		})
 	}
 }
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster-1
+  cluster:
+    server: https://cluster-1.example.com:6443
+- name: cluster-2
+  cluster:
+    server: https://cluster-2.example.com:6443
+contexts:
+- name: context-1
+  context:
+    cluster: cluster-1
+    namespace: ns-1
+- name: context-2
+  context:
+    cluster: cluster-2
+    namespace: ns-2
+current-context: context-1
+`
+
+func TestNewForConfigSelection(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(testKubeconfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// the KUBECONFIG environment variable must be ignored when a kubeconfig file is selected
+	t.Setenv("KUBECONFIG", filepath.Join(t.TempDir(), "missing"))
+
+	tests := []struct {
+		name          string
+		context       string
+		wantContext   string
+		wantNamespace string
+		wantServer    string
+		wantErr       bool
+	}{
+		{
+			name:          "current context of the selected kubeconfig",
+			wantContext:   "context-1",
+			wantNamespace: "ns-1",
+			wantServer:    "https://cluster-1.example.com:6443",
+		},
+		{
+			name:          "selected context",
+			context:       "context-2",
+			wantContext:   "context-2",
+			wantNamespace: "ns-2",
+			wantServer:    "https://cluster-2.example.com:6443",
+		},
+		{
+			name:    "non-existing context",
+			context: "context-3",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewForConfigSelection(ConfigSelection{Kubeconfig: kubeconfig, Context: tt.context}, DefaultRetryPolicy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewForConfigSelection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotContext, _ := client.GetCurrentContext(); gotContext != tt.wantContext {
+				t.Errorf("GetCurrentContext() = %q, want %q", gotContext, tt.wantContext)
+			}
+			if client.Namespace != tt.wantNamespace {
+				t.Errorf("Namespace = %q, want %q", client.Namespace, tt.wantNamespace)
+			}
+			if client.KubeClientConfig.Host != tt.wantServer {
+				t.Errorf("Host = %q, want %q", client.KubeClientConfig.Host, tt.wantServer)
+			}
+		})
+	}
+}
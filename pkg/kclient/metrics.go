@@ -0,0 +1,59 @@
+package kclient
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodMetricsGVR is the resource of the metrics of the pods, served by the metrics API (metrics-server)
+var PodMetricsGVR = schema.GroupVersionResource{
+	Group:    "metrics.k8s.io",
+	Version:  "v1beta1",
+	Resource: "pods",
+}
+
+// GetPodMetrics returns the current resource usage (CPU and memory) of each container of the pod, indexed by container name,
+// as reported by the metrics API. An error is returned if the metrics API is not available on the cluster.
+func (c *Client) GetPodMetrics(ctx context.Context, podName string) (map[string]corev1.ResourceList, error) {
+	metrics, err := c.DynamicClient.Resource(PodMetricsGVR).Namespace(c.Namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return parsePodMetrics(metrics)
+}
+
+// parsePodMetrics extracts the usage of the containers from a PodMetrics resource
+func parsePodMetrics(metrics *unstructured.Unstructured) (map[string]corev1.ResourceList, error) {
+	containers, _, err := unstructured.NestedSlice(metrics.Object, "containers")
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]corev1.ResourceList, len(containers))
+	for _, container := range containers {
+		containerMap, ok := container.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(containerMap, "name")
+		usage, _, err := unstructured.NestedStringMap(containerMap, "usage")
+		if err != nil {
+			return nil, err
+		}
+		resources := make(corev1.ResourceList, len(usage))
+		for resourceName, value := range usage {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s usage %q for container %q: %w", resourceName, value, name, err)
+			}
+			resources[corev1.ResourceName(resourceName)] = quantity
+		}
+		result[name] = resources
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package kclient
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestClient_GetPodMetrics(t *testing.T) {
+	podMetrics := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       "PodMetrics",
+		"metadata": map[string]interface{}{
+			"name":      "my-pod",
+			"namespace": "my-ns",
+		},
+		"containers": []interface{}{
+			map[string]interface{}{
+				"name": "runtime",
+				"usage": map[string]interface{}{
+					"cpu":    "250m",
+					"memory": "128Mi",
+				},
+			},
+			map[string]interface{}{
+				"name": "tools",
+				"usage": map[string]interface{}{
+					"cpu":    "1234567n",
+					"memory": "10240Ki",
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		podName string
+		want    map[string]corev1.ResourceList
+		wantErr bool
+	}{
+		{
+			name:    "metrics of the containers of the pod",
+			podName: "my-pod",
+			want: map[string]corev1.ResourceList{
+				"runtime": {
+					corev1.ResourceCPU:    resource.MustParse("250m"),
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+				"tools": {
+					corev1.ResourceCPU:    resource.MustParse("1234567n"),
+					corev1.ResourceMemory: resource.MustParse("10240Ki"),
+				},
+			},
+		},
+		{
+			name:    "no metrics for the pod",
+			podName: "other-pod",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				PodMetricsGVR: "PodMetricsList",
+			})
+			// the fake client guesses the resource from the kind (podmetricses), so the object is created with the expected resource
+			_, err := dynamicClient.Resource(PodMetricsGVR).Namespace("my-ns").Create(context.Background(), podMetrics, metav1.CreateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := &Client{
+				Namespace:     "my-ns",
+				DynamicClient: dynamicClient,
+			}
+			got, err := c.GetPodMetrics(context.Background(), tt.podName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPodMetrics() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("GetPodMetrics() = %v, want %v", got, tt.want)
+			}
+			for container, resources := range tt.want {
+				for name, quantity := range resources {
+					gotQuantity := got[container][name]
+					if gotQuantity.Cmp(quantity) != 0 {
+						t.Errorf("GetPodMetrics() %s usage of %q = %s, want %s", name, container, gotQuantity.String(), quantity.String())
+					}
+				}
+			}
+		})
+	}
+}
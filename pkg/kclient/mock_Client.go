@@ -57,6 +57,21 @@ func (m *MockClientInterface) EXPECT() *MockClientInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ApplyConfigMap mocks base method.
+func (m *MockClientInterface) ApplyConfigMap(cm v12.ConfigMap) (*v12.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyConfigMap", cm)
+	ret0, _ := ret[0].(*v12.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyConfigMap indicates an expected call of ApplyConfigMap.
+func (mr *MockClientInterfaceMockRecorder) ApplyConfigMap(cm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyConfigMap", reflect.TypeOf((*MockClientInterface)(nil).ApplyConfigMap), cm)
+}
+
 // ApplyDeployment mocks base method.
 func (m *MockClientInterface) ApplyDeployment(deploy v10.Deployment) (*v10.Deployment, error) {
 	m.ctrl.T.Helper()
@@ -204,6 +219,20 @@ func (mr *MockClientInterfaceMockRecorder) CreateTLSSecret(tlsCertificate, tlsPr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTLSSecret", reflect.TypeOf((*MockClientInterface)(nil).CreateTLSSecret), tlsCertificate, tlsPrivKey, objectMeta)
 }
 
+// DeleteConfigMap mocks base method.
+func (m *MockClientInterface) DeleteConfigMap(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigMap", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfigMap indicates an expected call of DeleteConfigMap.
+func (mr *MockClientInterfaceMockRecorder) DeleteConfigMap(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigMap", reflect.TypeOf((*MockClientInterface)(nil).DeleteConfigMap), name)
+}
+
 // DeleteDynamicResource mocks base method.
 func (m *MockClientInterface) DeleteDynamicResource(name string, gvr schema.GroupVersionResource, wait bool) error {
 	m.ctrl.T.Helper()
@@ -463,6 +492,21 @@ func (mr *MockClientInterfaceMockRecorder) GetClientConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientConfig", reflect.TypeOf((*MockClientInterface)(nil).GetClientConfig))
 }
 
+// GetClusterCapabilities mocks base method.
+func (m *MockClientInterface) GetClusterCapabilities() (ClusterCapabilities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterCapabilities")
+	ret0, _ := ret[0].(ClusterCapabilities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterCapabilities indicates an expected call of GetClusterCapabilities.
+func (mr *MockClientInterfaceMockRecorder) GetClusterCapabilities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterCapabilities", reflect.TypeOf((*MockClientInterface)(nil).GetClusterCapabilities))
+}
+
 // GetConfig mocks base method.
 func (m *MockClientInterface) GetConfig() clientcmd.ClientConfig {
 	m.ctrl.T.Helper()
@@ -477,6 +521,21 @@ func (mr *MockClientInterfaceMockRecorder) GetConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockClientInterface)(nil).GetConfig))
 }
 
+// GetConfigMap mocks base method.
+func (m *MockClientInterface) GetConfigMap(name string) (*v12.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigMap", name)
+	ret0, _ := ret[0].(*v12.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigMap indicates an expected call of GetConfigMap.
+func (mr *MockClientInterfaceMockRecorder) GetConfigMap(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigMap", reflect.TypeOf((*MockClientInterface)(nil).GetConfigMap), name)
+}
+
 // GetCurrentNamespace mocks base method.
 func (m *MockClientInterface) GetCurrentNamespace() string {
 	m.ctrl.T.Helper()
@@ -818,6 +877,21 @@ func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, fo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClientInterface)(nil).GetPodLogs), podName, containerName, followLog)
 }
 
+// GetPodMetrics mocks base method.
+func (m *MockClientInterface) GetPodMetrics(ctx context.Context, podName string) (map[string]v12.ResourceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodMetrics", ctx, podName)
+	ret0, _ := ret[0].(map[string]v12.ResourceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPodMetrics indicates an expected call of GetPodMetrics.
+func (mr *MockClientInterfaceMockRecorder) GetPodMetrics(ctx, podName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodMetrics", reflect.TypeOf((*MockClientInterface)(nil).GetPodMetrics), ctx, podName)
+}
+
 // GetPodUsingComponentName mocks base method.
 func (m *MockClientInterface) GetPodUsingComponentName(componentName string) (*v12.Pod, error) {
 	m.ctrl.T.Helper()
@@ -999,6 +1073,21 @@ func (mr *MockClientInterfaceMockRecorder) GetWorkloadKinds() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkloadKinds", reflect.TypeOf((*MockClientInterface)(nil).GetWorkloadKinds))
 }
 
+// IsActionAllowed mocks base method.
+func (m *MockClientInterface) IsActionAllowed(verb, group, resource, namespace string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActionAllowed", verb, group, resource, namespace)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActionAllowed indicates an expected call of IsActionAllowed.
+func (mr *MockClientInterfaceMockRecorder) IsActionAllowed(verb, group, resource, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActionAllowed", reflect.TypeOf((*MockClientInterface)(nil).IsActionAllowed), verb, group, resource, namespace)
+}
+
 // IsCSVSupported mocks base method.
 func (m *MockClientInterface) IsCSVSupported() (bool, error) {
 	m.ctrl.T.Helper()
@@ -1178,6 +1267,21 @@ func (mr *MockClientInterfaceMockRecorder) ListJobs(selector interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockClientInterface)(nil).ListJobs), selector)
 }
 
+// ListLimitRanges mocks base method.
+func (m *MockClientInterface) ListLimitRanges() ([]v12.LimitRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLimitRanges")
+	ret0, _ := ret[0].([]v12.LimitRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLimitRanges indicates an expected call of ListLimitRanges.
+func (mr *MockClientInterfaceMockRecorder) ListLimitRanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLimitRanges", reflect.TypeOf((*MockClientInterface)(nil).ListLimitRanges))
+}
+
 // ListPVCNames mocks base method.
 func (m *MockClientInterface) ListPVCNames(selector string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1208,6 +1312,21 @@ func (mr *MockClientInterfaceMockRecorder) ListPVCs(selector interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPVCs", reflect.TypeOf((*MockClientInterface)(nil).ListPVCs), selector)
 }
 
+// ListPodEvents mocks base method.
+func (m *MockClientInterface) ListPodEvents(podName string) ([]v12.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodEvents", podName)
+	ret0, _ := ret[0].([]v12.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodEvents indicates an expected call of ListPodEvents.
+func (mr *MockClientInterfaceMockRecorder) ListPodEvents(podName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodEvents", reflect.TypeOf((*MockClientInterface)(nil).ListPodEvents), podName)
+}
+
 // ListProjectNames mocks base method.
 func (m *MockClientInterface) ListProjectNames() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1223,6 +1342,21 @@ func (mr *MockClientInterfaceMockRecorder) ListProjectNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectNames", reflect.TypeOf((*MockClientInterface)(nil).ListProjectNames))
 }
 
+// ListResourceQuotas mocks base method.
+func (m *MockClientInterface) ListResourceQuotas() ([]v12.ResourceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceQuotas")
+	ret0, _ := ret[0].([]v12.ResourceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceQuotas indicates an expected call of ListResourceQuotas.
+func (mr *MockClientInterfaceMockRecorder) ListResourceQuotas() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceQuotas", reflect.TypeOf((*MockClientInterface)(nil).ListResourceQuotas))
+}
+
 // ListSecrets mocks base method.
 func (m *MockClientInterface) ListSecrets(labelSelector string) ([]v12.Secret, error) {
 	m.ctrl.T.Helper()
@@ -1330,6 +1464,20 @@ func (mr *MockClientInterfaceMockRecorder) PodWatcher(ctx, selector interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodWatcher", reflect.TypeOf((*MockClientInterface)(nil).PodWatcher), ctx, selector)
 }
 
+// PopRetriedErrors mocks base method.
+func (m *MockClientInterface) PopRetriedErrors() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopRetriedErrors")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// PopRetriedErrors indicates an expected call of PopRetriedErrors.
+func (mr *MockClientInterfaceMockRecorder) PopRetriedErrors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopRetriedErrors", reflect.TypeOf((*MockClientInterface)(nil).PopRetriedErrors))
+}
+
 // Refresh mocks base method.
 func (m *MockClientInterface) Refresh() (bool, error) {
 	m.ctrl.T.Helper()
@@ -1359,6 +1507,20 @@ func (mr *MockClientInterfaceMockRecorder) RunLogout(stdout interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunLogout", reflect.TypeOf((*MockClientInterface)(nil).RunLogout), stdout)
 }
 
+// ScaleDeployment mocks base method.
+func (m *MockClientInterface) ScaleDeployment(name string, replicas int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleDeployment", name, replicas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleDeployment indicates an expected call of ScaleDeployment.
+func (mr *MockClientInterfaceMockRecorder) ScaleDeployment(name, replicas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleDeployment", reflect.TypeOf((*MockClientInterface)(nil).ScaleDeployment), name, replicas)
+}
+
 // SetCurrentNamespace mocks base method.
 func (m *MockClientInterface) SetCurrentNamespace(namespace string) error {
 	m.ctrl.T.Helper()
@@ -1398,17 +1560,17 @@ func (mr *MockClientInterfaceMockRecorder) SetNamespace(ns interface{}) *gomock.
 }
 
 // SetupPortForwarding mocks base method.
-func (m *MockClientInterface) SetupPortForwarding(pod *v12.Pod, portPairs []string, out, errOut io.Writer, stopChan chan struct{}, address string) error {
+func (m *MockClientInterface) SetupPortForwarding(pod *v12.Pod, portPairs []string, out, errOut io.Writer, stopChan chan struct{}, address string, onConnection func()) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetupPortForwarding", pod, portPairs, out, errOut, stopChan, address)
+	ret := m.ctrl.Call(m, "SetupPortForwarding", pod, portPairs, out, errOut, stopChan, address, onConnection)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetupPortForwarding indicates an expected call of SetupPortForwarding.
-func (mr *MockClientInterfaceMockRecorder) SetupPortForwarding(pod, portPairs, out, errOut, stopChan, address interface{}) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) SetupPortForwarding(pod, portPairs, out, errOut, stopChan, address, onConnection interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwarding", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwarding), pod, portPairs, out, errOut, stopChan, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwarding", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwarding), pod, portPairs, out, errOut, stopChan, address, onConnection)
 }
 
 // TryWithBlockOwnerDeletion mocks base method.
@@ -151,9 +151,14 @@ func (c *Client) SetCurrentNamespace(namespace string) error {
 		return fmt.Errorf("unable to switch to %s project: %w", namespace, err)
 	}
 
-	rawConfig.Contexts[rawConfig.CurrentContext].Namespace = namespace
+	contextName := c.currentContextName(rawConfig)
+	kubeContext, ok := rawConfig.Contexts[contextName]
+	if !ok {
+		return fmt.Errorf("unable to switch to %s project: context %q not found", namespace, contextName)
+	}
+	kubeContext.Namespace = namespace
 
-	err = clientcmd.ModifyConfig(clientcmd.NewDefaultClientConfigLoadingRules(), rawConfig, true)
+	err = clientcmd.ModifyConfig(c.configSelection.loadingRules(), rawConfig, true)
 	if err != nil {
 		return fmt.Errorf("unable to switch to %s project: %w", namespace, err)
 	}
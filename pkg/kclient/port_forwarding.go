@@ -5,11 +5,12 @@ import (
 	"net/http"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
 )
 
-func (c *Client) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}, address string) error {
+func (c *Client) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}, address string, onConnection func()) error {
 	if address == "" {
 		address = "localhost"
 	}
@@ -20,7 +21,10 @@ func (c *Client) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io
 
 	req := c.GeneratePortForwardReq(pod.Name)
 
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+	var dialer httpstream.Dialer = spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+	if onConnection != nil {
+		dialer = connectionNotifierDialer{dialer: dialer, onConnection: onConnection}
+	}
 	// passing nil for readyChan because it's eventually being closed if it's not nil
 	// passing nil for out because we only care for error, not for output messages; we want to print our own messages
 	fw, err := portforward.NewOnAddresses(dialer, []string{address}, portPairs, stopChan, nil, out, errOut)
@@ -38,3 +42,32 @@ func (c *Client) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io
 
 	return nil
 }
+
+// connectionNotifierDialer is a dialer returning connections calling onConnection
+// each time a new local connection is forwarded to the pod
+type connectionNotifierDialer struct {
+	dialer       httpstream.Dialer
+	onConnection func()
+}
+
+func (o connectionNotifierDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, protocol, err := o.dialer.Dial(protocols...)
+	if err != nil {
+		return nil, "", err
+	}
+	return connectionNotifier{Connection: conn, onConnection: o.onConnection}, protocol, nil
+}
+
+type connectionNotifier struct {
+	httpstream.Connection
+	onConnection func()
+}
+
+// CreateStream calls onConnection when the data stream of a forwarded connection is created,
+// the error stream being created before for the same connection
+func (o connectionNotifier) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	if headers.Get(corev1.StreamType) == corev1.StreamTypeData {
+		o.onConnection()
+	}
+	return o.Connection.CreateStream(headers)
+}
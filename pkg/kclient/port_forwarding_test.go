@@ -0,0 +1,36 @@
+package kclient
+
+import (
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+type fakeConnection struct {
+	httpstream.Connection
+}
+
+func (o fakeConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	return nil, nil
+}
+
+func Test_connectionNotifier_CreateStream(t *testing.T) {
+	connections := 0
+	conn := connectionNotifier{
+		Connection:   fakeConnection{},
+		onConnection: func() { connections++ },
+	}
+	for _, streamType := range []string{corev1.StreamTypeError, corev1.StreamTypeData, corev1.StreamTypeError, corev1.StreamTypeData} {
+		headers := http.Header{}
+		headers.Set(corev1.StreamType, streamType)
+		_, err := conn.CreateStream(headers)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if connections != 2 {
+		t.Errorf("onConnection called %d times, want 2", connections)
+	}
+}
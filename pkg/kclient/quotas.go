@@ -0,0 +1,26 @@
+package kclient
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListResourceQuotas returns the resource quotas of the current namespace
+func (c *Client) ListResourceQuotas() ([]corev1.ResourceQuota, error) {
+	list, err := c.KubeClient.CoreV1().ResourceQuotas(c.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// ListLimitRanges returns the limit ranges of the current namespace
+func (c *Client) ListLimitRanges() ([]corev1.LimitRange, error) {
+	list, err := c.KubeClient.CoreV1().LimitRanges(c.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
@@ -12,7 +12,7 @@ import (
 // If the namespace or cluster of the current context has changed since the last time
 // the config has been loaded, the function will not update the configuration
 func (c *Client) Refresh() (bool, error) {
-	newClient, err := New()
+	newClient, err := NewForConfigSelection(c.configSelection, c.retryPolicy)
 	if err != nil {
 		return false, err
 	}
@@ -39,6 +39,8 @@ func (c *Client) Refresh() (bool, error) {
 	}
 
 	if updated {
+		// The transient errors recorded before the refresh are still reported
+		newClient.retriedErrors.takeFrom(c.retriedErrors)
 		*c = *newClient
 	}
 	return updated, nil
@@ -50,7 +52,10 @@ func getContext(c *Client) (cluster string, namespace string, err error) {
 		return "", "", err
 	}
 
-	currentCtx := raw.Contexts[raw.CurrentContext]
+	currentCtx, ok := raw.Contexts[c.currentContextName(raw)]
+	if !ok {
+		return "", "", nil
+	}
 	return currentCtx.Cluster, currentCtx.Namespace, nil
 }
 
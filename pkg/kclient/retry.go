@@ -0,0 +1,227 @@
+package kclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/klog"
+)
+
+// RetryPolicy configures how the idempotent requests to the cluster API failing with a transient error are retried
+type RetryPolicy struct {
+	// Retries is the maximum number of times a request is retried; retries are disabled if 0
+	Retries int
+	// Backoff is the delay before the first retry, doubled before each new retry
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy used by the clients created with New
+var DefaultRetryPolicy = RetryPolicy{
+	Retries: 3,
+	Backoff: 500 * time.Millisecond,
+}
+
+// transientErrorMessages are the messages of Internal Server Errors caused by a transient failure of the cluster
+var transientErrorMessages = []string{
+	"etcdserver:",
+	"failed calling webhook",
+	"the server was unable to return a response in the time allotted",
+}
+
+// retriedErrorsRecorder records the transient errors that caused requests to be retried,
+// so they can be reported to the user in a single warning.
+// It is shared by the transports of all the clientsets of a Client.
+type retriedErrorsRecorder struct {
+	lock sync.Mutex
+	// errors counts the transient errors, by message
+	errors map[string]int
+}
+
+func newRetriedErrorsRecorder() *retriedErrorsRecorder {
+	return &retriedErrorsRecorder{errors: make(map[string]int)}
+}
+
+func (o *retriedErrorsRecorder) record(msg string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.errors[msg]++
+}
+
+// takeFrom moves the transient errors recorded by other into o
+func (o *retriedErrorsRecorder) takeFrom(other *retriedErrorsRecorder) {
+	if other == nil || other == o {
+		return
+	}
+	other.lock.Lock()
+	recorded := other.errors
+	other.errors = make(map[string]int)
+	other.lock.Unlock()
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	for msg, count := range recorded {
+		o.errors[msg] += count
+	}
+}
+
+// pop returns a description of the transient errors recorded since the last call, and resets them
+func (o *retriedErrorsRecorder) pop() []string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	result := make([]string, 0, len(o.errors))
+	for msg, count := range o.errors {
+		if count > 1 {
+			msg = fmt.Sprintf("%s (x%d)", msg, count)
+		}
+		result = append(result, msg)
+	}
+	sort.Strings(result)
+	o.errors = make(map[string]int)
+	return result
+}
+
+// GetRetriedErrorsWarning returns a single warning for all the transient errors returned by PopRetriedErrors,
+// or an empty string if there are none
+func GetRetriedErrorsWarning(retriedErrors []string) string {
+	if len(retriedErrors) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Some requests to the cluster failed with a transient error and have been retried:\n - %s",
+		strings.Join(retriedErrors, "\n - "))
+}
+
+// retryTransport is an http.RoundTripper retrying the idempotent requests failing with a transient error
+type retryTransport struct {
+	next     http.RoundTripper
+	policy   RetryPolicy
+	recorder *retriedErrorsRecorder
+	// sleep waits for the given duration, or until the request is cancelled
+	sleep func(req *http.Request, d time.Duration) error
+}
+
+var _ http.RoundTripper = (*retryTransport)(nil)
+var _ utilnet.RoundTripperWrapper = (*retryTransport)(nil)
+
+func newRetryTransport(next http.RoundTripper, policy RetryPolicy, recorder *retriedErrorsRecorder) *retryTransport {
+	return &retryTransport{
+		next:     next,
+		policy:   policy,
+		recorder: recorder,
+		sleep:    sleepWithRequestContext,
+	}
+}
+
+func (o *retryTransport) WrappedRoundTripper() http.RoundTripper {
+	return o.next
+}
+
+func (o *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if o.policy.Retries <= 0 || !isIdempotentRequest(req) {
+		return o.next.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := o.next.RoundTrip(attemptReq)
+		transientErr := getTransientError(resp, err)
+		if transientErr == "" || attempt >= o.policy.Retries {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		msg := fmt.Sprintf("%s %s: %s", req.Method, req.URL.Path, transientErr)
+		klog.V(3).Infof("retrying request after transient error (attempt %d/%d): %s", attempt+1, o.policy.Retries, msg)
+		o.recorder.record(msg)
+
+		if err = o.sleep(req, o.policy.Backoff<<attempt); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isIdempotentRequest returns true if the request can be sent several times without changing the result:
+// read requests, updates and server-side apply patches.
+// Requests with a body that cannot be read again are not considered idempotent.
+// Upgrade requests are never considered idempotent: the transport also wraps the round trippers of the
+// exec and port-forward connections (through KubeClientConfig.Wrap), and an upgraded connection cannot be sent again.
+func isIdempotentRequest(req *http.Request) bool {
+	if httpstream.IsUpgradeRequest(req) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut:
+		return true
+	case http.MethodPatch:
+		return req.Header.Get("Content-Type") == "application/apply-patch+yaml"
+	default:
+		return false
+	}
+}
+
+// getTransientError returns a description of the error if the response or error of a request is caused
+// by a transient failure of the cluster.
+// The responses with a Retry-After header are not considered, as they are already retried by client-go.
+// The body of the response is kept readable.
+func getTransientError(resp *http.Response, err error) string {
+	if err != nil {
+		if utilnet.IsConnectionReset(err) || utilnet.IsProbableEOF(err) || utilnet.IsTimeout(err) {
+			return err.Error()
+		}
+		return ""
+	}
+
+	if resp.Header.Get("Retry-After") != "" {
+		return ""
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return resp.Status
+	case http.StatusInternalServerError:
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			return ""
+		}
+		for _, msg := range transientErrorMessages {
+			if strings.Contains(string(body), msg) {
+				return fmt.Sprintf("%s (%s)", resp.Status, msg)
+			}
+		}
+	}
+	return ""
+}
+
+func sleepWithRequestContext(req *http.Request, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,260 @@
+package kclient
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// fakeRoundTripper returns the responses in order, one per request
+type fakeRoundTripper struct {
+	responses []func() (*http.Response, error)
+	bodies    []string
+}
+
+func (o *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		o.bodies = append(o.bodies, string(body))
+	}
+	next := o.responses[0]
+	if len(o.responses) > 1 {
+		o.responses = o.responses[1:]
+	}
+	return next()
+}
+
+func response(status int, body string) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}
+}
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	etcdError := `{"kind":"Status","status":"Failure","message":"etcdserver: request timed out","code":500}`
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		upgrade     bool
+		body        string
+		responses   []func() (*http.Response, error)
+		wantStatus  int
+		wantErr     bool
+		wantCalls   int
+		wantRetried []string
+	}{
+		{
+			name:       "GET succeeding at first attempt",
+			method:     http.MethodGet,
+			responses:  []func() (*http.Response, error){response(200, "")},
+			wantStatus: 200,
+			wantCalls:  1,
+		},
+		{
+			name:        "GET retried on Service Unavailable",
+			method:      http.MethodGet,
+			responses:   []func() (*http.Response, error){response(503, ""), response(503, ""), response(200, "")},
+			wantStatus:  200,
+			wantCalls:   3,
+			wantRetried: []string{"GET /api/v1/pods: Service Unavailable (x2)"},
+		},
+		{
+			name:        "server-side apply retried on etcd error",
+			method:      http.MethodPatch,
+			contentType: "application/apply-patch+yaml",
+			body:        "apiVersion: v1",
+			responses:   []func() (*http.Response, error){response(500, etcdError), response(200, "")},
+			wantStatus:  200,
+			wantCalls:   2,
+			wantRetried: []string{"PATCH /api/v1/pods: Internal Server Error (etcdserver:)"},
+		},
+		{
+			name:   "GET retried on connection reset",
+			method: http.MethodGet,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return nil, syscall.ECONNRESET },
+				response(200, ""),
+			},
+			wantStatus:  200,
+			wantCalls:   2,
+			wantRetried: []string{"GET /api/v1/pods: connection reset by peer"},
+		},
+		{
+			name:       "Internal Server Error not caused by a transient error",
+			method:     http.MethodGet,
+			responses:  []func() (*http.Response, error){response(500, "something went wrong")},
+			wantStatus: 500,
+			wantCalls:  1,
+		},
+		{
+			name:       "POST not retried",
+			method:     http.MethodPost,
+			body:       "{}",
+			responses:  []func() (*http.Response, error){response(503, ""), response(200, "")},
+			wantStatus: 503,
+			wantCalls:  1,
+		},
+		{
+			name:       "exec and port-forward upgrade requests not retried",
+			method:     http.MethodPost,
+			upgrade:    true,
+			responses:  []func() (*http.Response, error){response(503, ""), response(200, "")},
+			wantStatus: 503,
+			wantCalls:  1,
+		},
+		{
+			name:       "GET upgrade requests not retried",
+			method:     http.MethodGet,
+			upgrade:    true,
+			responses:  []func() (*http.Response, error){response(503, ""), response(200, "")},
+			wantStatus: 503,
+			wantCalls:  1,
+		},
+		{
+			name:   "non-transient error not retried",
+			method: http.MethodGet,
+			responses: []func() (*http.Response, error){func() (*http.Response, error) {
+				return nil, errors.New("x509: certificate signed by unknown authority")
+			}},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		{
+			name:        "retries exhausted",
+			method:      http.MethodGet,
+			responses:   []func() (*http.Response, error){response(504, "")},
+			wantStatus:  504,
+			wantCalls:   4,
+			wantRetried: []string{"GET /api/v1/pods: Gateway Timeout (x3)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			next := &fakeRoundTripper{}
+			for _, resp := range tt.responses {
+				resp := resp
+				next.responses = append(next.responses, func() (*http.Response, error) {
+					calls++
+					return resp()
+				})
+			}
+			recorder := newRetriedErrorsRecorder()
+			transport := newRetryTransport(next, RetryPolicy{Retries: 3, Backoff: time.Second}, recorder)
+			var sleeps []time.Duration
+			transport.sleep = func(_ *http.Request, d time.Duration) error {
+				sleeps = append(sleeps, d)
+				return nil
+			}
+
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			req, err := http.NewRequest(tt.method, "https://cluster.example.com/api/v1/pods", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+				req.Header.Set("Upgrade", "SPDY/3.1")
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RoundTrip() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if resp != nil && resp.StatusCode != tt.wantStatus {
+				t.Errorf("RoundTrip() status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+			for i, d := range sleeps {
+				if want := time.Second << i; d != want {
+					t.Errorf("backoff before retry %d = %v, want %v", i+1, d, want)
+				}
+			}
+			for _, b := range next.bodies {
+				if b != tt.body {
+					t.Errorf("request body = %q, want %q", b, tt.body)
+				}
+			}
+			if diff := cmp.Diff(tt.wantRetried, recorder.pop(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("retried errors mismatch (-want +got):\n%s", diff)
+			}
+			if got := recorder.pop(); len(got) != 0 {
+				t.Errorf("expected retried errors to be reset, got %v", got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RetriesDisabled(t *testing.T) {
+	calls := 0
+	next := &fakeRoundTripper{responses: []func() (*http.Response, error){func() (*http.Response, error) {
+		calls++
+		return response(503, "")()
+	}}}
+	transport := newRetryTransport(next, RetryPolicy{}, newRetriedErrorsRecorder())
+	req, err := http.NewRequest(http.MethodGet, "https://cluster.example.com/api/v1/pods", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 503 || calls != 1 {
+		t.Errorf("expected a single call returning 503, got %d calls returning %d", calls, resp.StatusCode)
+	}
+}
+
+func TestRetriedErrorsRecorder_takeFrom(t *testing.T) {
+	previous := newRetriedErrorsRecorder()
+	previous.record("GET /api/v1/pods: Service Unavailable")
+	previous.record("GET /api/v1/pods: Service Unavailable")
+	recorder := newRetriedErrorsRecorder()
+	recorder.record("GET /api/v1/pods: Service Unavailable")
+	recorder.record("PUT /api/v1/services/svc: Gateway Timeout")
+
+	recorder.takeFrom(previous)
+
+	want := []string{"GET /api/v1/pods: Service Unavailable (x3)", "PUT /api/v1/services/svc: Gateway Timeout"}
+	if diff := cmp.Diff(want, recorder.pop()); diff != "" {
+		t.Errorf("retried errors mismatch (-want +got):\n%s", diff)
+	}
+	if got := previous.pop(); len(got) != 0 {
+		t.Errorf("expected the errors to be moved, got %v", got)
+	}
+}
+
+func TestGetRetriedErrorsWarning(t *testing.T) {
+	if got := GetRetriedErrorsWarning(nil); got != "" {
+		t.Errorf("expected no warning, got %q", got)
+	}
+	got := GetRetriedErrorsWarning([]string{"GET /api/v1/pods: Service Unavailable (x2)", "PUT /api/v1/services/svc: Gateway Timeout"})
+	want := "Some requests to the cluster failed with a transient error and have been retried:\n" +
+		" - GET /api/v1/pods: Service Unavailable (x2)\n" +
+		" - PUT /api/v1/services/svc: Gateway Timeout"
+	if got != want {
+		t.Errorf("GetRetriedErrorsWarning() = %q, want %q", got, want)
+	}
+}
@@ -40,12 +40,14 @@ func (c *Client) RunLogout(stdout io.Writer) error {
 	}
 
 	// deleting token for the current server from local config
-	for key, value := range rawConfig.AuthInfos {
-		if key == rawConfig.Contexts[rawConfig.CurrentContext].AuthInfo {
-			value.Token = ""
+	if kubeContext, ok := rawConfig.Contexts[c.currentContextName(rawConfig)]; ok {
+		for key, value := range rawConfig.AuthInfos {
+			if key == kubeContext.AuthInfo {
+				value.Token = ""
+			}
 		}
 	}
-	err = clientcmd.ModifyConfig(clientcmd.NewDefaultClientConfigLoadingRules(), rawConfig, true)
+	err = clientcmd.ModifyConfig(c.configSelection.loadingRules(), rawConfig, true)
 	if err != nil {
 		klog.V(1).Infof("%v : unable to write config to config file", err)
 	}
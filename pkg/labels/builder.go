@@ -53,6 +53,11 @@ func (o builder) WithProjectType(typ string) builder {
 	return o
 }
 
+func (o builder) WithLanguage(lang string) builder {
+	o.m[odoLanguageAnnotation] = lang
+	return o
+}
+
 func (o builder) WithMode(mode string) builder {
 	o.m[odoModeLabel] = mode
 	return o
@@ -28,6 +28,12 @@ const (
 	// odoProjectTypeAnnotation indicates the project type of the component
 	odoProjectTypeAnnotation = "odo.dev/project-type"
 
+	// odoLanguageAnnotation indicates the language of the component
+	odoLanguageAnnotation = "odo.dev/language"
+
+	// odoProfileAnnotation indicates the profile used to run the component in Dev mode
+	odoProfileAnnotation = "odo.dev/profile"
+
 	appLabel = "app"
 
 	componentLabel = "component"
@@ -51,3 +57,24 @@ const (
 	// odoManager is the value of the manager when a component is managed by odo
 	odoManager = "odo"
 )
+
+const (
+	// ManagerTypeOdoV3 means that the component is managed by odo v3
+	ManagerTypeOdoV3 = "odo-v3"
+	// ManagerTypeOdoV2 means that the component is managed by odo v2
+	ManagerTypeOdoV2 = "odo-v2"
+	// ManagerTypeDevfile means that the component is managed by another tool, with Devfile metadata
+	ManagerTypeDevfile = "devfile"
+	// ManagerTypeOther means that the component is managed by another tool, without Devfile metadata
+	ManagerTypeOther = "other"
+	// ManagerTypeNone means that the component is not managed by any tool, as a plain Deployment
+	ManagerTypeNone = "none"
+)
+
+const (
+	// ExtraLabelsAttribute is the Devfile attribute defining labels to add to all the resources created by odo
+	ExtraLabelsAttribute = "odo.dev/extra-labels"
+
+	// ExtraAnnotationsAttribute is the Devfile attribute defining annotations to add to all the resources created by odo
+	ExtraAnnotationsAttribute = "odo.dev/extra-annotations"
+)
@@ -0,0 +1,74 @@
+package labels
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ParseExtra parses a comma-separated list of key=value pairs, as set in the ExtraLabels and ExtraAnnotations preferences
+func ParseExtra(value string) (map[string]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	result := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		k, v, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || k == "" {
+			return nil, fmt.Errorf("invalid key=value pair %q", pair)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// FormatExtra returns the extra labels or annotations as a comma-separated list of key=value pairs, sorted by key
+func FormatExtra(extra map[string]string) string {
+	pairs := make([]string, 0, len(extra))
+	for k, v := range extra {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// ValidateExtraLabels checks that the keys and values are valid Kubernetes label keys and values
+func ValidateExtraLabels(extra map[string]string) error {
+	for k, v := range extra {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q for label %q: %s", v, k, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// ValidateExtraAnnotations checks that the keys are valid Kubernetes annotation keys
+func ValidateExtraAnnotations(extra map[string]string) error {
+	for k := range extra {
+		if errs := validation.IsQualifiedName(strings.ToLower(k)); len(errs) > 0 {
+			return fmt.Errorf("invalid annotation key %q: %s", k, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// AddExtra returns a copy of m completed with the extra labels or annotations; the keys already present in m
+// are not overridden, so that the labels and annotations set by odo are kept
+func AddExtra(m map[string]string, extra map[string]string) map[string]string {
+	if len(extra) == 0 {
+		return m
+	}
+	result := make(map[string]string, len(m)+len(extra))
+	for k, v := range extra {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
@@ -201,6 +201,61 @@ func SetProjectType(annotations map[string]string, value string) {
 	annotations[odoProjectTypeAnnotation] = value
 }
 
+// SetLanguage sets the language of the component, if any
+func SetLanguage(annotations map[string]string, value string) {
+	if value != "" {
+		annotations[odoLanguageAnnotation] = value
+	}
+}
+
+// GetLanguage returns the language of the component, as set by odo in the annotations (or in the labels for Podman),
+// or an empty string if the language is unknown
+func GetLanguage(labels map[string]string, annotations map[string]string) string {
+	if lang, ok := annotations[odoLanguageAnnotation]; ok {
+		return lang
+	}
+	return labels[odoLanguageAnnotation]
+}
+
+// GetManagerType returns the kind of tool managing the resource, based on the managed-by label and on the Devfile metadata:
+// odo v2 or v3, another tool with or without Devfile metadata, or no tool at all for a plain resource.
+func GetManagerType(labels map[string]string, annotations map[string]string) string {
+	switch managedBy := GetManagedBy(labels); {
+	case managedBy == odoManager:
+		if strings.HasPrefix(GetManagedByVersion(labels), "v2.") {
+			return ManagerTypeOdoV2
+		}
+		return ManagerTypeOdoV3
+	case managedBy == "":
+		return ManagerTypeNone
+	case hasDevfileMetadata(labels, annotations):
+		return ManagerTypeDevfile
+	default:
+		return ManagerTypeOther
+	}
+}
+
+// hasDevfileMetadata returns true if the resource contains metadata coming from a Devfile
+func hasDevfileMetadata(labels map[string]string, annotations map[string]string) bool {
+	for _, key := range []string{odoProjectTypeAnnotation, odoLanguageAnnotation} {
+		if _, ok := annotations[key]; ok {
+			return true
+		}
+		if _, ok := labels[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SetProfile sets the profile used to run the component, if any. As the annotation is part of the pod template,
+// the pod is recreated when the profile changes, so the commands of the new profile are executed in a new container.
+func SetProfile(annotations map[string]string, profile string) {
+	if profile != "" {
+		annotations[odoProfileAnnotation] = profile
+	}
+}
+
 func AddCommonAnnotations(annotations map[string]string) {
 	// Enable use of ImageStreams on OpenShift:
 	// https://github.com/redhat-developer/odo/issues/6376
@@ -265,3 +265,104 @@ func Test_sanitizeLabelValue(t *testing.T) {
 		})
 	}
 }
+
+func TestParseExtra(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "empty value",
+			value: "",
+		},
+		{
+			name:  "several pairs",
+			value: "team=my-team, cost-center=1234,empty=",
+			want:  map[string]string{"team": "my-team", "cost-center": "1234", "empty": ""},
+		},
+		{
+			name:    "missing value",
+			value:   "team",
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			value:   "=my-team",
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExtra(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseExtra() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseExtra() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddExtra(t *testing.T) {
+	m := map[string]string{"app": "app", "component": "my-component"}
+	got := AddExtra(m, map[string]string{"component": "other", "team": "my-team"})
+	want := map[string]string{"app": "app", "component": "my-component", "team": "my-team"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("AddExtra() mismatch (-want +got):\n%s", diff)
+	}
+	if _, found := m["team"]; found {
+		t.Errorf("AddExtra() should not modify its argument")
+	}
+}
+
+func TestGetManagerType(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		want        string
+	}{
+		{
+			name:   "odo v3",
+			labels: Builder().WithManager("odo").WithManagedByVersion("v3.15.0").Labels(),
+			want:   ManagerTypeOdoV3,
+		},
+		{
+			name:   "odo v2",
+			labels: Builder().WithManager("odo").WithManagedByVersion("v2.5.1").Labels(),
+			want:   ManagerTypeOdoV2,
+		},
+		{
+			name:        "other tool with Devfile metadata in annotations",
+			labels:      Builder().WithManager("devfile-tool").Labels(),
+			annotations: Builder().WithLanguage("java").Labels(),
+			want:        ManagerTypeDevfile,
+		},
+		{
+			name:   "other tool with Devfile metadata in labels",
+			labels: Builder().WithManager("devfile-tool").WithProjectType("nodejs").Labels(),
+			want:   ManagerTypeDevfile,
+		},
+		{
+			name:   "other tool without Devfile metadata",
+			labels: Builder().WithManager("Helm").Labels(),
+			want:   ManagerTypeOther,
+		},
+		{
+			name:        "plain resource",
+			labels:      Builder().WithComponentName("my-component").Labels(),
+			annotations: Builder().WithProjectType("nodejs").Labels(),
+			want:        ManagerTypeNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetManagerType(tt.labels, tt.annotations); got != tt.want {
+				t.Errorf("GetManagerType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
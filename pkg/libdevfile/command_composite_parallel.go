@@ -4,11 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
-
-	"github.com/redhat-developer/odo/pkg/util"
 )
 
 // parallelCompositeCommand is a command implementation that represents parallel composite commands
@@ -35,13 +34,15 @@ func (o *parallelCompositeCommand) CheckValidity() error {
 	cmds := o.command.Composite.Commands
 	for _, cmd := range cmds {
 		if _, ok := allCommands[strings.ToLower(cmd)]; !ok {
-			return fmt.Errorf("composite command %q has command %v not found in devfile", cmd, o.command.Id)
+			return fmt.Errorf("composite command %q references command %q not found in devfile", o.command.Id, cmd)
 		}
 	}
 	return nil
 }
 
-// Execute loops over each command and executes them in parallel
+// Execute executes the commands in parallel, and waits for all of them to terminate.
+// The output of each command is prefixed with its Id, and the errors of all the failed commands are returned
+// in a single ParallelCommandError.
 func (o *parallelCompositeCommand) Execute(ctx context.Context, handler Handler, parentGroup *v1alpha2.CommandGroup) error {
 	allCommands, err := allCommandsMap(o.devfileObj)
 	if err != nil {
@@ -50,24 +51,38 @@ func (o *parallelCompositeCommand) Execute(ctx context.Context, handler Handler,
 	if parentGroup == nil {
 		parentGroup = o.command.Composite.Group
 	}
-	commandExecs := util.NewConcurrentTasks(len(o.command.Composite.Commands))
-	for _, devfileCmd := range o.command.Composite.Commands {
-		cmd, err2 := newCommand(o.devfileObj, allCommands[devfileCmd])
+
+	ids := o.command.Composite.Commands
+	cmds := make([]command, 0, len(ids))
+	for _, devfileCmd := range ids {
+		cmd, err2 := newCommand(o.devfileObj, allCommands[strings.ToLower(devfileCmd)])
 		if err2 != nil {
 			return err2
 		}
-		commandExecs.Add(util.ConcurrentTask{
-			ToRun: func(errChannel chan error) {
-				err3 := cmd.Execute(ctx, handler, parentGroup)
-				if err3 != nil {
-					errChannel <- err3
-				}
-			},
-		})
+		cmds = append(cmds, cmd)
 	}
-	err = commandExecs.Run()
-	if err != nil {
-		return fmt.Errorf("parallel command execution failed: %w", err)
+
+	errs := make([]error, len(cmds))
+	var wg sync.WaitGroup
+	for i := range cmds {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = cmds[i].Execute(withOutputPrefix(ctx, ids[i]), handler, parentGroup)
+		}(i)
+	}
+	wg.Wait()
+
+	var failed []string
+	failedErrs := make(map[string]error)
+	for i, cmdErr := range errs {
+		if cmdErr != nil {
+			failed = append(failed, ids[i])
+			failedErrs[ids[i]] = cmdErr
+		}
+	}
+	if len(failed) != 0 {
+		return NewParallelCommandError(o.command.Id, failed, failedErrs)
 	}
 	return nil
 }
@@ -0,0 +1,123 @@
+package libdevfile
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+)
+
+func TestParallelCompositeCommand_Execute(t *testing.T) {
+	children := []v1alpha2.Command{
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Kind:        v1alpha2.BuildCommandGroupKind,
+			Id:          "build-frontend",
+			CommandLine: "npm run build",
+			Component:   "runtime",
+			WorkingDir:  "${PROJECT_SOURCE}/frontend",
+			Env:         []v1alpha2.EnvVar{{Name: "NODE_ENV", Value: "development"}},
+		}),
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Kind:        v1alpha2.BuildCommandGroupKind,
+			Id:          "build-backend",
+			CommandLine: "go build ./...",
+			Component:   "runtime",
+			WorkingDir:  "${PROJECT_SOURCE}/backend",
+			Env:         []v1alpha2.EnvVar{{Name: "CGO_ENABLED", Value: "0"}},
+		}),
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Kind:        v1alpha2.BuildCommandGroupKind,
+			Id:          "build-docs",
+			CommandLine: "make docs",
+			Component:   "runtime",
+		}),
+	}
+	parallel := generator.GetCompositeCommand(generator.CompositeCommandParams{
+		Kind:      v1alpha2.BuildCommandGroupKind,
+		Id:        "build-all",
+		IsDefault: pointer.Bool(true),
+		Commands:  []string{"Build-Frontend", "build-backend", "build-docs"},
+		Parallel:  pointer.Bool(true),
+	})
+
+	dData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dData.AddCommands(append([]v1alpha2.Command{parallel}, children...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: dData}
+
+	// all the commands wait for the others to be started, so the test fails if the commands are executed serially
+	var started sync.WaitGroup
+	started.Add(len(children))
+	var lock sync.Mutex
+	prefixes := map[string]string{}
+
+	ctrl := gomock.NewController(t)
+	handler := NewMockHandler(ctrl)
+	for _, child := range children {
+		child := child
+		handler.EXPECT().ExecuteTerminatingCommand(gomock.Any(), gomock.Eq(child)).DoAndReturn(
+			func(ctx context.Context, cmd v1alpha2.Command) error {
+				lock.Lock()
+				prefixes[cmd.Id] = GetOutputPrefix(ctx)
+				lock.Unlock()
+
+				started.Done()
+				done := make(chan struct{})
+				go func() {
+					started.Wait()
+					close(done)
+				}()
+				select {
+				case <-done:
+				case <-time.After(5 * time.Second):
+					return errors.New("commands not executed in parallel")
+				}
+
+				if cmd.Id != "build-docs" {
+					return errors.New("exit code 1")
+				}
+				return nil
+			})
+	}
+
+	err = Build(context.Background(), devfileObj, "", handler)
+
+	var parallelErr ParallelCommandError
+	if !errors.As(err, &parallelErr) {
+		t.Fatalf("expected a ParallelCommandError, got %v", err)
+	}
+	if got := len(parallelErr.Errors()); got != 2 {
+		t.Errorf("expected 2 errors, got %d: %v", got, parallelErr)
+	}
+	wantMsg := `2 of the commands executed in parallel by "build-all" failed:
+ - Build-Frontend: exit code 1
+ - build-backend: exit code 1`
+	if !strings.Contains(err.Error(), wantMsg) {
+		t.Errorf("expected error to contain %q, got %q", wantMsg, err.Error())
+	}
+
+	for id, want := range map[string]string{
+		"build-frontend": "[Build-Frontend] ",
+		"build-backend":  "[build-backend] ",
+		"build-docs":     "[build-docs] ",
+	} {
+		if prefixes[id] != want {
+			t.Errorf("output prefix of %s = %q, want %q", id, prefixes[id], want)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package libdevfile
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
@@ -72,3 +75,76 @@ func GetImageComponentsToPushAutomatically(devfileObj parser.DevfileObj) ([]v1al
 	}
 	return result, nil
 }
+
+// GetImageComponentsUsedByContainers returns the list of Image components applied by the commands with the given ids
+// (or by their sub-commands, for composite commands) and whose image name is used as image by a Container component.
+// As the containers cannot be started before these images exist, they need to be built before the containers are created.
+func GetImageComponentsUsedByContainers(devfileObj parser.DevfileObj, commandIds ...string) ([]v1alpha2.Component, error) {
+	commandsMap, err := allCommandsMap(devfileObj)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]struct{})
+	visited := make(map[string]struct{})
+	var walk func(id string) error
+	walk = func(id string) error {
+		id = strings.ToLower(id)
+		if _, done := visited[id]; done {
+			return nil
+		}
+		visited[id] = struct{}{}
+		cmd, present := commandsMap[id]
+		if !present {
+			return fmt.Errorf("command %q not found in the Devfile", id)
+		}
+		switch {
+		case cmd.Apply != nil:
+			applied[cmd.Apply.Component] = struct{}{}
+		case cmd.Composite != nil:
+			for _, c := range cmd.Composite.Commands {
+				if err := walk(c); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, id := range commandIds {
+		if err = walk(id); err != nil {
+			return nil, err
+		}
+	}
+	if len(applied) == 0 {
+		return nil, nil
+	}
+
+	containerComponents, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	containerImages := make(map[string]struct{}, len(containerComponents))
+	for _, comp := range containerComponents {
+		containerImages[comp.Container.Image] = struct{}{}
+	}
+
+	imageComponents, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ImageComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []v1alpha2.Component
+	for _, comp := range imageComponents {
+		if _, ok := applied[comp.Name]; !ok {
+			continue
+		}
+		if _, ok := containerImages[comp.Image.ImageName]; !ok {
+			continue
+		}
+		result = append(result, comp)
+	}
+	return result, nil
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	devfiletesting "github.com/redhat-developer/odo/pkg/devfile/testing"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
 )
 
 func TestGetImageComponentsToPushAutomatically(t *testing.T) {
@@ -200,3 +201,98 @@ func TestGetImageComponentsToPushAutomatically(t *testing.T) {
 		})
 	}
 }
+
+func TestGetImageComponentsUsedByContainers(t *testing.T) {
+	imageComponent := func(name, imageName string) devfilev1.Component {
+		return generator.GetImageComponent(generator.ImageComponentParams{
+			Name:  name,
+			Image: devfilev1.Image{ImageName: imageName},
+		})
+	}
+	backendImage := imageComponent("backend-image", "backend:latest")
+	toolsImage := imageComponent("tools-image", "tools:latest")
+	deployImage := imageComponent("deploy-image", "prod:latest")
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{
+		backendImage,
+		toolsImage,
+		deployImage,
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "runtime",
+			Container: devfilev1.Container{Image: "runtime:latest"},
+		}),
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "backend",
+			Container: devfilev1.Container{Image: "backend:latest"},
+		}),
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "prod",
+			Container: devfilev1.Container{Image: "prod:latest"},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddCommands([]devfilev1.Command{
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "build-backend-image", Component: "backend-image"}),
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "build-tools-image", Component: "tools-image"}),
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "build-deploy-image", Component: "deploy-image"}),
+		generator.GetExecCommand(generator.ExecCommandParams{Id: "start", Component: "runtime", CommandLine: "./start"}),
+		generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:       "run",
+			Kind:     devfilev1.RunCommandGroupKind,
+			Commands: []string{"Build-Backend-Image", "build-tools-image", "start"},
+		}),
+		generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:       "deploy",
+			Kind:     devfilev1.DeployCommandGroupKind,
+			Commands: []string{"build-deploy-image"},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: devfileData}
+
+	tests := []struct {
+		name       string
+		commandIds []string
+		want       []devfilev1.Component
+		wantErr    bool
+	}{
+		{
+			name:       "image applied by a composite command and used by a container",
+			commandIds: []string{"run"},
+			want:       []devfilev1.Component{backendImage},
+		},
+		{
+			name:       "image applied by an apply command and used by a container",
+			commandIds: []string{"start", "build-deploy-image"},
+			want:       []devfilev1.Component{deployImage},
+		},
+		{
+			name:       "no apply command",
+			commandIds: []string{"start"},
+		},
+		{
+			name:       "unknown command",
+			commandIds: []string{"unknown"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetImageComponentsUsedByContainers(devfileObj, tt.commandIds...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetImageComponentsUsedByContainers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("GetImageComponentsUsedByContainers() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package libdevfile
+
+import "context"
+
+type outputPrefixKeyType struct{}
+
+var outputPrefixKey outputPrefixKeyType
+
+// withOutputPrefix returns a context indicating that the output of the commands executed with it
+// must be prefixed with the Id of the command, as they are executed in parallel with other commands
+func withOutputPrefix(ctx context.Context, commandId string) context.Context {
+	return context.WithValue(ctx, outputPrefixKey, "["+commandId+"] ")
+}
+
+// GetOutputPrefix returns the prefix to add to each line of output of a command executed as part of a parallel
+// composite command, so the interleaved outputs of the commands can be distinguished, or an empty string
+func GetOutputPrefix(ctx context.Context) string {
+	value := ctx.Value(outputPrefixKey)
+	if cast, ok := value.(string); ok {
+		return cast
+	}
+	return ""
+}
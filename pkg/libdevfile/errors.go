@@ -2,6 +2,7 @@ package libdevfile
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 )
@@ -28,6 +29,11 @@ func (e NoCommandFoundError) Error() string {
 	return fmt.Sprintf("no %s command with name %q found in Devfile", e.kind, e.name)
 }
 
+// ErrorCode returns the code identifying the error in telemetry
+func (e NoCommandFoundError) ErrorCode() string {
+	return "no-command-found"
+}
+
 // NoDefaultCommandFoundError is returned when several commands of the specified kind exist
 // but no one is the default one
 type NoDefaultCommandFoundError struct {
@@ -43,6 +49,11 @@ func (e NoDefaultCommandFoundError) Error() string {
 	return fmt.Sprintf("no default %s command found in devfile", e.kind)
 }
 
+// ErrorCode returns the code identifying the error in telemetry
+func (e NoDefaultCommandFoundError) ErrorCode() string {
+	return "no-default-command-found"
+}
+
 // MoreThanOneDefaultCommandFoundError is returned when several default commands of the specified kind exist
 type MoreThanOneDefaultCommandFoundError struct {
 	kind v1alpha2.CommandGroupKind
@@ -109,3 +120,32 @@ type NoCommandForGroup struct {
 func (n NoCommandForGroup) Error() string {
 	return fmt.Sprintf("the command group of kind \"%v\" is not found in the devfile", n.Group)
 }
+
+// ParallelCommandError is returned when some of the commands of a parallel composite command fail
+type ParallelCommandError struct {
+	command string
+	// failed are the Ids of the failed commands, in the order they are defined in the composite command
+	failed []string
+	errors map[string]error
+}
+
+func NewParallelCommandError(command string, failed []string, errors map[string]error) ParallelCommandError {
+	return ParallelCommandError{
+		command: command,
+		failed:  failed,
+		errors:  errors,
+	}
+}
+
+func (e ParallelCommandError) Error() string {
+	msgs := make([]string, 0, len(e.failed))
+	for _, id := range e.failed {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", id, e.errors[id]))
+	}
+	return fmt.Sprintf("%d of the commands executed in parallel by %q failed:\n - %s", len(e.failed), e.command, strings.Join(msgs, "\n - "))
+}
+
+// Errors returns the errors of the failed commands, indexed by command Id
+func (e ParallelCommandError) Errors() map[string]error {
+	return e.errors
+}
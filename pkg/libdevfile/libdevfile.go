@@ -20,6 +20,9 @@ import (
 
 const DebugEndpointNamePrefix = "debug"
 
+// Handler is called to apply the components and execute the commands of the Devfile.
+// The commands of a parallel composite command are executed concurrently with the same Handler,
+// so implementations must be safe for concurrent use.
 type Handler interface {
 	ApplyImage(image v1alpha2.Component) error
 	ApplyKubernetes(kubernetes v1alpha2.Component, kind v1alpha2.CommandGroupKind) error
@@ -41,6 +44,12 @@ func Build(ctx context.Context, devfileObj parser.DevfileObj, buildCmd string, h
 	return ExecuteCommandByNameAndKind(ctx, devfileObj, buildCmd, v1alpha2.BuildCommandGroupKind, handler, buildCmd == "")
 }
 
+// Test executes the test command testCmd, or the default test command if testCmd is empty.
+// Contrary to Build, an error is returned if no test command is found.
+func Test(ctx context.Context, devfileObj parser.DevfileObj, testCmd string, handler Handler) error {
+	return ExecuteCommandByNameAndKind(ctx, devfileObj, testCmd, v1alpha2.TestCommandGroupKind, handler, false)
+}
+
 // ExecuteCommandByNameAndKind executes the specified command cmdName of the given kind in the Devfile.
 // If cmdName is empty, it executes the default command for the given kind or returns an error if there is no default command.
 // If ignoreCommandNotFound is true, nothing is executed if the command is not found and no error is returned.
@@ -263,6 +272,10 @@ func HasDebugCommand(devfileData data.DevfileData) bool {
 	return hasCommand(devfileData, v1alpha2.DebugCommandGroupKind)
 }
 
+func HasTestCommand(devfileData data.DevfileData) bool {
+	return hasCommand(devfileData, v1alpha2.TestCommandGroupKind)
+}
+
 // execDevfileEvent receives a Devfile Event (PostStart, PreStop etc.) and loops through them
 // Each Devfile Command associated with the given event is retrieved, and executed in the container specified
 // in the command
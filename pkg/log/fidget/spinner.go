@@ -100,7 +100,7 @@ func (s *Spinner) SetSuffix(suffix string) {
 	s.suffix = suffix
 
 	// Make sure we go back to the original line...
-	fmt.Print("\r")
+	fmt.Fprint(s.writer, "\r")
 	s.mu.Unlock()
 }
 
@@ -0,0 +1,223 @@
+package log
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/klog"
+)
+
+// LogFileFlagName is the name of the global flag copying the output of odo to a log file
+const LogFileFlagName = "log-file"
+
+const (
+	// logFileMaxSize is the size from which the log file is rotated
+	logFileMaxSize = 10 * 1024 * 1024
+	// logFileMaxBackups is the number of rotated log files kept, named <file>.1 (the most recent) to <file>.N
+	logFileMaxBackups = 3
+)
+
+// ansiEscapes matches the escape sequences used to colorize the output, which are removed from the log file
+var ansiEscapes = regexp.MustCompile("\x1b\\[[0-9;?]*[a-zA-Z]")
+
+// logFile is the file to which the output of odo is copied, nil if disabled
+var (
+	logFile   *rotatingFile
+	logFileMu sync.Mutex
+)
+
+// SetLogFile copies all the output of odo, including the klog debug messages enabled with -v, to the file at path.
+// The colors are removed, each line is prefixed with a timestamp, and the file is rotated when it becomes too large.
+func SetLogFile(path string) error {
+	f, err := newRotatingFile(path, logFileMaxSize, logFileMaxBackups)
+	if err != nil {
+		return err
+	}
+	logFileMu.Lock()
+	logFile = f
+	logFileMu.Unlock()
+
+	// Every klog message is written to the INFO output, whatever its severity
+	klog.SetOutput(io.Discard)
+	klog.SetOutputBySeverity("INFO", f)
+	setKlogFlag("logtostderr", "false")
+	setKlogFlag("alsologtostderr", "true")
+	return nil
+}
+
+// CloseLogFile stops copying the output of odo to the log file
+func CloseLogFile() error {
+	logFileMu.Lock()
+	f := logFile
+	logFile = nil
+	logFileMu.Unlock()
+	if f == nil {
+		return nil
+	}
+	setKlogFlag("logtostderr", "true")
+	klog.Flush()
+	return f.Close()
+}
+
+// IsLogFileFlagSet returns true if the --log-file flag is set
+func IsLogFileFlagSet() bool {
+	flag := pflag.Lookup(LogFileFlagName)
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// setKlogFlag sets the value of a klog flag, if the klog flags are registered
+func setKlogFlag(name string, value string) {
+	if f := flag.CommandLine.Lookup(name); f != nil {
+		_ = f.Value.Set(value)
+	}
+}
+
+// withLogFile returns a writer copying to the log file what is written to w, if the log file is enabled
+func withLogFile(w io.Writer) io.Writer {
+	logFileMu.Lock()
+	defer logFileMu.Unlock()
+	if logFile == nil {
+		return w
+	}
+	return &teeWriter{out: w, file: logFile}
+}
+
+// unwrapLogFile returns the writer to which w writes, other than the log file
+func unwrapLogFile(w io.Writer) io.Writer {
+	if tee, ok := w.(*teeWriter); ok {
+		return tee.out
+	}
+	return w
+}
+
+// teeWriter writes to out and to the log file; errors writing to the log file are ignored
+type teeWriter struct {
+	out  io.Writer
+	file io.Writer
+}
+
+func (o *teeWriter) Write(p []byte) (int, error) {
+	_, _ = o.file.Write(p)
+	return o.out.Write(p)
+}
+
+// rotatingFile is a log file renamed with a numbered suffix when it reaches maxSize bytes
+type rotatingFile struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	// lineStart is true when the next byte written starts a new line, to be prefixed with a timestamp
+	lineStart bool
+	now       func() time.Time
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, err
+	}
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+		lineStart:  true,
+		now:        time.Now,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (o *rotatingFile) open() error {
+	file, err := os.OpenFile(o.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	o.file = file
+	o.size = info.Size()
+	return nil
+}
+
+// Write writes p to the file, without the color escape sequences and carriage returns,
+// and prefixes each line with a timestamp
+func (o *rotatingFile) Write(p []byte) (int, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	clean := ansiEscapes.ReplaceAll(p, nil)
+	clean = bytes.ReplaceAll(clean, []byte("\r"), nil)
+	var buf bytes.Buffer
+	for _, b := range clean {
+		if o.lineStart {
+			buf.WriteString(o.now().Format("2006-01-02T15:04:05.000Z07:00 "))
+			o.lineStart = false
+		}
+		buf.WriteByte(b)
+		if b == '\n' {
+			o.lineStart = true
+		}
+	}
+
+	if o.size > 0 && o.size+int64(buf.Len()) > o.maxSize {
+		if err := o.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := o.file.Write(buf.Bytes())
+	o.size += int64(n)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// rotate renames <path>.i to <path>.i+1, removing the oldest one, then <path> to <path>.1, and opens a new <path>
+func (o *rotatingFile) rotate() error {
+	if err := o.file.Close(); err != nil {
+		return err
+	}
+	o.file = nil
+	_ = os.Remove(fmt.Sprintf("%s.%d", o.path, o.maxBackups))
+	for i := o.maxBackups - 1; i >= 1; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", o.path, i), fmt.Sprintf("%s.%d", o.path, i+1))
+	}
+	if o.maxBackups > 0 {
+		if err := os.Rename(o.path, o.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(o.path); err != nil {
+		return err
+	}
+	return o.open()
+}
+
+func (o *rotatingFile) Close() error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.file == nil {
+		return nil
+	}
+	err := o.file.Close()
+	o.file = nil
+	return err
+}
@@ -0,0 +1,74 @@
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_rotatingFile_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "odo.log")
+	f, err := newRotatingFile(path, 100, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	f.now = func() time.Time {
+		return time.Date(2023, 4, 5, 6, 7, 8, 9000000, time.UTC)
+	}
+
+	_, err = f.Write([]byte("\x1b[32m ✓ \x1b[0m Waiting\r for pod\nsecond "))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.Write([]byte("line\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "2023-04-05T06:07:08.009Z  ✓  Waiting for pod\n2023-04-05T06:07:08.009Z second line\n"
+	if string(content) != want {
+		t.Errorf("unexpected content\ngot:  %q\nwant: %q", string(content), want)
+	}
+}
+
+func Test_rotatingFile_rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "odo.log")
+	f, err := newRotatingFile(path, 50, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	f.now = func() time.Time {
+		return time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+	}
+
+	// Each line is 30 bytes long with its timestamp, so every write after the first one triggers a rotation
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err = f.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for suffix, want := range map[string]string{
+		"":   "fourth\n",
+		".1": "third\n",
+		".2": "second\n",
+	} {
+		content, err := os.ReadFile(path + suffix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(content[len("2023-04-05T06:07:08.000Z "):]); got != want {
+			t.Errorf("unexpected content of %q: got %q, want %q", path+suffix, got, want)
+		}
+	}
+	if _, err = os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("%q should not exist, only 2 backups are kept", path+".3")
+	}
+}
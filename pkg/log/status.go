@@ -35,6 +35,7 @@ import (
 	"github.com/spf13/pflag"
 	"golang.org/x/term"
 
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/log/fidget"
 )
 
@@ -57,7 +58,8 @@ type Status struct {
 
 // NewStatus creates a new default Status
 func NewStatus(w io.Writer) *Status {
-	spin := fidget.NewSpinner(w)
+	// The spinner animation is not copied to the log file
+	spin := fidget.NewSpinner(unwrapLogFile(w))
 	s := &Status{
 		spinner: spin,
 		writer:  w,
@@ -70,6 +72,7 @@ func NewStatus(w io.Writer) *Status {
 // as Golang's built-in "IsTerminal" command only works on UNIX-based systems:
 // https://github.com/golang/crypto/blob/master/ssh/terminal/util.go#L5
 func IsTerminal(w io.Writer) bool {
+	w = unwrapLogFile(w)
 	if runtime.GOOS == "windows" {
 		return true
 	} else if v, ok := (w).(*os.File); ok {
@@ -127,6 +130,9 @@ func (s *Status) Start(status string, debug bool) {
 			newSuffix := fmt.Sprintf(suffixSpacing+"%s", s.status)
 			s.spinner.SetSuffix(truncateSuffixIfNeeded(newSuffix, s.writer, 0))
 			s.spinner.Start()
+			if tee, ok := s.writer.(*teeWriter); ok {
+				fmt.Fprintf(tee.file, prefixSpacing+getSpacingString()+suffixSpacing+"%s  ...\n", s.status)
+			}
 		}
 	}
 }
@@ -166,6 +172,7 @@ func truncateSuffixIfNeeded(suffix string, w io.Writer, padding int) string {
 }
 
 func getTerminalWidth(w io.Writer) *int {
+	w = unwrapLogFile(w)
 
 	if runtime.GOOS != "windows" {
 
@@ -307,10 +314,11 @@ func DisplayExperimentalWarning() {
 		yellow := color.New(color.FgYellow).SprintFunc()
 		h := "============================================================================"
 		fmt.Fprintln(GetStdout(), yellow(fmt.Sprintf(`%[1]s
-%s Experimental mode enabled. Use at your own risk.
-More details on https://odo.dev/docs/user-guides/advanced/experimental-mode
+%s %s
+%s
 %[1]s
-`, h, getWarningString())))
+`, h, getWarningString(), i18n.T("Experimental mode enabled. Use at your own risk."),
+			i18n.T("More details on https://odo.dev/docs/user-guides/advanced/experimental-mode"))))
 	}
 }
 
@@ -370,7 +378,7 @@ func Section(a ...interface{}) {
 func Deprecate(what, nextAction string) {
 	if !IsJSON() {
 		yellow := color.New(color.FgYellow).SprintFunc()
-		msg1 := fmt.Sprintf("%s%s%s%s%s", yellow(getWarningString()), suffixSpacing, yellow(fmt.Sprintf("%s Deprecated", what)), suffixSpacing, nextAction)
+		msg1 := fmt.Sprintf("%s%s%s%s%s", yellow(getWarningString()), suffixSpacing, yellow(fmt.Sprintf(i18n.T("%s Deprecated"), what)), suffixSpacing, nextAction)
 		fmt.Fprintf(GetStderr(), " %s\n", msg1)
 	}
 }
@@ -393,6 +401,16 @@ func Error(a ...interface{}) {
 	}
 }
 
+// Ferror will output in an appropriate "progress" manner in out writer
+//
+//	✗ <message>
+func Ferror(out io.Writer, a ...interface{}) {
+	if !IsJSON() {
+		red := color.New(color.FgRed).SprintFunc()
+		fmt.Fprintf(out, "%s%s%s%s", prefixSpacing, red(getErrString()), suffixSpacing, fmt.Sprintln(a...))
+	}
+}
+
 // Info will simply print out information on a new (bolded) line
 // this is intended as information *after* something has been deployed
 // **Line in bold**
@@ -529,9 +547,9 @@ func IsAppleSilicon() bool {
 // TODO: Test needs to be added once we get Windows testing available on TravisCI / CI platform.
 func GetStdout() io.Writer {
 	if runtime.GOOS == "windows" {
-		return colorable.NewColorableStdout()
+		return withLogFile(colorable.NewColorableStdout())
 	}
-	return os.Stdout
+	return withLogFile(os.Stdout)
 }
 
 // GetStderr gets the appropriate stderrfrom the OS. If it's Linux, it will use
@@ -539,9 +557,9 @@ func GetStdout() io.Writer {
 // TODO: Test needs to be added once we get Windows testing available on TravisCI / CI platform.
 func GetStderr() io.Writer {
 	if runtime.GOOS == "windows" {
-		return colorable.NewColorableStderr()
+		return withLogFile(colorable.NewColorableStderr())
 	}
-	return os.Stderr
+	return withLogFile(os.Stderr)
 }
 
 // getErrString returns a certain string based upon the OS.
@@ -85,6 +85,10 @@ func (c *NoOpMachineEventLoggingClient) KubernetesPodStatus(pods []KubernetesPod
 
 }
 
+// TestResult ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) TestResult(commandID string, componentName string, iteration int, errorVal error, timestamp string) {
+}
+
 // NewConsoleMachineEventLoggingClient creates a new instance of ConsoleMachineEventLoggingClient,
 // which will output events as JSON to the console.
 func NewConsoleMachineEventLoggingClient() *ConsoleMachineEventLoggingClient {
@@ -203,6 +207,25 @@ func (c *ConsoleMachineEventLoggingClient) KubernetesPodStatus(pods []Kubernetes
 	c.outputJSON(json)
 }
 
+// TestResult outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) TestResult(commandID string, componentName string, iteration int, errorVal error, timestamp string) {
+	errorStr := ""
+	if errorVal != nil {
+		errorStr = errorVal.Error()
+	}
+	json := MachineEventWrapper{
+		TestResult: &TestResult{
+			CommandID:        commandID,
+			ComponentName:    componentName,
+			Iteration:        iteration,
+			Success:          errorVal == nil,
+			Error:            errorStr,
+			AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
 func (c *ConsoleMachineEventLoggingClient) outputJSON(machineOutput MachineEventWrapper) {
 
 	if c.logFunc != nil {
@@ -244,6 +267,10 @@ func (w MachineEventWrapper) GetEntry() (MachineEventLogEntry, error) {
 		return w.URLReachable, nil
 	}
 
+	if w.TestResult != nil {
+		return w.TestResult, nil
+	}
+
 	return nil, errors.New("unexpected machine event log entry")
 }
 
@@ -275,6 +302,9 @@ func (c URLReachable) GetType() MachineEventLogEntryType { return TypeURLReachab
 // GetType returns the event type for this event.
 func (c KubernetesPodStatus) GetType() MachineEventLogEntryType { return TypeKubernetesPodStatus }
 
+// GetType returns the event type for this event.
+func (c TestResult) GetType() MachineEventLogEntryType { return TypeTestResult }
+
 // MachineEventLogEntryType indicates the machine-readable event type from an ODO operation
 type MachineEventLogEntryType int
 
@@ -293,6 +323,8 @@ const (
 	TypeURLReachable MachineEventLogEntryType = 6
 	// TypeKubernetesPodStatus is the entry type for that event.
 	TypeKubernetesPodStatus MachineEventLogEntryType = 7
+	// TypeTestResult is the entry type for that event.
+	TypeTestResult MachineEventLogEntryType = 8
 )
 
 // createWriterAndChannel is similar to the exec.CreateConsoleOutputWriterAndChannel(); see that function's comment for details.
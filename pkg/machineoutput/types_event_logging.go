@@ -22,6 +22,8 @@ type MachineEventLoggingClient interface {
 
 	KubernetesPodStatus(pods []KubernetesPodStatusEntry, timestamp string)
 
+	TestResult(commandID string, componentName string, iteration int, errorVal error, timestamp string)
+
 	// CreateContainerOutputWriter is used to capture output from container processes, and synchronously write it to the screen as LogText. See implementation comments for details.
 	CreateContainerOutputWriter() (*io.PipeWriter, chan interface{}, *io.PipeWriter, chan interface{})
 }
@@ -36,6 +38,7 @@ type MachineEventWrapper struct {
 	ContainerStatus                 *ContainerStatus                 `json:"containerStatus,omitempty"`
 	URLReachable                    *URLReachable                    `json:"urlReachable,omitempty"`
 	KubernetesPodStatus             *KubernetesPodStatus             `json:"kubernetesPodStatus,omitempty"`
+	TestResult                      *TestResult                      `json:"testResult,omitempty"`
 }
 
 // DevFileCommandExecutionBegin is the JSON event that is emitted when a dev file command begins execution.
@@ -110,6 +113,17 @@ type KubernetesPodStatusEntry struct {
 	// vast majority are useful.
 }
 
+// TestResult is the JSON event that is emitted when an execution of the test command completes.
+type TestResult struct {
+	CommandID     string `json:"commandId"`
+	ComponentName string `json:"componentName"`
+	// Iteration is the number of the execution of the test command since the start of the session, starting at 1
+	Iteration int    `json:"iteration"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+	AbstractLogEvent
+}
+
 // AbstractLogEvent is the base struct for all events; all events must at a minimum contain a timestamp.
 type AbstractLogEvent struct {
 	Timestamp string `json:"timestamp"`
@@ -123,6 +137,7 @@ var _ MachineEventLogEntry = &ReportError{}
 var _ MachineEventLogEntry = &ContainerStatus{}
 var _ MachineEventLogEntry = &URLReachable{}
 var _ MachineEventLogEntry = &KubernetesPodStatus{}
+var _ MachineEventLogEntry = &TestResult{}
 
 // MachineEventLogEntry contains the expected methods for every event that is emitted.
 // (This is mainly used for test purposes.)
@@ -2,6 +2,7 @@ package binding
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	odoutil "github.com/redhat-developer/odo/pkg/util"
 )
 
@@ -76,7 +78,15 @@ func (o *AddBindingOptions) Validate(ctx context.Context) (err error) {
 	return o.clientset.BindingClient.ValidateAddBinding(o.flags, withDevfile)
 }
 
-func (o *AddBindingOptions) Run(ctx context.Context) error {
+func (o *AddBindingOptions) Run(ctx context.Context) (err error) {
+	defer func() {
+		var noTerminalErr *prompt.NoTerminalError
+		if errors.As(err, &noTerminalErr) {
+			err = fmt.Errorf("%w\nrun the command in a terminal, with the --%s and --%s flags (and --%s if no Devfile is present), or with the --%s flag to read the answers from a file",
+				err, backend.FLAG_SERVICE, backend.FLAG_NAME, backend.FLAG_WORKLOAD, prompt.AnswersFileFlagName)
+		}
+	}()
+
 	// Update the raw Devfile only, so we do not break any relationship between parent-child for example
 	withDevfile := odoutil.CheckPathExists(location.DevfileLocation(odocontext.GetWorkingDirectory(ctx)))
 	var devfileObj *parser.DevfileObj
@@ -180,7 +190,7 @@ func (o *AddBindingOptions) Run(ctx context.Context) error {
 	}
 
 	if output != "" {
-		fmt.Println(output)
+		fmt.Fprintln(log.GetStdout(), output)
 	}
 
 	// Display the info after outputting to stdout
@@ -220,6 +230,7 @@ func NewCmdBinding(name, fullName string) *cobra.Command {
 		"Naming strategy to use for binding names. "+
 			"It can be set to pre-defined strategies: 'none', 'lowercase', or 'uppercase'. "+
 			"Otherwise, it is treated as a custom Go template, and it is handled accordingly.")
+	bindingCmd.Flags().String(prompt.AnswersFileFlagName, "", "Path to a YAML file containing the answers to the questions of the interactive mode, for use when the standard input is not a terminal")
 	clientset.Add(bindingCmd, clientset.BINDING, clientset.FILESYSTEM)
 
 	return bindingCmd
@@ -63,15 +63,26 @@ func (o *AlizerOptions) RunForJsonOutput(ctx context.Context) (out interface{},
 		return nil, err
 	}
 	result := alizer.NewDetectionResult(df, reg, appPorts, defaultVersion, name)
+	result.Languages, err = o.clientset.AlizerClient.DetectLanguages(workingDir)
+	if err != nil {
+		return nil, err
+	}
+	result.RecommendedDevfiles, err = o.clientset.AlizerClient.DetectDevfiles(ctx, workingDir, result.Languages)
+	if err != nil {
+		return nil, err
+	}
 	return []api.DetectionResult{*result}, nil
 }
 
 func NewCmdAlizer(name, fullName string) *cobra.Command {
 	o := NewAlizerOptions()
 	alizerCmd := &cobra.Command{
-		Use:         name,
-		Short:       "Detect devfile to use based on files present in current directory",
-		Long:        "Detect devfile to use based on files present in current directory",
+		Use:     name,
+		Aliases: []string{"alizer"},
+		Short:   "Detect devfile to use based on files present in current directory",
+		Long: `Detect devfile to use based on files present in current directory.
+The languages, frameworks and tools used by the project are detected, along with the ports of the application
+and the Devfiles of the registries matching the project, with a confidence score.`,
 		Args:        cobra.MaximumNArgs(0),
 		Annotations: map[string]string{},
 		RunE: func(cmd *cobra.Command, args []string) error {
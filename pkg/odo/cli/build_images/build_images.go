@@ -2,12 +2,16 @@ package build_images
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/devfile/image"
+	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -26,7 +30,9 @@ type BuildImagesOptions struct {
 	clientset *clientset.Clientset
 
 	// Flags
-	pushFlag bool
+	pushFlag        bool
+	tagStrategyFlag string
+	digestFileFlag  string
 }
 
 var _ genericclioptions.Runnable = (*BuildImagesOptions)(nil)
@@ -37,6 +43,9 @@ var buildImagesExample = templates.Examples(`
 
   # Build images and push them to their registries
   %[1]s --push
+
+  # Build images tagged with the SHA of the current Git commit, push them and write their digests to a file
+  %[1]s --push --tag-strategy git-sha --digest-file digests.json
 `)
 
 // NewBuildImagesOptions creates a new BuildImagesOptions instance
@@ -59,12 +68,60 @@ func (o *BuildImagesOptions) Validate(ctx context.Context) (err error) {
 	if devfileObj == nil {
 		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
 	}
+	if o.tagStrategyFlag != "" && !isValidTagStrategy(image.TagStrategy(o.tagStrategyFlag)) {
+		return fmt.Errorf("invalid value %q for --tag-strategy, must be one of: %s", o.tagStrategyFlag, strings.Join(getTagStrategies(), ", "))
+	}
+	if o.digestFileFlag != "" && !o.pushFlag {
+		return errors.New("--digest-file can only be used with --push")
+	}
 	return nil
 }
 
 // Run contains the logic for the odo command
 func (o *BuildImagesOptions) Run(ctx context.Context) (err error) {
-	return image.BuildPushImages(ctx, image.SelectBackend(ctx), o.clientset.FS, o.pushFlag)
+	pushed, err := image.BuildPushImages(ctx, image.SelectBackend(ctx), o.clientset.FS, o.pushFlag, image.TagStrategy(o.tagStrategyFlag))
+	if err != nil {
+		return err
+	}
+	if !o.pushFlag {
+		return nil
+	}
+
+	for _, img := range pushed {
+		if img.Digest != "" {
+			log.Successf("Image %s pushed with digest %s", img.Image, img.Digest)
+		}
+	}
+	if o.digestFileFlag == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(pushed, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = o.clientset.FS.WriteFile(o.digestFileFlag, append(content, '\n'), 0644)
+	if err != nil {
+		return fmt.Errorf("unable to write the digests to %q: %w", o.digestFileFlag, err)
+	}
+	log.Infof("Digests written to %s", o.digestFileFlag)
+	return nil
+}
+
+func isValidTagStrategy(strategy image.TagStrategy) bool {
+	for _, s := range image.TagStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+func getTagStrategies() []string {
+	result := make([]string, 0, len(image.TagStrategies))
+	for _, s := range image.TagStrategies {
+		result = append(result, string(s))
+	}
+	return result
 }
 
 // NewCmdBuildImages implements the odo command
@@ -85,6 +142,10 @@ func NewCmdBuildImages(name, fullName string) *cobra.Command {
 	buildImagesCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UseVariablesFlags(buildImagesCmd)
 	buildImagesCmd.Flags().BoolVar(&o.pushFlag, "push", false, "If true, build and push the images")
+	buildImagesCmd.Flags().StringVar(&o.tagStrategyFlag, "tag-strategy", "",
+		fmt.Sprintf("Strategy used to replace the tags of the images defined in the Devfile, one of: %s. The tags of the Devfile are used if this flag is not set.", strings.Join(getTagStrategies(), ", ")))
+	buildImagesCmd.Flags().StringVar(&o.digestFileFlag, "digest-file", "",
+		"File to which the names and digests of the pushed images are written, in JSON format. Can only be used with --push.")
 	clientset.Add(buildImagesCmd, clientset.FILESYSTEM)
 
 	return buildImagesCmd
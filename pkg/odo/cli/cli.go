@@ -9,6 +9,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/redhat-developer/odo/pkg/odo/cli/exec"
 	"github.com/redhat-developer/odo/pkg/odo/cli/logs"
 	"github.com/redhat-developer/odo/pkg/odo/cli/run"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -23,6 +24,8 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/deploy"
 	"github.com/redhat-developer/odo/pkg/odo/cli/describe"
 	"github.com/redhat-developer/odo/pkg/odo/cli/dev"
+	"github.com/redhat-developer/odo/pkg/odo/cli/devfile"
+	"github.com/redhat-developer/odo/pkg/odo/cli/export"
 	_init "github.com/redhat-developer/odo/pkg/odo/cli/init"
 	"github.com/redhat-developer/odo/pkg/odo/cli/list"
 	"github.com/redhat-developer/odo/pkg/odo/cli/login"
@@ -32,7 +35,11 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/registry"
 	"github.com/redhat-developer/odo/pkg/odo/cli/remove"
 	"github.com/redhat-developer/odo/pkg/odo/cli/set"
+	"github.com/redhat-developer/odo/pkg/odo/cli/status"
+	_switch "github.com/redhat-developer/odo/pkg/odo/cli/switch"
 	"github.com/redhat-developer/odo/pkg/odo/cli/telemetry"
+	"github.com/redhat-developer/odo/pkg/odo/cli/test"
+	"github.com/redhat-developer/odo/pkg/odo/cli/validate"
 	"github.com/redhat-developer/odo/pkg/odo/cli/version"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 
@@ -139,6 +146,7 @@ func odoRootCmd(ctx context.Context, name, fullName string) *cobra.Command {
 	commonflags.AddOutputFlag()
 	commonflags.AddPlatformFlag(ctx)
 	commonflags.AddVariablesFlags()
+	commonflags.AddKubeconfigFlags()
 
 	// Here we add the necessary "logging" flags.. However, we choose to hide some of these from the user
 	// as they are not necessarily needed and more for advanced debugging
@@ -154,6 +162,7 @@ func odoRootCmd(ctx context.Context, name, fullName string) *cobra.Command {
 	_ = pflag.CommandLine.MarkHidden("log_file_max_size")
 	_ = pflag.CommandLine.MarkHidden("skip_headers")
 	_ = pflag.CommandLine.MarkHidden("skip_log_headers")
+	pflag.CommandLine.Bool(log.LogFileFlagName, false, "Copy the output of odo, including the debug logs enabled with -v, to .odo/logs/odo.log in the component directory")
 
 	// Override the verbosity flag description
 	verbosity := pflag.Lookup("v")
@@ -197,6 +206,13 @@ func odoRootCmd(ctx context.Context, name, fullName string) *cobra.Command {
 		logs.NewCmdLogs(logs.RecommendedCommandName, util.GetFullName(fullName, logs.RecommendedCommandName)),
 		completion.NewCmdCompletion(completion.RecommendedCommandName, util.GetFullName(fullName, completion.RecommendedCommandName)),
 		run.NewCmdRun(run.RecommendedCommandName, util.GetFullName(fullName, run.RecommendedCommandName)),
+		exec.NewCmdExec(exec.RecommendedCommandName, util.GetFullName(fullName, exec.RecommendedCommandName)),
+		_switch.NewCmdSwitch(_switch.RecommendedCommandName, util.GetFullName(fullName, _switch.RecommendedCommandName)),
+		test.NewCmdTest(test.RecommendedCommandName, util.GetFullName(fullName, test.RecommendedCommandName)),
+		status.NewCmdStatus(ctx, status.RecommendedCommandName, util.GetFullName(fullName, status.RecommendedCommandName)),
+		devfile.NewCmdDevfile(devfile.RecommendedCommandName, util.GetFullName(fullName, devfile.RecommendedCommandName)),
+		validate.NewCmdValidate(validate.RecommendedCommandName, util.GetFullName(fullName, validate.RecommendedCommandName)),
+		export.NewCmdExport(export.RecommendedCommandName, util.GetFullName(fullName, export.RecommendedCommandName)),
 	)
 
 	// Add all subcommands to base commands
@@ -282,7 +298,7 @@ func ShowHelp(cmd *cobra.Command, args []string) error {
 		cmd.SilenceErrors = true
 
 		// Print out the default "help" usage
-		fmt.Println(rootDefaultHelp)
+		fmt.Fprintln(log.GetStdout(), rootDefaultHelp)
 		return nil
 	}
 
@@ -28,6 +28,7 @@ import (
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
@@ -180,7 +181,14 @@ func (o *ComponentOptions) deleteNamedComponent(ctx context.Context) error {
 	}
 	printDevfileComponents(o.name, o.namespace, clusterResources, podmanResources)
 
-	if o.forceFlag || ui.Proceed("Are you sure you want to delete these resources?") {
+	proceed := o.forceFlag
+	if !proceed {
+		proceed, err = ui.Proceed("Are you sure you want to delete these resources?")
+		if err != nil {
+			return err
+		}
+	}
+	if proceed {
 
 		if len(clusterResources) > 0 {
 			spinner := log.Spinnerf("Deleting resources from cluster")
@@ -239,7 +247,7 @@ func printRemainingResources(ctx context.Context, remainingResources []unstructu
 	namespace := odocontext.GetNamespace(ctx)
 	log.Printf("There are still resources left in the cluster that might be belonging to the deleted component.")
 	for _, resource := range remainingResources {
-		fmt.Printf("\t- %s: %s\n", resource.GetKind(), resource.GetName())
+		fmt.Fprintf(log.GetStdout(), "\t- %s: %s\n", resource.GetKind(), resource.GetName())
 	}
 	log.Infof("If you want to delete those, execute `odo delete component --name %s --namespace %s`\n", componentName, namespace)
 }
@@ -328,7 +336,14 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 	if o.runningIn != "" {
 		msg = fmt.Sprintf("Are you sure you want to delete %q and all its resources running in the %s mode?", componentName, o.runningIn)
 	}
-	if o.forceFlag || ui.Proceed(msg) {
+	proceed := o.forceFlag
+	if !proceed {
+		proceed, err = ui.Proceed(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if proceed {
 
 		if hasClusterResources {
 			spinner := log.Spinnerf("Deleting resources from cluster")
@@ -378,7 +393,7 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 			}
 			if len(remainingFiles) != 0 {
 				log.Printf("There are still files or directories that could not be deleted.")
-				fmt.Println(strings.Join(listOfFiles, "\n"))
+				fmt.Fprintln(log.GetStdout(), strings.Join(listOfFiles, "\n"))
 				log.Info("You need to manually delete those.")
 			}
 		}
@@ -488,7 +503,7 @@ func printFileCreatedByOdo(files []string, hasClusterResources bool) {
 	}
 	log.Info(m + "delete the following files and directories:")
 	for _, f := range files {
-		fmt.Println("\t- " + f)
+		fmt.Fprintln(log.GetStdout(), "\t- "+f)
 	}
 }
 
@@ -526,6 +541,7 @@ func NewCmdComponent(ctx context.Context, name, fullName string) *cobra.Command
 	componentCmd.Flags().BoolVarP(&o.withFilesFlag, "files", "", false, "Delete all files and directories generated by odo. Use with caution.")
 	componentCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Delete component without prompting")
 	componentCmd.Flags().BoolVarP(&o.waitFlag, "wait", "w", false, "Wait for deletion of all dependent resources")
+	_ = componentCmd.RegisterFlagCompletionFunc("name", completion.ComponentNames)
 	clientset.Add(componentCmd, clientset.DELETE_COMPONENT, clientset.KUBERNETES, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
 		clientset.Add(componentCmd, clientset.PODMAN_NULLABLE)
@@ -553,7 +553,7 @@ func TestComponentOptions_deleteDevfileComponent(t *testing.T) {
 			fields: fields{
 				forceFlag: false,
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
 			name: "deleting a component running in Dev should be aborted if forceFlag is not passed",
@@ -567,7 +567,7 @@ func TestComponentOptions_deleteDevfileComponent(t *testing.T) {
 				forceFlag: false,
 				runningIn: labels.ComponentDevMode,
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
 			name: "deleting a component running in Deploy should be aborted if forceFlag is not passed",
@@ -581,7 +581,7 @@ func TestComponentOptions_deleteDevfileComponent(t *testing.T) {
 				forceFlag: false,
 				runningIn: labels.ComponentDeployMode,
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
 			name: "nothing to delete",
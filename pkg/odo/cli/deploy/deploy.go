@@ -9,6 +9,8 @@ import (
 	"github.com/redhat-developer/odo/pkg/kclient"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/i18n"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
@@ -18,6 +20,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/preflight"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 	"github.com/redhat-developer/odo/pkg/version"
 
@@ -51,7 +54,7 @@ func (o *DeployOptions) SetClientset(clientset *clientset.Clientset) {
 }
 
 func (o *DeployOptions) PreInit() string {
-	return messages.DeployInitializeExistingComponent
+	return i18n.T(messages.DeployInitializeExistingComponent)
 }
 
 // Complete DeployOptions after they've been created
@@ -71,7 +74,10 @@ func (o *DeployOptions) Validate(ctx context.Context) error {
 	}
 	componentName := odocontext.GetComponentName(ctx)
 	err := dfutil.ValidateK8sResourceName("component name", componentName)
-	return err
+	if err != nil {
+		return err
+	}
+	return preflight.Report(o.clientset.PreflightClient.Run(ctx, odolabels.ComponentDeployMode))
 }
 
 // Run contains the logic for the odo command
@@ -116,7 +122,7 @@ func NewCmdDeploy(name, fullName string) *cobra.Command {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
-	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES, clientset.PREFLIGHT)
 
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(deployCmd, util.MainGroup)
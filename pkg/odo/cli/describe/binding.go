@@ -187,14 +187,14 @@ func printBindingsHumanReadableOutput(bindings []api.ServiceBinding) {
 	log.Info("ServiceBinding used by the current component:")
 	someStatusUnknown := false
 	for _, binding := range bindings {
-		fmt.Println()
+		log.Println()
 		statusUnknown := printSingleBindingHumanReadableOutput(binding)
 		if statusUnknown {
 			someStatusUnknown = true
 		}
 	}
 	if someStatusUnknown {
-		fmt.Println()
+		log.Println()
 		log.Info(`Binding information for one or more ServiceBinding is not available because they don't exist on the cluster yet.
 Start "odo dev" first to see binding information.`)
 	}
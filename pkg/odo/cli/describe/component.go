@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/generator"
@@ -13,9 +14,13 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/dev"
+	devcommon "github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/devfile/profile"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
@@ -26,6 +31,7 @@ import (
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
 	"github.com/redhat-developer/odo/pkg/podman"
 )
 
@@ -38,6 +44,12 @@ var describeExample = ktemplates.Examples(`
 
 # Describe a component deployed in the cluster
 %[1]s --name frontend --namespace myproject
+
+# Display the Devfile of the component in the current directory, as resolved by odo
+%[1]s --devfile
+
+# Display the resolved Devfile, with a variable overridden
+%[1]s --devfile --var VARIABLE_NAME=value
 `)
 
 type ComponentOptions struct {
@@ -47,6 +59,9 @@ type ComponentOptions struct {
 	// namespaceFlag on which to find the component to describe, optional, defaults to current namespaceFlag
 	namespaceFlag string
 
+	// devfileFlag displays the resolved Devfile instead of the description of the component
+	devfileFlag bool
+
 	// Clients
 	clientset *clientset.Clientset
 }
@@ -90,6 +105,14 @@ func (o *ComponentOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline
 }
 
 func (o *ComponentOptions) Validate(ctx context.Context) (err error) {
+	if o.devfileFlag {
+		if o.nameFlag != "" {
+			return errors.New("--devfile cannot be used with --name")
+		}
+		// The Devfile is resolved locally, no access to the platform is needed
+		return nil
+	}
+
 	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
 	case commonflags.PlatformCluster:
 		if o.clientset.KubernetesClient == nil {
@@ -106,9 +129,27 @@ func (o *ComponentOptions) Validate(ctx context.Context) (err error) {
 
 // Run contains the logic for the odo command
 func (o *ComponentOptions) Run(ctx context.Context) error {
+	if o.devfileFlag {
+		devfileObj, err := o.resolveDevfile(ctx)
+		if err != nil {
+			return err
+		}
+		content, err := yaml.Marshal(devfileObj.Data)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(log.GetStdout(), string(content))
+		return nil
+	}
+
 	result, devfileObj, err := o.run(ctx)
 	if err != nil {
-		if clierrors.AsWarning(err) {
+		var warnings clierrors.Warnings
+		if errors.As(err, &warnings) {
+			for _, w := range warnings {
+				log.Warning(w.Error())
+			}
+		} else if clierrors.AsWarning(err) {
 			log.Warning(err.Error())
 		} else {
 			return err
@@ -119,11 +160,50 @@ func (o *ComponentOptions) Run(ctx context.Context) error {
 
 // RunForJsonOutput contains the logic for the JSON Output
 func (o *ComponentOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	if o.devfileFlag {
+		devfileObj, err := o.resolveDevfile(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return devfileObj.Data, nil
+	}
+
 	result, _, err := o.run(ctx) // TODO(feloy) handle warning
 	if clierrors.AsWarning(err) {
 		err = nil
 	}
-	return result, err
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// resolveDevfile returns the Devfile as used by odo: with its parents flattened, the variables substituted
+// and the environment variables of the active profile added to the containers running the application
+func (o *ComponentOptions) resolveDevfile(ctx context.Context) (*parser.DevfileObj, error) {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+
+	active, err := profile.GetActive(o.clientset.FS, odocontext.GetWorkingDirectory(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the active profile: %w", err)
+	}
+	if active == "" {
+		return devfileObj, nil
+	}
+
+	options, err := devcommon.ApplyProfile(*devfileObj, dev.StartOptions{Profile: active})
+	if err != nil {
+		if errors.As(err, &profile.NotFoundError{}) {
+			log.Warningf("The active profile %q is not defined in the Devfile, it is ignored", active)
+			return devfileObj, nil
+		}
+		return nil, err
+	}
+	err = devcommon.AddEnvToRunContainers(*devfileObj, options)
+	if err != nil {
+		return nil, err
+	}
+	return devfileObj, nil
 }
 
 func (o *ComponentOptions) run(ctx context.Context) (result api.Component, devfileObj *parser.DevfileObj, err error) {
@@ -189,22 +269,29 @@ func (o *ComponentOptions) describeNamedComponent(ctx context.Context, name stri
 		}
 	}
 
+	devSession, err := component.GetDevSession(kubeClient, name, odocontext.GetApplication(ctx))
+	if err != nil {
+		// The user may not be allowed to get the Dev session information, this is only a warning
+		err = clierrors.NewWarning("failed to get Dev session", err)
+	}
+
 	cmp := api.Component{
 		DevfileData: &api.DevfileData{
 			Devfile: devfile.Data,
 		},
-		RunningIn: api.MergeRunningModes(runningOn),
-		RunningOn: runningOn,
-		ManagedBy: "odo",
-		Ingresses: ingresses,
-		Routes:    routes,
+		RunningIn:  api.MergeRunningModes(runningOn),
+		RunningOn:  runningOn,
+		ManagedBy:  "odo",
+		Ingresses:  ingresses,
+		Routes:     routes,
+		DevSession: devSession,
 	}
 	if !feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
 		// Display RunningOn field only if the feature is enabled
 		cmp.RunningOn = nil
 	}
 
-	return cmp, &devfile, nil
+	return cmp, &devfile, err
 }
 
 // describeDevfileComponent describes the component defined by the devfile in the current directory
@@ -286,16 +373,22 @@ func (o *ComponentOptions) describeDevfileComponent(ctx context.Context) (result
 		return api.Component{}, nil, err
 	}
 
+	// The warnings are not returned yet, the component is described with the information available
+	var warnings clierrors.Warnings
 	var ingresses []api.ConnectionData
 	var routes []api.ConnectionData
 	if kubeClient != nil {
 		ingresses, routes, err = component.ListRoutesAndIngresses(kubeClient, componentName, odocontext.GetApplication(ctx))
 		if err != nil {
-			err = clierrors.NewWarning("failed to get ingresses/routes", err)
-			// Do not return the error yet, as it is only a warning
+			warnings = append(warnings, clierrors.NewWarning("failed to get ingresses/routes", err))
 		}
 	}
 
+	devSession, err := component.GetDevSession(kubeClient, componentName, odocontext.GetApplication(ctx))
+	if err != nil {
+		warnings = append(warnings, clierrors.NewWarning("failed to get Dev session", err))
+	}
+
 	cmp := api.Component{
 		DevfilePath:       devfilePath,
 		DevfileData:       api.GetDevfileData(*devfileObj),
@@ -305,13 +398,14 @@ func (o *ComponentOptions) describeDevfileComponent(ctx context.Context) (result
 		ManagedBy:         "odo",
 		Ingresses:         ingresses,
 		Routes:            routes,
+		DevSession:        devSession,
 	}
 	if !isPlatformFeatureEnabled {
 		// Display RunningOn field only if the feature is enabled
 		cmp.RunningOn = nil
 	}
 	updateWithRemoteSourceLocation(&cmp)
-	return cmp, devfileObj, err
+	return cmp, devfileObj, warnings.ErrorOrNil()
 }
 
 func updateWithRemoteSourceLocation(cmp *api.Component) {
@@ -365,11 +459,11 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		log.Describef("Version: ", cmp.DevfileData.Devfile.GetMetadata().Version)
 		log.Describef("Description: ", cmp.DevfileData.Devfile.GetMetadata().Description)
 		log.Describef("Tags: ", strings.Join(cmp.DevfileData.Devfile.GetMetadata().Tags, ", "))
-		fmt.Println()
+		log.Println()
 	}
 
 	log.Describef("Running in: ", cmp.RunningIn.String())
-	fmt.Println()
+	log.Println()
 
 	withPlatformFeature := feature.IsEnabled(ctx, feature.GenericPlatformFlag)
 
@@ -378,7 +472,16 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		for p, r := range cmp.RunningOn {
 			log.Printf("%s: %s", p, r)
 		}
-		fmt.Println()
+		log.Println()
+	}
+
+	if cmp.DevSession != nil {
+		log.Info("Dev session:")
+		log.Printf("Started by %s on %s at %s", cmp.DevSession.Owner, cmp.DevSession.Machine, cmp.DevSession.StartedAt.Format(time.RFC1123))
+		for _, port := range cmp.DevSession.ForwardedPorts {
+			log.Printf("%s:%d -> %s:%d", port.LocalAddress, port.LocalPort, port.ContainerName, port.ContainerPort)
+		}
+		log.Println()
 	}
 
 	if len(cmp.DevForwardedPorts) > 0 {
@@ -403,7 +506,7 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 			}
 			log.Printf(details)
 		}
-		fmt.Println()
+		log.Println()
 	}
 
 	log.Info("Supported odo features:")
@@ -416,7 +519,7 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		log.Printf("Deploy: Unknown")
 		log.Printf("Debug: Unknown")
 	}
-	fmt.Println()
+	log.Println()
 
 	err := listComponentsNames("Container components:", devfileObj, v1alpha2.ContainerComponentType)
 	if err != nil {
@@ -438,14 +541,14 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		for _, ing := range cmp.Ingresses {
 			for _, rule := range ing.Rules {
 				for _, path := range rule.Paths {
-					log.Printf("%s: %s%s", ing.Name, rule.Host, path)
+					log.Printf("%s: %s", ing.Name, getConnectionURL(rule, path))
 				}
 			}
 			if len(ing.Rules) == 0 {
 				log.Printf(ing.Name)
 			}
 		}
-		fmt.Println()
+		log.Println()
 	}
 
 	if len(cmp.Routes) != 0 {
@@ -453,19 +556,38 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		for _, route := range cmp.Routes {
 			for _, rule := range route.Rules {
 				for _, path := range rule.Paths {
-					log.Printf("%s: %s%s", route.Name, rule.Host, path)
+					log.Printf("%s: %s", route.Name, getConnectionURL(rule, path))
 				}
 			}
 			if len(route.Rules) == 0 {
 				log.Printf(route.Name)
 			}
 		}
-		fmt.Println()
+		log.Println()
 	}
 
 	return nil
 }
 
+// getConnectionURL returns the URL of the path of an Ingress or Route rule, with information about its TLS configuration
+func getConnectionURL(rule api.Rules, path string) string {
+	if rule.Host == "" || rule.Host == "*" {
+		return rule.Host + path
+	}
+	if rule.TLS == nil {
+		return fmt.Sprintf("http://%s%s", rule.Host, path)
+	}
+	url := fmt.Sprintf("https://%s%s", rule.Host, path)
+	switch {
+	case rule.TLS.Termination != "":
+		return fmt.Sprintf("%s (TLS termination: %s)", url, rule.TLS.Termination)
+	case rule.TLS.SecretName != "":
+		return fmt.Sprintf("%s (TLS secret: %s)", url, rule.TLS.SecretName)
+	default:
+		return url
+	}
+}
+
 func listComponentsNames(title string, devfileObj *parser.DevfileObj, typ v1alpha2.ComponentType) error {
 	if devfileObj == nil {
 		log.Describef(title, " Unknown")
@@ -488,7 +610,7 @@ func listComponentsNames(title string, devfileObj *parser.DevfileObj, typ v1alph
 		}
 		log.Printf(printmsg)
 	}
-	fmt.Println()
+	log.Println()
 	return nil
 }
 
@@ -508,12 +630,15 @@ func NewCmdComponent(ctx context.Context, name, fullName string) *cobra.Command
 	}
 	componentCmd.Flags().StringVar(&o.nameFlag, "name", "", "Name of the component to describe, optional. By default, the component in the local devfile is described")
 	componentCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace in which to find the component to describe, optional. By default, the current namespace defined in kubeconfig is used")
+	componentCmd.Flags().BoolVar(&o.devfileFlag, "devfile", false, "Display the Devfile of the component in the current directory, with its parents flattened, variables substituted and the environment variables of the active profile added")
+	_ = componentCmd.RegisterFlagCompletionFunc("name", completion.ComponentNames)
 	clientset.Add(componentCmd, clientset.KUBERNETES_NULLABLE, clientset.STATE)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
 		clientset.Add(componentCmd, clientset.PODMAN_NULLABLE)
 	}
 	commonflags.UseOutputFlag(componentCmd)
 	commonflags.UsePlatformFlag(componentCmd)
+	commonflags.UseVariablesFlags(componentCmd)
 
 	return componentCmd
 }
@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 	netutils "k8s.io/utils/net"
@@ -20,11 +24,15 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/devfile/profile"
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
@@ -33,9 +41,13 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/preflight"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/redhat-developer/odo/pkg/vars"
 	"github.com/redhat-developer/odo/pkg/version"
 )
 
@@ -53,6 +65,9 @@ type DevOptions struct {
 	out            io.Writer
 	errOut         io.Writer
 	forwardedPorts []api.ForwardedPort
+	env            map[string]string
+	// profile is the profile used by the session, selected with --profile or with odo switch
+	profile string
 
 	// ctx is used to communicate with WatchAndPush to stop watching and start cleaning up
 	ctx context.Context
@@ -60,6 +75,9 @@ type DevOptions struct {
 	// cancel function ensures that any function/method listening on ctx.Done channel stops doing its work
 	cancel context.CancelFunc
 
+	// cleanupDone is closed when the cleanup of the session is done
+	cleanupDone chan struct{}
+
 	// Flags
 	noWatchFlag          bool
 	randomPortsFlag      bool
@@ -70,15 +88,33 @@ type DevOptions struct {
 	forwardLocalhostFlag bool
 	portForwardFlag      []string
 	addressFlag          string
+	serviceAccountFlag   string
+	pullSecretFlag       []string
+	exposeFlag           bool
+	exposeDomainFlag     string
+	envFlag              []string
+	envFileFlag          string
+	profileFlag          string
+	runTestsFlag         bool
+	testCommandFlag      string
+	idleTimeoutFlag      time.Duration
+	rollbackFlag         bool
+	normalizeEOLFlag     bool
+	textExtensionsFlag   []string
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
 var _ genericclioptions.SignalHandler = (*DevOptions)(nil)
 
+// cleanupTimeout is the maximum duration of the cleanup after a signal is received, after which odo exits
+// and records in the state file that the resources have not been cleaned up
+const cleanupTimeout = 30 * time.Second
+
 func NewDevOptions() *DevOptions {
 	return &DevOptions{
-		out:    log.GetStdout(),
-		errOut: log.GetStderr(),
+		out:         log.GetStdout(),
+		errOut:      log.GetStderr(),
+		cleanupDone: make(chan struct{}),
 	}
 }
 
@@ -94,6 +130,30 @@ var devExample = ktemplates.Examples(`
 
 	# Run your application on cluster in the Dev mode, using custom port-mapping for port-forwarding
 	%[1]s --port-forward 8080:3000 --port-forward 5000:runtime:5858
+
+	# Run your application on the cluster in the Dev mode, pulling the images from a private registry
+	%[1]s --service-account my-sa --image-pull-secret my-registry-secret
+
+	# Run your application on the cluster in the Dev mode, exposing its public endpoints with Ingresses using the specified domain
+	%[1]s --expose --expose-domain apps.example.com
+
+	# Run your application in the Dev mode, with additional environment variables defined for this session only
+	%[1]s --env FEATURE_FLAG=true --env-file .env.local
+
+	# Run your application in the Dev mode from Windows, converting the line endings of the JavaScript and shell files to LF
+	%[1]s --normalize-line-endings --text-extensions .js,.sh
+
+	# Run your application in the Dev mode, using the commands and environment variables of a profile defined in the Devfile
+	%[1]s --profile dev-debug
+
+	# Run your application in the Dev mode, executing the default test command after each successful push of the sources
+	%[1]s --run-tests
+
+	# Run your application on the cluster in the Dev mode, scaling it down after 30 minutes without file changes and connections on the forwarded ports
+	%[1]s --idle-timeout 30m
+
+	# Run your application on the cluster in the Dev mode, rolling it back to its last working state when an update makes its pod crash
+	%[1]s --rollback-on-failure
 `)
 
 func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
@@ -101,24 +161,56 @@ func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
 }
 
 func (o *DevOptions) PreInit() string {
-	return messages.DevInitializeExistingComponent
+	return i18n.T(messages.DevInitializeExistingComponent)
 }
 
 func (o *DevOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
 	// Define this first so that if user hits Ctrl+c very soon after running odo dev, odo doesn't panic
 	o.ctx, o.cancel = context.WithCancel(ctx)
 
+	// Get the writers again, as they copy the output to the log file only once it is enabled
+	o.out = log.GetStdout()
+	o.errOut = log.GetStderr()
+
 	return nil
 }
 
 func (o *DevOptions) Validate(ctx context.Context) error {
 	devfileObj := *odocontext.GetEffectiveDevfileObj(ctx)
-	if !o.debugFlag && !libdevfile.HasRunCommand(devfileObj.Data) {
+	debug, err := o.validateProfile(ctx, devfileObj)
+	if err != nil {
+		return err
+	}
+	if !debug && !libdevfile.HasRunCommand(devfileObj.Data) {
 		return clierrors.NewNoCommandInDevfileError("run")
 	}
-	if o.debugFlag && !libdevfile.HasDebugCommand(devfileObj.Data) {
+	if debug && !libdevfile.HasDebugCommand(devfileObj.Data) {
 		return clierrors.NewNoCommandInDevfileError("debug")
 	}
+	if o.testCommandFlag != "" && !o.runTestsFlag {
+		return errors.New("--test-command can only be used with --run-tests")
+	}
+	if o.runTestsFlag {
+		if !libdevfile.HasTestCommand(devfileObj.Data) {
+			return clierrors.NewNoCommandInDevfileError("test")
+		}
+		if _, err = libdevfile.ValidateAndGetCommand(devfileObj, o.testCommandFlag, v1alpha2.TestCommandGroupKind); err != nil {
+			return err
+		}
+	}
+
+	if len(o.textExtensionsFlag) != 0 && !o.normalizeEOLFlag {
+		return errors.New("--text-extensions can only be used with --normalize-line-endings")
+	}
+	for _, ext := range o.textExtensionsFlag {
+		if ext == "" || ext == "." || strings.ContainsAny(ext, `/\`) {
+			return fmt.Errorf("invalid value %q for --text-extensions: must be a file extension starting with a dot, or a file name", ext)
+		}
+	}
+
+	if o.idleTimeoutFlag < 0 {
+		return fmt.Errorf("invalid value %s for --idle-timeout: must be positive", o.idleTimeoutFlag)
+	}
 
 	platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	switch platform {
@@ -126,23 +218,77 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		if o.ignoreLocalhostFlag {
 			return errors.New("--ignore-localhost cannot be used when running in cluster mode")
 		}
-		if o.forwardLocalhostFlag {
-			return errors.New("--forward-localhost cannot be used when running in cluster mode")
+		if o.serviceAccountFlag != "" {
+			if errs := validation.IsDNS1123Subdomain(o.serviceAccountFlag); len(errs) > 0 {
+				return fmt.Errorf("invalid value %q for --service-account: %s", o.serviceAccountFlag, strings.Join(errs, "; "))
+			}
+		}
+		for _, name := range o.pullSecretFlag {
+			if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+				return fmt.Errorf("invalid value %q for --image-pull-secret: %s", name, strings.Join(errs, "; "))
+			}
+		}
+		if o.exposeDomainFlag != "" {
+			if !o.exposeFlag {
+				return errors.New("--expose-domain can only be used with --expose")
+			}
+			if errs := validation.IsDNS1123Subdomain(o.exposeDomainFlag); len(errs) > 0 {
+				return fmt.Errorf("invalid value %q for --expose-domain: %s", o.exposeDomainFlag, strings.Join(errs, "; "))
+			}
 		}
 		if o.clientset.KubernetesClient == nil {
 			return kclient.NewNoConnectionError()
 		}
+		if o.exposeFlag {
+			capabilities, err := o.clientset.KubernetesClient.GetClusterCapabilities()
+			if err != nil {
+				return err
+			}
+			gvk, err := capabilities.ExposureGVK()
+			if err != nil {
+				return fmt.Errorf("unable to use --expose: %w", err)
+			}
+			if gvk != kclient.RouteGVK && o.exposeDomainFlag == "" {
+				return errors.New("--expose-domain is required with --expose on clusters not supporting OpenShift Routes")
+			}
+		}
 		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
 	case commonflags.PlatformPodman:
 		if o.ignoreLocalhostFlag && o.forwardLocalhostFlag {
 			return errors.New("--ignore-localhost and --forward-localhost cannot be used together")
 		}
+		if o.serviceAccountFlag != "" {
+			return errors.New("--service-account cannot be used when running on podman")
+		}
+		if len(o.pullSecretFlag) != 0 {
+			return errors.New("--image-pull-secret cannot be used when running on podman")
+		}
+		if o.exposeFlag || o.exposeDomainFlag != "" {
+			return errors.New("--expose and --expose-domain cannot be used when running on podman")
+		}
+		if o.idleTimeoutFlag != 0 {
+			return errors.New("--idle-timeout cannot be used when running on podman")
+		}
+		if o.rollbackFlag {
+			return errors.New("--rollback-on-failure cannot be used when running on podman")
+		}
 		if o.clientset.PodmanClient == nil {
 			return podman.NewPodmanNotFoundError(nil)
 		}
 		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
 	}
 
+	env, err := vars.GetVariables(o.clientset.FS, o.envFileFlag, o.envFlag, os.LookupEnv)
+	if err != nil {
+		return fmt.Errorf("unable to read environment variables from --env and --env-file: %w", err)
+	}
+	for name := range env {
+		if errs := validation.IsEnvVarName(name); len(errs) > 0 {
+			return fmt.Errorf("invalid environment variable name %q: %s", name, strings.Join(errs, "; "))
+		}
+	}
+	o.env = env
+
 	if o.randomPortsFlag && o.portForwardFlag != nil {
 		return errors.New("--random-ports and --port-forward cannot be used together")
 	}
@@ -171,12 +317,49 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 				fmt.Fprintf(log.GetStderr(), "\t- %s\n", errStr)
 			}
 		}
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
+	if platform == commonflags.PlatformCluster {
+		return preflight.Report(o.clientset.PreflightClient.Run(ctx, odolabels.ComponentDevMode))
+	}
 	return nil
 }
 
+// validateProfile selects the profile used by the session: the one selected with --profile or, if no command is selected
+// with flags, the active profile of the component. It returns true if the debug command is executed.
+func (o *DevOptions) validateProfile(ctx context.Context, devfileObj parser.DevfileObj) (bool, error) {
+	commandFlagsSet := o.debugFlag || o.buildCommandFlag != "" || o.runCommandFlag != ""
+	if o.profileFlag != "" {
+		if commandFlagsSet {
+			return false, errors.New("--profile cannot be used with --build-command, --run-command or --debug")
+		}
+		o.profile = o.profileFlag
+	} else if !commandFlagsSet {
+		active, err := profile.GetActive(o.clientset.FS, odocontext.GetWorkingDirectory(ctx))
+		if err != nil {
+			return false, fmt.Errorf("unable to read the active profile: %w", err)
+		}
+		o.profile = active
+	}
+	if o.profile == "" {
+		return o.debugFlag, nil
+	}
+
+	p, err := profile.Get(devfileObj, o.profile)
+	if err != nil {
+		if errors.As(err, &profile.NotFoundError{}) && o.profileFlag == "" {
+			log.Warningf("The active profile %q is not defined in the Devfile, it is ignored", o.profile)
+			o.profile = ""
+			return o.debugFlag, nil
+		}
+		return false, err
+	}
+	return p.Debug, nil
+}
+
 func (o *DevOptions) Run(ctx context.Context) (err error) {
 	var (
 		devFileObj    = odocontext.GetEffectiveDevfileObj(ctx)
@@ -191,22 +374,30 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 	var deployingTo string
 	switch platform {
 	case commonflags.PlatformPodman:
-		dest = "Platform: podman"
+		dest = fmt.Sprintf(i18n.T("Platform: %s"), "podman")
 		deployingTo = "podman"
 	case commonflags.PlatformCluster:
-		dest = "Namespace: " + odocontext.GetNamespace(ctx)
-		deployingTo = "the cluster"
+		dest = fmt.Sprintf(i18n.T("Namespace: %s"), odocontext.GetNamespace(ctx))
+		deployingTo = i18n.T("the cluster")
 	default:
 		panic(fmt.Errorf("platform %s is not implemented", platform))
 	}
 
 	// Output what the command is doing / information
-	log.Title("Developing using the \""+componentName+"\" Devfile",
+	log.Title(fmt.Sprintf(i18n.T("Developing using the %q Devfile"), componentName),
 		dest,
-		"odo version: "+version.VERSION)
+		fmt.Sprintf(i18n.T("odo version: %s"), version.VERSION))
 	if platform == commonflags.PlatformCluster {
 		genericclioptions.WarnIfDefaultNamespace(odocontext.GetNamespace(ctx), o.clientset.KubernetesClient)
 	}
+	if o.profile != "" {
+		log.Infof(i18n.T("Using profile %q"), o.profile)
+		// The profile selected with --profile becomes the active profile, switched with odo switch
+		err = profile.SetActive(o.clientset.FS, path, o.profile)
+		if err != nil {
+			return fmt.Errorf("unable to save the active profile: %w", err)
+		}
+	}
 
 	// check for .gitignore file and add odo-file-index.json to .gitignore.
 	// In case the .gitignore was created by odo, it is purposely not reported as candidate for deletion (via a call to files.ReportLocalFileGeneratedByOdo)
@@ -235,7 +426,7 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 	scontext.SetProjectType(ctx, devFileObj.Data.GetMetadata().ProjectType)
 	scontext.SetDevfileName(ctx, componentName)
 
-	log.Sectionf("Running on %s in Dev mode", deployingTo)
+	log.Sectionf(i18n.T("Running on %s in Dev mode"), deployingTo)
 
 	err = o.clientset.StateClient.Init(ctx)
 	if err != nil {
@@ -243,6 +434,19 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		return err
 	}
 
+	err = o.reconcileDirtyShutdown(ctx)
+	if err != nil {
+		return err
+	}
+
+	var textExtensions []string
+	if o.normalizeEOLFlag {
+		textExtensions = o.textExtensionsFlag
+		if len(textExtensions) == 0 {
+			textExtensions = sync.DefaultTextExtensions
+		}
+	}
+
 	return o.clientset.DevClient.Start(
 		o.ctx,
 		dev.StartOptions{
@@ -250,33 +454,98 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 			Debug:                o.debugFlag,
 			BuildCommand:         o.buildCommandFlag,
 			RunCommand:           o.runCommandFlag,
+			Profile:              o.profile,
+			RunTests:             o.runTestsFlag,
+			TestCommand:          o.testCommandFlag,
 			RandomPorts:          o.randomPortsFlag,
 			WatchFiles:           !o.noWatchFlag,
 			IgnoreLocalhost:      o.ignoreLocalhostFlag,
 			ForwardLocalhost:     o.forwardLocalhostFlag,
 			Variables:            variables,
+			Env:                  o.env,
 			CustomForwardedPorts: o.forwardedPorts,
 			CustomAddress:        o.addressFlag,
+			ServiceAccount:       o.serviceAccountFlag,
+			ImagePullSecrets:     o.pullSecretFlag,
+			Expose:               o.exposeFlag,
+			ExposeDomain:         o.exposeDomainFlag,
+			IdleTimeout:          o.idleTimeoutFlag,
+			RollbackOnFailure:    o.rollbackFlag,
+			TextExtensions:       textExtensions,
 			Out:                  o.out,
 			ErrOut:               o.errOut,
 		},
 	)
 }
 
+// reconcileDirtyShutdown checks if a previous session ended without completing the cleanup of its resources,
+// and offers to delete the resources it left before deploying the component. Otherwise, the resources left
+// are updated by the current session.
+func (o *DevOptions) reconcileDirtyShutdown(ctx context.Context) error {
+	dirty, err := o.clientset.StateClient.PopDirtyShutdown(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read the state of the previous sessions: %w", err)
+	}
+	if dirty == nil {
+		return nil
+	}
+	log.Warningf(i18n.T("The previous session (PID %d) ended at %s without cleaning up its resources: %s"),
+		dirty.PID, dirty.Time.Format(time.RFC3339), dirty.Reason)
+
+	promptClient, err := prompt.NewClient("")
+	if err != nil {
+		return err
+	}
+	cleanup, err := promptClient.Confirm(prompt.Question{
+		Name:    "cleanupLeftResources",
+		Message: i18n.T("Do you want to delete the resources left by the previous session before starting?"),
+	}, false)
+	var noTerminalErr *prompt.NoTerminalError
+	if errors.As(err, &noTerminalErr) {
+		cleanup, err = false, nil
+	}
+	ui.HandleError(err)
+	if !cleanup {
+		log.Info(i18n.T("The resources left by the previous session will be updated by this session"))
+		return nil
+	}
+	return o.clientset.DevClient.CleanupLeftResources(ctx, o.out)
+}
+
 func (o *DevOptions) HandleSignal(ctx context.Context, cancelFunc context.CancelFunc) error {
 	cancelFunc()
 	// At this point, `ctx.Done()` will be raised, and the cleanup will be done
-	// wait for the cleanup to finish and let the main thread finish instead of signal handler go routine from runnable
-	select {}
+	// wait for the cleanup to finish and let the main thread finish instead of signal handler go routine from runnable.
+	// The cleanup can hang, for example if the platform is not reachable anymore: after cleanupTimeout,
+	// the session is recorded as not cleaned up and odo exits. The state client records only one final state,
+	// so a cleanup completing in the meantime does not reset the record, and conversely.
+	select {
+	case <-o.cleanupDone:
+		select {}
+	case <-time.After(cleanupTimeout):
+	}
+	reason := fmt.Errorf("the cleanup did not complete in %s", cleanupTimeout)
+	if o.clientset != nil && o.clientset.StateClient != nil {
+		if err := o.clientset.StateClient.RecordDirtyShutdown(ctx, reason); err != nil {
+			klog.V(4).Infof("unable to record the dirty shutdown in the state file: %v", err)
+		}
+	}
+	return reason
 }
 
 func (o *DevOptions) Cleanup(ctx context.Context, commandError error) {
+	defer close(o.cleanupDone)
 	if errors.As(commandError, &state.ErrAlreadyRunningOnPlatform{}) {
 		klog.V(4).Info("session already running, no need to cleanup")
 		return
 	}
 	if commandError != nil {
-		_ = o.clientset.DevClient.CleanupResources(ctx, log.GetStdout())
+		if err := o.clientset.DevClient.CleanupResources(ctx, log.GetStdout()); err != nil {
+			if err = o.clientset.StateClient.RecordDirtyShutdown(ctx, err); err != nil {
+				klog.V(4).Infof("unable to record the dirty shutdown in the state file: %v", err)
+			}
+			return
+		}
 	}
 	_ = o.clientset.StateClient.SaveExit(ctx)
 }
@@ -305,10 +574,36 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	devCmd.Flags().BoolVar(&o.ignoreLocalhostFlag, "ignore-localhost", false,
 		"Whether to ignore errors related to port-forwarding apps listening on the container loopback interface. Applicable only if platform is podman.")
 	devCmd.Flags().BoolVar(&o.forwardLocalhostFlag, "forward-localhost", false,
-		"Whether to enable port-forwarding if app is listening on the container loopback interface. Applicable only if platform is podman.")
+		"Whether to enable port-forwarding if app is listening on the container loopback interface. On a cluster, a side container relaying the traffic to the loopback interface is added to the pod.")
 	devCmd.Flags().StringArrayVar(&o.portForwardFlag, "port-forward", nil,
 		"Define custom port mapping for port forwarding. Acceptable formats: LOCAL_PORT:REMOTE_PORT, LOCAL_PORT:CONTAINER_NAME:REMOTE_PORT.")
 	devCmd.Flags().StringVar(&o.addressFlag, "address", "127.0.0.1", "Define custom address for port forwarding.")
+	devCmd.Flags().StringVar(&o.serviceAccountFlag, "service-account", "",
+		"Service account used by the pod of the component. The ServiceAccount preference is used if this flag is not set and the pod-overrides of the Devfile do not set one. Applicable only if platform is cluster.")
+	devCmd.Flags().StringArrayVar(&o.pullSecretFlag, "image-pull-secret", nil,
+		"Name of a secret used to pull the images of the component; can be repeated. The ImagePullSecrets preference is used if this flag is not set. Applicable only if platform is cluster.")
+	devCmd.Flags().BoolVar(&o.exposeFlag, "expose", false,
+		"Expose the public endpoints with OpenShift Routes, or Ingresses if Routes are not supported by the cluster. Applicable only if platform is cluster.")
+	devCmd.Flags().StringVar(&o.exposeDomainFlag, "expose-domain", "",
+		"Domain used to build the hosts of the Ingresses created with --expose; required if Routes are not supported by the cluster. Applicable only if platform is cluster.")
+	devCmd.Flags().StringArrayVar(&o.envFlag, "env", nil,
+		"Environment variable KEY=VALUE to add to the container running the application, for this session only; can be repeated. Overrides the variables defined in the Devfile and in --env-file.")
+	devCmd.Flags().StringVar(&o.envFileFlag, "env-file", "",
+		"File containing KEY=VALUE lines defining environment variables to add to the container running the application, for this session only.")
+	devCmd.Flags().StringVar(&o.profileFlag, "profile", "",
+		"Profile defined in the Devfile, selecting the build, run and debug commands and the environment variables. The active profile, selected with odo switch, is used if this flag is not set.")
+	devCmd.Flags().BoolVar(&o.runTestsFlag, "run-tests", false,
+		"Execute the test command after each successful push of the sources, and report whether the tests passed.")
+	devCmd.Flags().StringVar(&o.testCommandFlag, "test-command", "",
+		"Alternative test command to execute with --run-tests. The default one will be used if this flag is not set.")
+	devCmd.Flags().DurationVar(&o.idleTimeoutFlag, "idle-timeout", 0,
+		"Scale the component down to zero replicas after this duration without file changes and without connections on the forwarded ports, until the next change (e.g. 30m). Disabled if not set. Applicable only if platform is cluster.")
+	devCmd.Flags().BoolVar(&o.rollbackFlag, "rollback-on-failure", false,
+		"Roll the component back automatically to its last working state when its pod keeps crashing after an update. Applicable only if platform is cluster.")
+	devCmd.Flags().BoolVar(&o.normalizeEOLFlag, "normalize-line-endings", false,
+		"Convert the CRLF line endings of the text files to LF when syncing them to the containers, for projects edited on Windows.")
+	devCmd.Flags().StringSliceVar(&o.textExtensionsFlag, "text-extensions", nil,
+		"Extensions (e.g. .js) or names (e.g. Dockerfile) of the text files whose line endings are converted with --normalize-line-endings; can be repeated. Common source and configuration files are converted if this flag is not set.")
 	clientset.Add(devCmd,
 		clientset.BINDING,
 		clientset.DEV,
@@ -319,6 +614,7 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 		clientset.PODMAN_NULLABLE,
 		clientset.PORT_FORWARD,
 		clientset.PREFERENCE,
+		clientset.PREFLIGHT,
 		clientset.STATE,
 		clientset.SYNC,
 		clientset.WATCH,
@@ -0,0 +1,34 @@
+package devfile
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/cli/devfile/upgrade"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended devfile command name
+const RecommendedCommandName = "devfile"
+
+// NewCmdDevfile implements the devfile odo command
+func NewCmdDevfile(name, fullName string) *cobra.Command {
+	upgradeCmd := upgrade.NewCmdUpgrade(upgrade.RecommendedCommandName,
+		util.GetFullName(fullName, upgrade.RecommendedCommandName))
+	devfileCmd := &cobra.Command{
+		Use:   name + " [options]",
+		Short: "Manage the Devfile of the component",
+		Long:  "Manage the Devfile of the component",
+		Example: fmt.Sprintf("%s\n",
+			upgradeCmd.Example,
+		),
+	}
+
+	devfileCmd.AddCommand(upgradeCmd)
+
+	util.SetCommandGroup(devfileCmd, util.ManagementGroup)
+	devfileCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return devfileCmd
+}
@@ -0,0 +1,382 @@
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/spf13/cobra"
+	"k8s.io/klog"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	devfileupgrade "github.com/redhat-developer/odo/pkg/devfile/upgrade"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+)
+
+// RecommendedCommandName is the recommended upgrade command name
+const RecommendedCommandName = "upgrade"
+
+var upgradeLongDesc = ktemplates.LongDesc(`
+	Upgrade the Devfile of the component to a more recent version of the stack it has been created from.
+
+	The changes between the version of the stack the Devfile is based on and the target version
+	(by default, the latest version available in the registry) are displayed field by field, grouped by parent, components, images and commands.
+	With --apply, the changes are written to the Devfile: the elements customized in the Devfile are preserved.
+
+	The stack is determined from the project type and language of the Devfile, use --devfile to specify it explicitly.
+`)
+
+var upgradeExample = ktemplates.Examples(`
+	# Show the changes brought by the latest version of the stack
+	%[1]s
+
+	# Upgrade the Devfile to the latest version of the stack
+	%[1]s --apply
+
+	# Upgrade the Devfile to a specific version of a stack
+	%[1]s --devfile nodejs --devfile-registry DefaultDevfileRegistry --devfile-version 2.2.0 --apply
+`)
+
+// UpgradeOptions encapsulates the options for the odo devfile upgrade command
+type UpgradeOptions struct {
+	// Flags
+	devfileFlag         string
+	devfileRegistryFlag string
+	devfileVersionFlag  string
+	applyFlag           bool
+
+	// project is the raw Devfile of the component
+	project parser.DevfileObj
+	// effective is the Devfile of the component with its parent flattened
+	effective *parser.DevfileObj
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*UpgradeOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*UpgradeOptions)(nil)
+
+// NewUpgradeOptions returns a new instance of UpgradeOptions
+func NewUpgradeOptions() *UpgradeOptions {
+	return &UpgradeOptions{}
+}
+
+func (o *UpgradeOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete parses the raw Devfile of the component, as the upgrade must not write the resolved content (e.g. of the parent)
+func (o *UpgradeOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.effective = odocontext.GetEffectiveDevfileObj(ctx)
+	if o.effective == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.project, err = devfile.ParseAndValidateFromFile(odocontext.GetDevfilePath(ctx), "", false)
+	return err
+}
+
+func (o *UpgradeOptions) Validate(ctx context.Context) error {
+	if o.devfileRegistryFlag != "" && o.devfileFlag == "" {
+		return errors.New("--devfile-registry can only be used with --devfile")
+	}
+	if o.devfileVersionFlag != "" {
+		if _, err := semver.ParseTolerant(o.devfileVersionFlag); err != nil {
+			return fmt.Errorf("invalid --devfile-version %q: %w", o.devfileVersionFlag, err)
+		}
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile upgrade command
+func (o *UpgradeOptions) Run(ctx context.Context) error {
+	result, err := o.run(ctx)
+	if err != nil {
+		return err
+	}
+	printHumanReadableOutput(result)
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *UpgradeOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.run(ctx)
+}
+
+func (o *UpgradeOptions) run(ctx context.Context) (api.DevfileUpgrade, error) {
+	stack, err := o.getStack(ctx)
+	if err != nil {
+		return api.DevfileUpgrade{}, err
+	}
+
+	result := api.DevfileUpgrade{
+		Devfile:         stack.Name,
+		DevfileRegistry: stack.Registry.Name,
+		CurrentVersion:  o.project.Data.GetMetadata().Version,
+		TargetVersion:   o.devfileVersionFlag,
+	}
+	if result.TargetVersion == "" {
+		result.TargetVersion = latestVersion(stack)
+	}
+	if !hasVersion(stack, result.TargetVersion) {
+		return api.DevfileUpgrade{}, fmt.Errorf("version %q of Devfile %q not found in registry %q", result.TargetVersion, stack.Name, stack.Registry.Name)
+	}
+
+	tmpDir, err := o.clientset.FS.TempDir("", "odo-devfile-upgrade")
+	if err != nil {
+		return api.DevfileUpgrade{}, err
+	}
+	defer func() {
+		if rmErr := o.clientset.FS.RemoveAll(tmpDir); rmErr != nil {
+			klog.V(4).Infof("unable to remove temporary directory %s: %v", tmpDir, rmErr)
+		}
+	}()
+
+	target, err := o.downloadStack(ctx, stack, result.TargetVersion, tmpDir, "target")
+	if err != nil {
+		return api.DevfileUpgrade{}, err
+	}
+	var base *parser.DevfileObj
+	if hasVersion(stack, result.CurrentVersion) {
+		b, dlErr := o.downloadStack(ctx, stack, result.CurrentVersion, tmpDir, "base")
+		if dlErr != nil {
+			return api.DevfileUpgrade{}, dlErr
+		}
+		base = &b
+	} else {
+		klog.V(4).Infof("version %q of Devfile %q not found, all the differences with the target version are considered as customizations", result.CurrentVersion, stack.Name)
+	}
+
+	result.Changes, err = devfileupgrade.Upgrade(o.project, o.effective, base, target)
+	if err != nil {
+		return api.DevfileUpgrade{}, err
+	}
+
+	if o.applyFlag {
+		err = o.project.WriteYamlDevfile()
+		if err != nil {
+			return api.DevfileUpgrade{}, err
+		}
+		result.Applied = true
+	}
+	return result, nil
+}
+
+// getStack returns the stack of the registry the Devfile has been created from
+func (o *UpgradeOptions) getStack(ctx context.Context) (api.DevfileStack, error) {
+	stacks, err := o.clientset.RegistryClient.ListDevfileStacks(ctx, o.devfileRegistryFlag, o.devfileFlag, "", false, false)
+	if err != nil {
+		return api.DevfileStack{}, err
+	}
+
+	if o.devfileFlag != "" {
+		if len(stacks.Items) == 0 {
+			return api.DevfileStack{}, fmt.Errorf("no Devfile %q found in the registries", o.devfileFlag)
+		}
+		return highestPriority(stacks.Items), nil
+	}
+
+	metadata := o.project.Data.GetMetadata()
+	var candidates []api.DevfileStack
+	names := map[string]struct{}{}
+	for _, stack := range stacks.Items {
+		if metadata.ProjectType != "" && strings.EqualFold(stack.ProjectType, metadata.ProjectType) &&
+			strings.EqualFold(stack.Language, metadata.Language) {
+			candidates = append(candidates, stack)
+			names[stack.Name] = struct{}{}
+		}
+	}
+	switch len(names) {
+	case 0:
+		return api.DevfileStack{}, errors.New("unable to determine the stack the Devfile has been created from, please use the --devfile flag")
+	case 1:
+		return highestPriority(candidates), nil
+	default:
+		var list []string
+		for name := range names {
+			list = append(list, name)
+		}
+		sort.Strings(list)
+		return api.DevfileStack{}, fmt.Errorf("several stacks match the Devfile (%s), please use the --devfile flag", strings.Join(list, ", "))
+	}
+}
+
+// downloadStack downloads the version of the stack in a sub-directory of tmpDir, and returns the raw Devfile
+func (o *UpgradeOptions) downloadStack(ctx context.Context, stack api.DevfileStack, version string, tmpDir string, subDir string) (parser.DevfileObj, error) {
+	dir := filepath.Join(tmpDir, subDir)
+	err := o.clientset.FS.MkdirAll(dir, 0750)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+	devfilePath, err := o.clientset.InitClient.DownloadDevfile(ctx, &api.DetectionResult{
+		Devfile:         stack.Name,
+		DevfileRegistry: stack.Registry.Name,
+		DevfileVersion:  version,
+	}, dir)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+	return devfile.ParseAndValidateFromFile(devfilePath, "", false)
+}
+
+// highestPriority returns the stack of the registry with the highest priority
+func highestPriority(stacks []api.DevfileStack) api.DevfileStack {
+	res := stacks[0]
+	for _, stack := range stacks[1:] {
+		if stack.Registry.Priority > res.Registry.Priority {
+			res = stack
+		}
+	}
+	return res
+}
+
+// latestVersion returns the most recent version of the stack
+func latestVersion(stack api.DevfileStack) string {
+	latest := stack.DefaultVersion
+	latestSemver, _ := semver.ParseTolerant(latest)
+	for _, v := range stack.Versions {
+		parsed, err := semver.ParseTolerant(v.Version)
+		if err != nil {
+			continue
+		}
+		if parsed.GT(latestSemver) {
+			latest, latestSemver = v.Version, parsed
+		}
+	}
+	return latest
+}
+
+func hasVersion(stack api.DevfileStack, version string) bool {
+	if version == "" {
+		return false
+	}
+	if len(stack.Versions) == 0 {
+		return version == stack.DefaultVersion
+	}
+	for _, v := range stack.Versions {
+		if v.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func printHumanReadableOutput(result api.DevfileUpgrade) {
+	currentVersion := result.CurrentVersion
+	if currentVersion == "" {
+		currentVersion = "unknown version"
+	}
+	log.Infof("Devfile %q from registry %q: %s -> %s", result.Devfile, result.DevfileRegistry, currentVersion, result.TargetVersion)
+	if len(result.Changes) == 0 {
+		log.Info("No changes, the Devfile is up to date")
+	}
+
+	symbols := map[api.DevfileChangeType]string{
+		api.DevfileChangeAdded:    "+",
+		api.DevfileChangeRemoved:  "-",
+		api.DevfileChangeModified: "~",
+	}
+	sections := []struct {
+		kind  api.DevfileElementKind
+		title string
+	}{
+		{api.DevfileElementParent, "Parent"},
+		{api.DevfileElementComponent, "Components"},
+		{api.DevfileElementImage, "Images"},
+		{api.DevfileElementCommand, "Commands"},
+	}
+	for _, section := range sections {
+		var lines []string
+		for _, change := range result.Changes {
+			if change.Kind != section.kind {
+				continue
+			}
+			line := fmt.Sprintf("  %s %s (%s)", symbols[change.Change], change.Name, change.Change)
+			if change.Preserved {
+				line += ", customized in the Devfile: kept as is"
+			}
+			if change.Inherited {
+				line += ", already inherited from the parent: not added"
+			}
+			lines = append(lines, line)
+			for _, field := range change.Fields {
+				lines = append(lines, "      "+formatFieldChange(field))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		log.Println()
+		log.Sectionf("%s changes", section.title)
+		for _, line := range lines {
+			fmt.Fprintln(log.GetStdout(), line)
+		}
+	}
+	log.Println()
+
+	if result.Applied {
+		log.Successf("Devfile upgraded to version %s", result.TargetVersion)
+	} else if len(result.Changes) != 0 {
+		log.Info("Run the command with --apply to write the changes to the Devfile")
+	}
+}
+
+// formatFieldChange returns a line describing the change of a field, with its values in JSON format
+func formatFieldChange(field api.DevfileFieldChange) string {
+	format := func(value interface{}) string {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(b)
+	}
+	switch {
+	case field.Old == nil:
+		return fmt.Sprintf("%s: added %s", field.Path, format(field.New))
+	case field.New == nil:
+		return fmt.Sprintf("%s: removed %s", field.Path, format(field.Old))
+	default:
+		return fmt.Sprintf("%s: %s -> %s", field.Path, format(field.Old), format(field.New))
+	}
+}
+
+// NewCmdUpgrade implements the odo devfile upgrade command
+func NewCmdUpgrade(name, fullName string) *cobra.Command {
+	o := NewUpgradeOptions()
+	upgradeCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Upgrade the Devfile to a more recent version of its stack",
+		Long:    upgradeLongDesc,
+		Example: fmt.Sprintf(upgradeExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	upgradeCmd.Flags().StringVar(&o.devfileFlag, "devfile", "", "Name of the stack in the registry the Devfile has been created from")
+	upgradeCmd.Flags().StringVar(&o.devfileRegistryFlag, completion.RegistryFlagName, "", "Name of the registry containing the stack")
+	upgradeCmd.Flags().StringVar(&o.devfileVersionFlag, "devfile-version", "", "Version of the stack to upgrade to (defaults to the latest version)")
+	upgradeCmd.Flags().BoolVar(&o.applyFlag, "apply", false, "Write the changes to the Devfile")
+	_ = upgradeCmd.RegisterFlagCompletionFunc("devfile", completion.DevfileNames)
+	_ = upgradeCmd.RegisterFlagCompletionFunc(completion.RegistryFlagName, completion.RegistryNames)
+
+	clientset.Add(upgradeCmd, clientset.FILESYSTEM, clientset.INIT, clientset.REGISTRY)
+	commonflags.UseOutputFlag(upgradeCmd)
+	upgradeCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return upgradeCmd
+}
@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 type NoCommandInDevfileError struct {
@@ -49,11 +50,37 @@ func (o Warning) Error() string {
 	return fmt.Errorf("%s: %w", o.msg, o.err).Error()
 }
 
+func (o Warning) Unwrap() error {
+	return o.err
+}
+
+// Warnings is an error aggregating several warnings
+type Warnings []Warning
+
+func (o Warnings) Error() string {
+	msgs := make([]string, 0, len(o))
+	for _, w := range o {
+		msgs = append(msgs, w.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ErrorOrNil returns nil if there is no warning, or the warnings as an error otherwise
+func (o Warnings) ErrorOrNil() error {
+	if len(o) == 0 {
+		return nil
+	}
+	return o
+}
+
 func IsWarning(err error) bool {
-	_, ok := err.(Warning)
-	return ok
+	switch err.(type) {
+	case Warning, Warnings:
+		return true
+	}
+	return false
 }
 
 func AsWarning(err error) bool {
-	return errors.As(err, &Warning{})
+	return errors.As(err, &Warning{}) || errors.As(err, &Warnings{})
 }
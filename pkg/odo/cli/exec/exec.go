@@ -0,0 +1,202 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	RecommendedCommandName = "exec"
+)
+
+type ExecOptions struct {
+	// Clients
+	clientset      *clientset.Clientset
+	platformClient platform.Client
+
+	// Variables
+	out    io.Writer
+	errOut io.Writer
+
+	// Args
+	command []string
+
+	// Flags
+	containerFlag string
+	stdinFlag     bool
+	ttyFlag       bool
+}
+
+var _ genericclioptions.Runnable = (*ExecOptions)(nil)
+
+func NewExecOptions() *ExecOptions {
+	return &ExecOptions{}
+}
+
+var execExample = ktemplates.Examples(`
+	# Run "ls -la" in the container of the component started by odo dev
+	%[1]s -- ls -la
+
+	# Run "ls" in the container "runtime" of the component started by odo dev
+	%[1]s --container runtime -- ls
+
+	# Open an interactive shell in the container of the component started by odo dev
+	%[1]s -it -- /bin/sh
+`)
+
+func (o *ExecOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *ExecOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	// Get the writers now, as they copy the output to the log file only once it is enabled
+	o.out = log.GetStdout()
+	o.errOut = log.GetStderr()
+	o.command = args
+	return nil
+}
+
+func (o *ExecOptions) Validate(ctx context.Context) error {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		platform   = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	if o.ttyFlag && !o.stdinFlag {
+		return fmt.Errorf("--tty can only be used with --stdin")
+	}
+
+	switch platform {
+
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+		o.platformClient = o.clientset.KubernetesClient
+
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+		o.platformClient = o.clientset.PodmanClient
+	}
+	return nil
+}
+
+func (o *ExecOptions) Run(ctx context.Context) error {
+	componentName := odocontext.GetComponentName(ctx)
+
+	pod, err := o.platformClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return fmt.Errorf("unable to get pod for component %s: %w. Please check the command 'odo dev' is running", componentName, err)
+	}
+
+	containerName, err := o.getContainerName(component.GetContainersNames(pod))
+	if err != nil {
+		return err
+	}
+
+	options := exec.ExecOptions{
+		Stdout: o.out,
+		Stderr: o.errOut,
+	}
+	if o.stdinFlag {
+		options.Stdin = os.Stdin
+	}
+
+	if o.ttyFlag {
+		stdinFd := int(os.Stdin.Fd())
+		if !term.IsTerminal(stdinFd) {
+			log.Warning(i18n.T("Unable to use a TTY, as the standard input is not a terminal"))
+		} else {
+			options.TTY = true
+			oldState, err := term.MakeRaw(stdinFd)
+			if err != nil {
+				return fmt.Errorf("unable to set the terminal in raw mode: %w", err)
+			}
+			defer func() {
+				if err := term.Restore(stdinFd, oldState); err != nil {
+					klog.V(4).Infof("unable to restore the terminal: %v", err)
+				}
+			}()
+		}
+	}
+
+	return o.clientset.ExecClient.Exec(ctx, o.command, pod.Name, containerName, options)
+}
+
+// getContainerName returns the container passed with --container,
+// or the only container of the component if the flag is not set
+func (o *ExecOptions) getContainerName(containers []string) (string, error) {
+	if o.containerFlag != "" {
+		for _, container := range containers {
+			if container == o.containerFlag {
+				return container, nil
+			}
+		}
+		return "", fmt.Errorf("container %q not found in the component, available containers: %s", o.containerFlag, strings.Join(containers, ", "))
+	}
+	if len(containers) != 1 {
+		return "", fmt.Errorf("the component has several containers, please select one with --container: %s", strings.Join(containers, ", "))
+	}
+	return containers[0], nil
+}
+
+func NewCmdExec(name, fullName string) *cobra.Command {
+	o := NewExecOptions()
+	execCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Execute a command in a container of the component in the Dev mode",
+		Long:    `odo exec executes a command in a container of the component started by "odo dev" ("odo dev" needs to be running), and exits with the exit code of the command`,
+		Example: fmt.Sprintf(execExample, fullName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	execCmd.Flags().StringVarP(&o.containerFlag, "container", "c", "", "Container in which to execute the command. Required if the component has several containers")
+	execCmd.Flags().BoolVarP(&o.stdinFlag, "stdin", "i", false, "Pass the standard input to the command")
+	execCmd.Flags().BoolVarP(&o.ttyFlag, "tty", "t", false, "Allocate a terminal for the command. Requires --stdin")
+	clientset.Add(execCmd,
+		clientset.FILESYSTEM,
+		clientset.KUBERNETES_NULLABLE,
+		clientset.PODMAN_NULLABLE,
+		clientset.EXEC,
+	)
+
+	odoutil.SetCommandGroup(execCmd, odoutil.MainGroup)
+	execCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(execCmd)
+	return execCmd
+}
@@ -0,0 +1,141 @@
+package devcontainer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/export"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended devcontainer command name
+const RecommendedCommandName = "devcontainer"
+
+var devcontainerLongDesc = ktemplates.LongDesc(`
+	Generate a dev container configuration (devcontainer.json) from the Devfile.
+
+	The container component of the default run command is translated, with its image, environment variables,
+	endpoints (as forwarded ports) and volumes; the exec commands of the postStart events running in this container
+	are translated into the postStartCommand of the dev container.
+	A dev container runs a single container: the other container components are ignored.
+
+	With --dockerfile, a Dockerfile based on the image of the container is also generated, and used to build the dev container.
+`)
+
+var devcontainerExample = ktemplates.Examples(`
+	# Generate the .devcontainer/devcontainer.json file
+	%[1]s
+
+	# Generate a devcontainer.json file and a Dockerfile, overwriting the existing files
+	%[1]s --dockerfile --force
+`)
+
+// DevcontainerOptions encapsulates the options for the odo export devcontainer command
+type DevcontainerOptions struct {
+	// Flags
+	outputDirFlag  string
+	dockerfileFlag bool
+	forceFlag      bool
+
+	// outputDir is the absolute path of the output directory
+	outputDir string
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*DevcontainerOptions)(nil)
+
+// NewDevcontainerOptions returns a new instance of DevcontainerOptions
+func NewDevcontainerOptions() *DevcontainerOptions {
+	return &DevcontainerOptions{}
+}
+
+func (o *DevcontainerOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *DevcontainerOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+	if odocontext.GetEffectiveDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(workingDir)
+	}
+	o.outputDir = o.outputDirFlag
+	if !filepath.IsAbs(o.outputDir) {
+		o.outputDir = filepath.Join(workingDir, o.outputDir)
+	}
+	return nil
+}
+
+func (o *DevcontainerOptions) Validate(ctx context.Context) error {
+	if o.outputDirFlag == "" {
+		return errors.New("--output-dir must not be empty")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo export devcontainer command
+func (o *DevcontainerOptions) Run(ctx context.Context) error {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		workingDir = odocontext.GetWorkingDirectory(ctx)
+	)
+
+	devcontainer, warnings, err := export.NewDevcontainer(*devfileObj)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		log.Warningf("%s", warning)
+	}
+
+	var dockerfile []byte
+	if o.dockerfileFlag {
+		dockerfile = devcontainer.UseDockerfile()
+	}
+	paths, err := export.WriteDevcontainer(o.clientset.FS, devcontainer, dockerfile, o.outputDir, o.forceFlag)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if rel, relErr := filepath.Rel(workingDir, path); relErr == nil {
+			path = rel
+		}
+		log.Printf("%s", path)
+	}
+	log.Successf("Dev container configuration generated in %s", o.outputDirFlag)
+	return nil
+}
+
+// NewCmdDevcontainer implements the odo export devcontainer command
+func NewCmdDevcontainer(name, fullName string) *cobra.Command {
+	o := NewDevcontainerOptions()
+	devcontainerCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Generate a dev container configuration from the Devfile",
+		Long:    devcontainerLongDesc,
+		Example: fmt.Sprintf(devcontainerExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	devcontainerCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", ".devcontainer", "Directory in which the files are written")
+	devcontainerCmd.Flags().BoolVar(&o.dockerfileFlag, "dockerfile", false, "Generate a Dockerfile to build the dev container")
+	devcontainerCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the existing files")
+
+	clientset.Add(devcontainerCmd, clientset.FILESYSTEM)
+	devcontainerCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return devcontainerCmd
+}
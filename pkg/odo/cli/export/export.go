@@ -0,0 +1,38 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/cli/export/devcontainer"
+	"github.com/redhat-developer/odo/pkg/odo/cli/export/k8s"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended export command name
+const RecommendedCommandName = "export"
+
+// NewCmdExport implements the export odo command
+func NewCmdExport(name, fullName string) *cobra.Command {
+	devcontainerCmd := devcontainer.NewCmdDevcontainer(devcontainer.RecommendedCommandName,
+		util.GetFullName(fullName, devcontainer.RecommendedCommandName))
+	k8sCmd := k8s.NewCmdK8s(k8s.RecommendedCommandName,
+		util.GetFullName(fullName, k8s.RecommendedCommandName))
+	exportCmd := &cobra.Command{
+		Use:   name + " [options]",
+		Short: "Export the component to be used without odo",
+		Long:  "Export the resources of the component to be used without odo",
+		Example: fmt.Sprintf("%s\n%s\n",
+			k8sCmd.Example,
+			devcontainerCmd.Example,
+		),
+	}
+
+	exportCmd.AddCommand(k8sCmd, devcontainerCmd)
+
+	util.SetCommandGroup(exportCmd, util.ManagementGroup)
+	exportCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return exportCmd
+}
@@ -0,0 +1,188 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/export"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended k8s command name
+const RecommendedCommandName = "k8s"
+
+// modes are the values of the --mode flag, and the corresponding modes of the resources
+var modes = map[string]string{
+	"dev":    odolabels.ComponentDevMode,
+	"deploy": odolabels.ComponentDeployMode,
+}
+
+var k8sLongDesc = ktemplates.LongDesc(`
+	Generate the Kubernetes manifests of the component from the Devfile.
+
+	In the deploy mode (the default), the manifests are the ones applied by the default deploy command of the Devfile.
+	In the dev mode, the manifests are the Deployment, Service and PersistentVolumeClaims created by odo dev,
+	and the Kubernetes components not referenced by any command.
+
+	The manifests are written into the directory specified with --output-dir, one file per resource,
+	or as the templates of a Helm chart skeleton with --helm.
+	The resources are generated without namespace, and no image is built.
+	In the dev mode, the Deployment is built as by odo dev: if a cluster is accessible, it is adapted to the Pod Security level,
+	LimitRanges and ResourceQuotas of the current namespace.
+`)
+
+var k8sExample = ktemplates.Examples(`
+	# Generate the manifests applied by odo deploy into the k8s directory
+	%[1]s
+
+	# Generate the manifests created by odo dev into the dev directory
+	%[1]s --mode dev --output-dir dev
+
+	# Generate a Helm chart skeleton, overwriting the existing files
+	%[1]s --helm --output-dir chart --force
+`)
+
+// K8sOptions encapsulates the options for the odo export k8s command
+type K8sOptions struct {
+	// Flags
+	modeFlag      string
+	outputDirFlag string
+	helmFlag      bool
+	forceFlag     bool
+
+	serviceAccountFlag   string
+	pullSecretFlag       []string
+	forwardLocalhostFlag bool
+
+	// outputDir is the absolute path of the output directory
+	outputDir string
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*K8sOptions)(nil)
+
+// NewK8sOptions returns a new instance of K8sOptions
+func NewK8sOptions() *K8sOptions {
+	return &K8sOptions{}
+}
+
+func (o *K8sOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *K8sOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+	if odocontext.GetEffectiveDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(workingDir)
+	}
+	o.outputDir = o.outputDirFlag
+	if !filepath.IsAbs(o.outputDir) {
+		o.outputDir = filepath.Join(workingDir, o.outputDir)
+	}
+	return nil
+}
+
+func (o *K8sOptions) Validate(ctx context.Context) error {
+	if _, ok := modes[o.modeFlag]; !ok {
+		return fmt.Errorf("--mode must be one of %q or %q", "dev", "deploy")
+	}
+	if o.outputDirFlag == "" {
+		return errors.New("--output-dir must not be empty")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo export k8s command
+func (o *K8sOptions) Run(ctx context.Context) error {
+	var (
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		workingDir    = odocontext.GetWorkingDirectory(ctx)
+	)
+
+	pullSecrets := o.pullSecretFlag
+	if len(pullSecrets) == 0 {
+		pullSecrets = o.clientset.PreferenceClient.GetImagePullSecrets()
+	}
+	resources, err := export.KubernetesResources(ctx, *devfileObj, componentName, appName, workingDir, export.KubernetesOptions{
+		Mode:                  modes[o.modeFlag],
+		KubeClient:            o.clientset.KubernetesClient,
+		ServiceAccount:        o.serviceAccountFlag,
+		DefaultServiceAccount: o.clientset.PreferenceClient.GetServiceAccount(),
+		ImagePullSecrets:      pullSecrets,
+		ForwardLocalhost:      o.forwardLocalhostFlag,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		log.Infof("No resources to export in the %s mode", o.modeFlag)
+		return nil
+	}
+
+	var paths []string
+	if o.helmFlag {
+		paths, err = export.WriteHelmChart(o.clientset.FS, resources, o.outputDir, componentName, devfileObj.Data.GetMetadata().Version, o.forceFlag)
+	} else {
+		paths, err = export.WriteManifests(o.clientset.FS, resources, o.outputDir, o.forceFlag)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if rel, relErr := filepath.Rel(workingDir, path); relErr == nil {
+			path = rel
+		}
+		log.Printf("%s", path)
+	}
+	if o.helmFlag {
+		log.Successf("Helm chart of the component %q generated in %s", componentName, o.outputDirFlag)
+	} else {
+		log.Successf("Kubernetes manifests of the component %q generated in %s", componentName, o.outputDirFlag)
+	}
+	return nil
+}
+
+// NewCmdK8s implements the odo export k8s command
+func NewCmdK8s(name, fullName string) *cobra.Command {
+	o := NewK8sOptions()
+	k8sCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Generate the Kubernetes manifests of the component",
+		Long:    k8sLongDesc,
+		Example: fmt.Sprintf(k8sExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	k8sCmd.Flags().StringVar(&o.modeFlag, "mode", "deploy", "Mode of the generated resources (dev or deploy)")
+	k8sCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", "k8s", "Directory in which the manifests are written")
+	k8sCmd.Flags().BoolVar(&o.helmFlag, "helm", false, "Generate a Helm chart skeleton containing the manifests as templates")
+	k8sCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the existing files")
+	k8sCmd.Flags().StringVar(&o.serviceAccountFlag, "service-account", "",
+		"Service account used by the pod of the component in the dev mode. The ServiceAccount preference is used if this flag is not set and the pod-overrides of the Devfile do not set one.")
+	k8sCmd.Flags().StringArrayVar(&o.pullSecretFlag, "image-pull-secret", nil,
+		"Name of a secret used to pull the images of the component in the dev mode; can be repeated. The ImagePullSecrets preference is used if this flag is not set.")
+	k8sCmd.Flags().BoolVar(&o.forwardLocalhostFlag, "forward-localhost", false,
+		"Add to the pod of the dev mode the side container relaying the traffic to the applications listening on the loopback interface, as odo dev --forward-localhost.")
+
+	clientset.Add(k8sCmd, clientset.FILESYSTEM, clientset.KUBERNETES_NULLABLE, clientset.PREFERENCE)
+	k8sCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return k8sCmd
+}
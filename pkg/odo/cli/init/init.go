@@ -16,6 +16,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/init/backend"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -29,6 +30,8 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 	"github.com/redhat-developer/odo/pkg/version"
 
@@ -63,6 +66,9 @@ var initExample = templates.Examples(`
 
   # Bootstrap a new component and download a starter project
   %[1]s --name my-app --devfile nodejs --starter nodejs-starter
+
+  # Bootstrap a new component from the services of a Docker Compose file
+  %[1]s --name my-app --from-compose docker-compose.yaml
   `)
 
 type InitOptions struct {
@@ -71,6 +77,9 @@ type InitOptions struct {
 
 	// Flags passed to the command
 	flags map[string]string
+
+	// runPostInitFlag runs the post-init commands of the starter project without asking for confirmation
+	runPostInitFlag bool
 }
 
 var _ genericclioptions.Runnable = (*InitOptions)(nil)
@@ -118,6 +127,10 @@ func (o *InitOptions) Validate(ctx context.Context) error {
 	if len(o.flags) == 0 && fcontext.IsJsonOutput(ctx) {
 		return errors.New("parameters are expected to select a devfile")
 	}
+
+	if o.runPostInitFlag && len(o.flags) != 0 && o.flags[backend.FLAG_STARTER] == "" {
+		return fmt.Errorf("--run-post-init can only be used with the --%s flag", backend.FLAG_STARTER)
+	}
 	return nil
 }
 
@@ -129,10 +142,10 @@ func (o *InitOptions) Run(ctx context.Context) (err error) {
 		return err
 	}
 
-	exitMessage := fmt.Sprintf(`
+	exitMessage := fmt.Sprintf(i18n.T(`
 Your new component '%s' is ready in the current directory.
 To start editing your component, use 'odo dev' and open this folder in your favorite IDE.
-Changes will be directly reflected on the cluster.`, devfileObj.Data.GetMetadata().Name)
+Changes will be directly reflected on the cluster.`), devfileObj.Data.GetMetadata().Name)
 
 	if len(o.flags) == 0 {
 		automateCommand := fmt.Sprintf("odo init --name %s --devfile %s --devfile-registry %s", name, devfileLocation.Devfile, devfileLocation.DevfileRegistry)
@@ -144,11 +157,11 @@ Changes will be directly reflected on the cluster.`, devfileObj.Data.GetMetadata
 		}
 
 		klog.V(2).Infof("Port configuration using flag is currently not supported")
-		log.Infof("\nYou can automate this command by executing:\n   %s", automateCommand)
+		log.Infof(i18n.T("\nYou can automate this command by executing:\n   %s"), automateCommand)
 	}
 
 	if libdevfile.HasDeployCommand(devfileObj.Data) {
-		exitMessage += "\nTo deploy your component to a cluster use \"odo deploy\"."
+		exitMessage += i18n.T("\nTo deploy your component to a cluster use \"odo deploy\".")
 	}
 	log.Info(exitMessage)
 
@@ -180,6 +193,11 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 		if err == nil {
 			return
 		}
+		var noTerminalErr *prompt.NoTerminalError
+		if errors.As(err, &noTerminalErr) {
+			err = fmt.Errorf("%w\nrun the command in a terminal, with the --%s and --%s (or --%s) flags, or with the --%s flag to read the answers from a file",
+				err, backend.FLAG_NAME, backend.FLAG_DEVFILE, backend.FLAG_DEVFILE_PATH, prompt.AnswersFileFlagName)
+		}
 		if starterDownloaded {
 			err = fmt.Errorf("%w\nthe command failed after downloading the starter project. By security, the directory is not cleaned up", err)
 		} else {
@@ -197,14 +215,14 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 
 	var infoOutput string
 	if isEmptyDir && len(o.flags) == 0 {
-		infoOutput = messages.NoSourceCodeDetected
+		infoOutput = i18n.T(messages.NoSourceCodeDetected)
 	} else if len(o.flags) == 0 {
-		infoOutput = messages.SourceCodeDetected
+		infoOutput = i18n.T(messages.SourceCodeDetected)
 	}
-	log.Title(messages.InitializingNewComponent, infoOutput, "odo version: "+version.VERSION)
+	log.Title(i18n.T(messages.InitializingNewComponent), infoOutput, fmt.Sprintf(i18n.T("odo version: %s"), version.VERSION))
 	log.Println()
 	if len(o.flags) == 0 {
-		log.Info(messages.InteractiveModeEnabled)
+		log.Info(i18n.T(messages.InteractiveModeEnabled))
 	}
 
 	devfileObj, devfilePath, devfileLocation, err := o.clientset.InitClient.SelectAndPersonalizeDevfile(ctx, o.flags, workingDir)
@@ -251,6 +269,13 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 		klog.V(4).Infof("error trying to report local file generated: %v", err)
 	}
 
+	if starterInfo != nil {
+		err = o.clientset.InitClient.RunPostInitCommands(starterInfo, o.flags, o.runPostInitFlag, workingDir)
+		if err != nil {
+			return parser.DevfileObj{}, "", "", nil, nil, err
+		}
+	}
+
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
 	scontext.SetLanguage(ctx, devfileObj.Data.GetMetadata().Language)
 	scontext.SetProjectType(ctx, devfileObj.Data.GetMetadata().ProjectType)
@@ -281,10 +306,16 @@ func NewCmdInit(name, fullName string) *cobra.Command {
 	initCmd.Flags().String(backend.FLAG_STARTER, "", "name of the starter project")
 	initCmd.Flags().String(backend.FLAG_DEVFILE_PATH, "", "path to a devfile. This is an alternative to using devfile from Devfile registry. It can be local filesystem path or http(s) URL")
 	initCmd.Flags().String(backend.FLAG_DEVFILE_VERSION, "", "version of the devfile stack; use \"latest\" to dowload the latest stack")
+	initCmd.Flags().String(backend.FLAG_FROM_COMPOSE, "", "path to a Docker Compose file, whose services are converted into the devfile. This is an alternative to using a devfile")
+	initCmd.Flags().BoolVar(&o.runPostInitFlag, "run-post-init", false, "run the post-init commands defined by the starter project (for example to install the dependencies) without asking for confirmation")
+	initCmd.Flags().String(prompt.AnswersFileFlagName, "", "path to a YAML file containing the answers to the questions of the interactive mode, for use when the standard input is not a terminal")
 
 	commonflags.UseOutputFlag(initCmd)
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(initCmd, util.MainGroup)
+	_ = initCmd.RegisterFlagCompletionFunc(backend.FLAG_DEVFILE, completion.DevfileNames)
+	_ = initCmd.RegisterFlagCompletionFunc(backend.FLAG_DEVFILE_REGISTRY, completion.RegistryNames)
+
 	initCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	return initCmd
 }
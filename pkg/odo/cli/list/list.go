@@ -103,9 +103,9 @@ func (lo *ListOptions) Run(ctx context.Context) error {
 
 	listSpinner.End(true)
 
-	fmt.Printf("\nComponents:\n")
+	fmt.Fprintf(log.GetStdout(), "\nComponents:\n")
 	clicomponent.HumanReadableOutput(ctx, list)
-	fmt.Printf("\nBindings:\n")
+	fmt.Fprintf(log.GetStdout(), "\nBindings:\n")
 	binding.HumanReadableOutput(list)
 	return nil
 }
@@ -139,7 +139,7 @@ func HumanReadable(services api.ResourcesList) {
 		log.Error("no bindable Operator backed services found")
 		return
 	}
-	fmt.Println()
+	log.Println()
 	t := ui.NewTable()
 	t.AppendHeader(table.Row{"NAME", "NAMESPACE"})
 	for _, svc := range services.BindableServices {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/auth"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/odo/util"
@@ -30,6 +31,9 @@ type LoginOptions struct {
 	skipTlsFlag  bool
 	serverFlag   string
 
+	// kubeconfig is the kubeconfig file selected with the --kubeconfig flag
+	kubeconfig string
+
 	// client
 	loginClient auth.Client
 }
@@ -68,6 +72,7 @@ func (o *LoginOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, ar
 		// odo login --token=<some-token> https://api.crc.testing:6443
 		o.server = args[0]
 	}
+	o.kubeconfig = commonflags.GetKubeconfigSelection().Kubeconfig
 	return
 }
 
@@ -87,7 +92,7 @@ func (o *LoginOptions) Validate(ctx context.Context) (err error) {
 
 // Run contains the logic for the odo command
 func (o *LoginOptions) Run(ctx context.Context) (err error) {
-	return o.loginClient.Login(o.serverFlag, o.userNameFlag, o.passwordFlag, o.tokenFlag, o.caAuthFlag, o.skipTlsFlag)
+	return o.loginClient.Login(o.serverFlag, o.userNameFlag, o.passwordFlag, o.tokenFlag, o.caAuthFlag, o.skipTlsFlag, o.kubeconfig)
 }
 
 // NewCmdLogin implements the odo command
@@ -106,6 +111,7 @@ func NewCmdLogin(name, fullName string) *cobra.Command {
 		},
 	}
 
+	commonflags.UseKubeconfigFlag(loginCmd)
 	util.SetCommandGroup(loginCmd, util.OpenshiftGroup)
 	loginCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	loginCmd.Flags().StringVarP(&o.userNameFlag, "username", "u", "", "username, will prompt if not provided")
@@ -74,6 +74,9 @@ func (o *LogsOptions) SetClientset(clientset *clientset.Clientset) {
 }
 
 func (o *LogsOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, _ []string) error {
+	// Get the writer again, as it copies the output to the log file only once it is enabled
+	o.out = log.GetStdout()
+
 	var err error
 	workingDir := odocontext.GetWorkingDirectory(ctx)
 	isEmptyDir, err := location.DirIsEmpty(o.clientset.FS, workingDir)
@@ -0,0 +1,105 @@
+package plugins
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// Environment variables passed to the plugins, giving them the context of the odo invocation
+const (
+	// EnvNamespace is the current namespace defined in the kubeconfig, or in the kubeconfig file and context
+	// selected with the --kubeconfig and --context flags
+	EnvNamespace = "ODO_NAMESPACE"
+	// EnvDevfilePath is the absolute path of the Devfile in the working directory, if any
+	EnvDevfilePath = "ODO_DEVFILE_PATH"
+	// EnvStateFile is the absolute path of the file containing the state of the Dev session running from the working directory
+	EnvStateFile = "ODO_STATE_FILE"
+	// EnvBinary is the absolute path of the odo binary executing the plugin
+	EnvBinary = "ODO_BINARY"
+)
+
+// getEnvironment returns the environment variables giving the context of the odo invocation to the plugins.
+// Errors are not fatal, the variables whose values cannot be determined are not defined.
+func getEnvironment(workingDir string, selection kclient.ConfigSelection) []string {
+	var env []string
+
+	namespace, err := selection.Namespace()
+	if err != nil {
+		klog.V(4).Infof("unable to get current namespace for plugin: %v", err)
+	} else {
+		env = append(env, envVar(EnvNamespace, namespace))
+	}
+
+	devfilePath := location.DevfileLocation(workingDir)
+	if util.CheckPathExists(devfilePath) {
+		env = append(env, envVar(EnvDevfilePath, devfilePath))
+	}
+
+	env = append(env, envVar(EnvStateFile, state.GetStateFilePath(workingDir)))
+
+	binary, err := os.Executable()
+	if err != nil {
+		klog.V(4).Infof("unable to get odo binary path for plugin: %v", err)
+	} else {
+		env = append(env, envVar(EnvBinary, binary))
+	}
+	return env
+}
+
+// withoutPluginEnvironment returns the environment env without the variables giving the context of the odo invocation,
+// so the values inherited by odo (e.g. from a plugin executing odo) do not take precedence over the ones of this invocation,
+// nor are passed when their values cannot be determined
+func withoutPluginEnvironment(env []string) []string {
+	result := make([]string, 0, len(env))
+	for _, e := range env {
+		name, _, _ := strings.Cut(e, "=")
+		switch name {
+		case EnvNamespace, EnvDevfilePath, EnvStateFile, EnvBinary:
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// getKubeconfigSelection returns the kubeconfig file and context selected with the --kubeconfig and --context flags
+// passed in the arguments, as the plugins are executed before the flags of odo are parsed
+func getKubeconfigSelection(args []string) kclient.ConfigSelection {
+	var selection kclient.ConfigSelection
+	for i := 0; i < len(args); i++ {
+		var value *string
+		name, v, hasValue := strings.Cut(strings.TrimPrefix(args[i], "--"), "=")
+		switch {
+		case !strings.HasPrefix(args[i], "--"):
+			continue
+		case name == commonflags.KubeconfigFlagName:
+			value = &selection.Kubeconfig
+		case name == commonflags.ContextFlagName:
+			value = &selection.Context
+		default:
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				break
+			}
+			i++
+			v = args[i]
+		}
+		*value = v
+	}
+	return selection
+}
+
+func envVar(name, value string) string {
+	return fmt.Sprintf("%s=%s", name, value)
+}
@@ -11,13 +11,18 @@ import (
 
 // HandleCommand receives a PluginHandler and command-line arguments and attempts to find
 // a plugin executable on the PATH that satisfies the given arguments.
+// The plugin receives the context of the odo invocation through environment variables (see getEnvironment).
 func HandleCommand(handler PluginHandler, args []string) error {
 	foundBinary, remaining := findBinary(handler, args)
 	if foundBinary == "" {
 		return nil
 	}
 
-	if err := handler.Execute(foundBinary, args[len(remaining):], os.Environ()); err != nil {
+	env := withoutPluginEnvironment(os.Environ())
+	if workingDir, err := os.Getwd(); err == nil {
+		env = append(env, getEnvironment(workingDir, getKubeconfigSelection(args))...)
+	}
+	if err := handler.Execute(foundBinary, args[len(remaining):], env); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,176 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeHandler struct {
+	plugins map[string]string
+
+	executed string
+	args     []string
+	env      []string
+}
+
+func (o *fakeHandler) Lookup(command string) string {
+	return o.plugins[command]
+}
+
+func (o *fakeHandler) Execute(filename string, args, env []string) error {
+	o.executed = filename
+	o.args = args
+	o.env = env
+	return nil
+}
+
+func TestHandleCommand(t *testing.T) {
+	dir := t.TempDir()
+	kubeconfig := filepath.Join(dir, "kubeconfig")
+	err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://cluster.example.com:6443
+  name: cluster
+contexts:
+- context:
+    cluster: cluster
+    namespace: my-namespace
+    user: user
+  name: my-context
+current-context: my-context
+users:
+- name: user
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", kubeconfig)
+	// stale values inherited from the environment of odo
+	t.Setenv(EnvNamespace, "stale-namespace")
+	t.Setenv(EnvStateFile, "/stale/devstate.json")
+
+	devfilePath := filepath.Join(dir, "devfile.yaml")
+	if err = os.WriteFile(devfilePath, []byte("schemaVersion: 2.2.0\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	handler := &fakeHandler{
+		plugins: map[string]string{
+			"hello-world": "/path/to/odo-hello-world",
+		},
+	}
+	err = HandleCommand(handler, []string{"hello", "world", "arg", "--flag"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if handler.executed != "/path/to/odo-hello-world" {
+		t.Errorf("expected plugin odo-hello-world to be executed, got %q", handler.executed)
+	}
+	if diff := cmp.Diff([]string{"arg", "--flag"}, handler.args); diff != "" {
+		t.Errorf("args mismatch (-want +got):\n%s", diff)
+	}
+
+	env := map[string]string{}
+	for _, e := range handler.env {
+		if name, value, found := strings.Cut(e, "="); found {
+			if _, defined := env[name]; defined && strings.HasPrefix(name, "ODO_") {
+				t.Errorf("expected %s to be defined once, got %q and %q", name, env[name], value)
+			}
+			env[name] = value
+		}
+	}
+	for name, want := range map[string]string{
+		EnvNamespace:   "my-namespace",
+		EnvDevfilePath: devfilePath,
+		EnvStateFile:   filepath.Join(dir, ".odo", "devstate.json"),
+	} {
+		if got := env[name]; got != want {
+			t.Errorf("expected %s=%q, got %q", name, want, got)
+		}
+	}
+	if env[EnvBinary] == "" {
+		t.Errorf("expected %s to be defined", EnvBinary)
+	}
+}
+
+func TestHandleCommand_KubeconfigFlags(t *testing.T) {
+	dir := t.TempDir()
+	kubeconfig := filepath.Join(dir, "other-kubeconfig")
+	err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://cluster.example.com:6443
+  name: cluster
+contexts:
+- context:
+    cluster: cluster
+    namespace: my-namespace
+    user: user
+  name: my-context
+- context:
+    cluster: cluster
+    namespace: other-namespace
+    user: user
+  name: other-context
+current-context: my-context
+users:
+- name: user
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBECONFIG", filepath.Join(dir, "not-found"))
+
+	for _, args := range [][]string{
+		{"hello", "--kubeconfig", kubeconfig, "--context", "other-context"},
+		{"hello", "--kubeconfig=" + kubeconfig, "--context=other-context"},
+	} {
+		handler := &fakeHandler{
+			plugins: map[string]string{
+				"hello": "/path/to/odo-hello",
+			},
+		}
+		err = HandleCommand(handler, args)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var namespace string
+		for _, e := range handler.env {
+			if name, value, found := strings.Cut(e, "="); found && name == EnvNamespace {
+				namespace = value
+			}
+		}
+		if namespace != "other-namespace" {
+			t.Errorf("with args %v, expected %s=%q, got %q", args, EnvNamespace, "other-namespace", namespace)
+		}
+	}
+}
+
+func TestHandleCommand_NoPlugin(t *testing.T) {
+	handler := &fakeHandler{}
+	err := HandleCommand(handler, []string{"unknown"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handler.executed != "" {
+		t.Errorf("expected no plugin to be executed, got %q", handler.executed)
+	}
+}
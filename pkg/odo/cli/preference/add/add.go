@@ -19,17 +19,19 @@ var registryDesc = ktemplates.LongDesc(`Add value to an array of items`)
 // NewCmdAdd implements the registry configuration command
 func NewCmdAdd(name, fullName string) *cobra.Command {
 	registryCmd := NewCmdRegistry(registryCommandName, util.GetFullName(fullName, registryCommandName))
+	credentialCmd := NewCmdCredential(credentialCommandName, util.GetFullName(fullName, credentialCommandName))
 
 	addCmd := &cobra.Command{
 		Use:   name,
 		Short: registryDesc,
 		Long:  registryDesc,
-		Example: fmt.Sprintf("%s\n",
+		Example: fmt.Sprintf("%s\n%s\n",
 			registryCmd.Example,
+			credentialCmd.Example,
 		),
 	}
 
-	addCmd.AddCommand(registryCmd)
+	addCmd.AddCommand(registryCmd, credentialCmd)
 	addCmd.SetUsageTemplate(util.CmdUsageTemplate)
 	util.SetCommandGroup(addCmd, util.MainGroup)
 
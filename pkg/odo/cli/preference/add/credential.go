@@ -0,0 +1,98 @@
+package add
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/credentials"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+const credentialCommandName = "credential"
+
+// "odo preference add credential" command description and examples
+var (
+	addCredentialLongDesc = ktemplates.LongDesc(`Add the credentials used to access private git repositories and files on a host,
+	for example to download starter projects or devfiles. The credentials are saved in the keyring of the system.`)
+
+	addCredentialExample = ktemplates.Examples(`# Add a token to access private repositories on GitHub
+	%[1]s github.com --token ghp_xxxxxxxx
+
+	# Add a username and a token to access private repositories on a GitLab instance
+	%[1]s gitlab.example.com --username myuser --token glpat-xxxxxxxx
+	`)
+)
+
+// CredentialOptions encapsulates the options for the "odo preference add credential" command
+type CredentialOptions struct {
+	// Parameters
+	host string
+
+	// Flags
+	usernameFlag string
+	tokenFlag    string
+}
+
+var _ genericclioptions.Runnable = (*CredentialOptions)(nil)
+
+// NewCredentialOptions creates a new CredentialOptions instance
+func NewCredentialOptions() *CredentialOptions {
+	return &CredentialOptions{}
+}
+
+func (o *CredentialOptions) SetClientset(clientset *clientset.Clientset) {
+}
+
+// Complete completes CredentialOptions after they've been created
+func (o *CredentialOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.host = args[0]
+	return nil
+}
+
+// Validate validates the CredentialOptions based on completed values
+func (o *CredentialOptions) Validate(ctx context.Context) (err error) {
+	if o.tokenFlag == "" {
+		return errors.New("missing --token parameter: please add --token <token> to specify the token or password for the host")
+	}
+	return nil
+}
+
+// Run contains the logic for "odo preference add credential" command
+func (o *CredentialOptions) Run(ctx context.Context) (err error) {
+	err = credentials.Save(o.host, credentials.Credentials{
+		Username: o.usernameFlag,
+		Password: o.tokenFlag,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Credentials for %q successfully added", o.host)
+	return nil
+}
+
+// NewCmdCredential implements the "odo preference add credential" command
+func NewCmdCredential(name, fullName string) *cobra.Command {
+	o := NewCredentialOptions()
+	credentialCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <host>", name),
+		Short:   "Add credentials for a host",
+		Long:    addCredentialLongDesc,
+		Example: fmt.Sprintf(fmt.Sprint(addCredentialExample), fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	credentialCmd.Flags().StringVar(&o.usernameFlag, "username", "", "Username to access the host")
+	credentialCmd.Flags().StringVar(&o.tokenFlag, "token", "", "Token (or password) to access the host")
+
+	return credentialCmd
+}
@@ -0,0 +1,79 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/credentials"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+const credentialCommandName = "credential"
+
+// "odo preference remove credential" command description and examples
+var (
+	removeCredentialLongDesc = ktemplates.LongDesc(`Remove the credentials of a host from the keyring of the system`)
+
+	removeCredentialExample = ktemplates.Examples(`# Remove the credentials for GitHub
+	%[1]s github.com
+	`)
+)
+
+// CredentialOptions encapsulates the options for the "odo preference remove credential" command
+type CredentialOptions struct {
+	// Parameters
+	host string
+}
+
+var _ genericclioptions.Runnable = (*CredentialOptions)(nil)
+
+// NewCredentialOptions creates a new CredentialOptions instance
+func NewCredentialOptions() *CredentialOptions {
+	return &CredentialOptions{}
+}
+
+func (o *CredentialOptions) SetClientset(clientset *clientset.Clientset) {
+}
+
+// Complete completes CredentialOptions after they've been created
+func (o *CredentialOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.host = args[0]
+	return nil
+}
+
+// Validate validates the CredentialOptions based on completed values
+func (o *CredentialOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo preference remove credential" command
+func (o *CredentialOptions) Run(ctx context.Context) (err error) {
+	err = credentials.Delete(o.host)
+	if err != nil {
+		return err
+	}
+	log.Infof("Credentials for %q successfully removed", o.host)
+	return nil
+}
+
+// NewCmdCredential implements the "odo preference remove credential" command
+func NewCmdCredential(name, fullName string) *cobra.Command {
+	o := NewCredentialOptions()
+	credentialCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <host>", name),
+		Short:   removeCredentialLongDesc,
+		Long:    removeCredentialLongDesc,
+		Example: fmt.Sprintf(fmt.Sprint(removeCredentialExample), fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	return credentialCmd
+}
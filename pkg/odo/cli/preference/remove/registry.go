@@ -15,6 +15,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
 	registryUtil "github.com/redhat-developer/odo/pkg/registry"
 )
 
@@ -92,11 +93,12 @@ func (o *RegistryOptions) Run(ctx context.Context) (err error) {
 func NewCmdRegistry(name, fullName string) *cobra.Command {
 	o := NewRegistryOptions()
 	registryDeleteCmd := &cobra.Command{
-		Use:     fmt.Sprintf("%s <registry name>", name),
-		Short:   removeLongDesc,
-		Long:    removeLongDesc,
-		Example: fmt.Sprintf(fmt.Sprint(removeExample), fullName),
-		Args:    cobra.ExactArgs(1),
+		Use:               fmt.Sprintf("%s <registry name>", name),
+		Short:             removeLongDesc,
+		Long:              removeLongDesc,
+		Example:           fmt.Sprintf(fmt.Sprint(removeExample), fullName),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.PreferenceRegistryNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
@@ -19,17 +19,19 @@ var registryDesc = ktemplates.LongDesc(`Remove value from an array of items`)
 // NewCmdRemove implements the registry configuration command
 func NewCmdRemove(name, fullName string) *cobra.Command {
 	registryCmd := NewCmdRegistry(registryCommandName, util.GetFullName(fullName, registryCommandName))
+	credentialCmd := NewCmdCredential(credentialCommandName, util.GetFullName(fullName, credentialCommandName))
 
 	removeCmd := &cobra.Command{
 		Use:   name,
 		Short: registryDesc,
 		Long:  registryDesc,
-		Example: fmt.Sprintf("%s\n",
+		Example: fmt.Sprintf("%s\n%s\n",
 			registryCmd.Example,
+			credentialCmd.Example,
 		),
 	}
 
-	removeCmd.AddCommand(registryCmd)
+	removeCmd.AddCommand(registryCmd, credentialCmd)
 	removeCmd.SetUsageTemplate(util.CmdUsageTemplate)
 	util.SetCommandGroup(removeCmd, util.MainGroup)
 
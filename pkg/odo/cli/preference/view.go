@@ -16,12 +16,16 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util"
 )
 
 const viewCommandName = "view"
 
 var viewExample = ktemplates.Examples(`# View all set preference values 
    %[1]s
+
+  # View the fields sent to telemetry
+   %[1]s telemetry
   `)
 
 // ViewOptions encapsulates the options for the command
@@ -140,5 +144,7 @@ func NewCmdView(name, fullName string) *cobra.Command {
 	}
 	clientset.Add(preferenceViewCmd, clientset.PREFERENCE, clientset.REGISTRY)
 	commonflags.UseOutputFlag(preferenceViewCmd)
+
+	preferenceViewCmd.AddCommand(NewCmdViewTelemetry(viewTelemetryCommandName, util.GetFullName(fullName, viewTelemetryCommandName)))
 	return preferenceViewCmd
 }
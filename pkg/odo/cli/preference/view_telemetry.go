@@ -0,0 +1,107 @@
+package preference
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/segment"
+)
+
+const viewTelemetryCommandName = "telemetry"
+
+var viewTelemetryExample = ktemplates.Examples(`# View the fields sent to telemetry
+   %[1]s
+  `)
+
+// ViewTelemetryOptions encapsulates the options for the command
+type ViewTelemetryOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*ViewTelemetryOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*ViewTelemetryOptions)(nil)
+
+// NewViewTelemetryOptions creates a new ViewTelemetryOptions instance
+func NewViewTelemetryOptions() *ViewTelemetryOptions {
+	return &ViewTelemetryOptions{}
+}
+
+func (o *ViewTelemetryOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes ViewTelemetryOptions after they've been created
+func (o *ViewTelemetryOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	return
+}
+
+// Validate validates the ViewTelemetryOptions based on completed values
+func (o *ViewTelemetryOptions) Validate(ctx context.Context) (err error) {
+	return
+}
+
+// Run contains the logic for the command
+func (o *ViewTelemetryOptions) Run(ctx context.Context) (err error) {
+	view := o.getTelemetryView(ctx)
+
+	destination := "Segment"
+	if view.Endpoint != "" {
+		destination = view.Endpoint
+	}
+	if view.Enabled {
+		log.Infof("Telemetry is enabled. The following fields are sent to %s for each command executed:", destination)
+	} else {
+		log.Infof("Telemetry is disabled. If enabled, the following fields would be sent to %s for each command executed:", destination)
+	}
+	fieldsT := ui.NewTable()
+	fieldsT.AppendHeader(table.Row{"FIELD", "EVENT", "DESCRIPTION"})
+	for _, field := range view.Fields {
+		fieldsT.AppendRow(table.Row{field.Name, field.Event, field.Description})
+	}
+	fieldsT.Render()
+	return nil
+}
+
+func (o *ViewTelemetryOptions) RunForJsonOutput(ctx context.Context) (result interface{}, err error) {
+	return o.getTelemetryView(ctx), nil
+}
+
+func (o *ViewTelemetryOptions) getTelemetryView(ctx context.Context) api.TelemetryView {
+	return api.TelemetryView{
+		Enabled:  segment.IsTelemetryEnabled(o.clientset.PreferenceClient, envcontext.GetEnvConfig(ctx)),
+		Endpoint: o.clientset.PreferenceClient.GetTelemetryEndpoint(),
+		Fields:   segment.GetTelemetryFields(),
+	}
+}
+
+// NewCmdViewTelemetry implements the preference view telemetry odo command
+func NewCmdViewTelemetry(name, fullName string) *cobra.Command {
+	o := NewViewTelemetryOptions()
+	viewTelemetryCmd := &cobra.Command{
+		Use:     name,
+		Short:   "View the fields sent to telemetry",
+		Long:    "View the fields sent to telemetry when it is enabled, and whether it is enabled",
+		Example: fmt.Sprintf(fmt.Sprint("\n", viewTelemetryExample), fullName),
+
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(viewTelemetryCmd, clientset.PREFERENCE)
+	commonflags.UseOutputFlag(viewTelemetryCmd)
+	return viewTelemetryCmd
+}
@@ -0,0 +1,51 @@
+package preference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/preference"
+)
+
+func TestViewTelemetry(t *testing.T) {
+	for _, consent := range []bool{true, false} {
+		t.Run("", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetConsentTelemetry().Return(consent).AnyTimes()
+			prefClient.EXPECT().GetTelemetryEndpoint().Return("https://collector.example.com")
+			opts := NewViewTelemetryOptions()
+			opts.SetClientset(&clientset.Clientset{
+				PreferenceClient: prefClient,
+			})
+			ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{})
+
+			result, err := opts.RunForJsonOutput(ctx)
+			if err != nil {
+				t.Fatalf("Expected nil error, got %s", err)
+			}
+			view := result.(api.TelemetryView)
+			if view.Enabled != consent {
+				t.Errorf("expected enabled to be %v, got %v", consent, view.Enabled)
+			}
+			if view.Endpoint != "https://collector.example.com" {
+				t.Errorf("unexpected endpoint %q", view.Endpoint)
+			}
+			names := map[string]bool{}
+			for _, field := range view.Fields {
+				names[field.Name] = true
+			}
+			for _, name := range []string{"userId", "duration-bucket", "error-code"} {
+				if !names[name] {
+					t.Errorf("field %q should be listed", name)
+				}
+			}
+		})
+	}
+}
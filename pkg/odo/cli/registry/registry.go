@@ -17,6 +17,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
 	"github.com/redhat-developer/odo/pkg/registry"
 	"github.com/redhat-developer/odo/pkg/util"
 )
@@ -121,6 +122,8 @@ func NewCmdRegistry(name, fullName string) *cobra.Command {
 	listCmd.Flags().StringVar(&o.devfileFlag, "devfile", "", "Only the specific Devfile component")
 	listCmd.Flags().StringVar(&o.registryFlag, "devfile-registry", "", "Only show components from the specific Devfile registry")
 	listCmd.Flags().BoolVar(&o.detailsFlag, "details", false, "Show details of a Devfile, to be used only with --devfile")
+	_ = listCmd.RegisterFlagCompletionFunc("devfile", completion.DevfileNames)
+	_ = listCmd.RegisterFlagCompletionFunc("devfile-registry", completion.RegistryNames)
 
 	// Add a defined annotation in order to appear in the help menu
 	odoutil.SetCommandGroup(listCmd, odoutil.MainGroup)
@@ -196,7 +199,7 @@ func (o *ListOptions) printDevfileList(DevfileList []api.DevfileStack) {
 			}
 
 			// Output the details of the component
-			fmt.Printf(`%s: %s
+			fmt.Fprintf(log.GetStdout(), `%s: %s
 %s: %s
 %s: %s
 %s: %s
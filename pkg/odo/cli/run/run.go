@@ -16,6 +16,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
 	"github.com/redhat-developer/odo/pkg/podman"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 
@@ -99,11 +100,12 @@ func (o *RunOptions) Run(ctx context.Context) (err error) {
 func NewCmdRun(name, fullName string) *cobra.Command {
 	o := NewRunOptions()
 	runCmd := &cobra.Command{
-		Use:     name,
-		Short:   "Run a specific command in the Dev mode",
-		Long:    `odo run executes a specific command of the Devfile during the Dev mode ("odo dev" needs to be running)`,
-		Example: fmt.Sprintf(runExample, fullName),
-		Args:    cobra.ExactArgs(1),
+		Use:               name,
+		Short:             "Run a specific command in the Dev mode",
+		Long:              `odo run executes a specific command of the Devfile during the Dev mode ("odo dev" needs to be running)`,
+		Example:           fmt.Sprintf(runExample, fullName),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.DevfileCommandNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
@@ -0,0 +1,206 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/feature"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended status command name
+const RecommendedCommandName = "status"
+
+var statusExample = ktemplates.Examples(`
+	# Show the status of the inner loop of the component in the current directory
+	%[1]s
+
+	# Show the status in JSON format
+	%[1]s -o json
+`)
+
+type StatusOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*StatusOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*StatusOptions)(nil)
+
+// NewStatusOptions returns new instance of StatusOptions
+func NewStatusOptions() *StatusOptions {
+	return &StatusOptions{}
+}
+
+func (o *StatusOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *StatusOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	return nil
+}
+
+func (o *StatusOptions) Validate(ctx context.Context) error {
+	return nil
+}
+
+// Run contains the logic for the odo command
+func (o *StatusOptions) Run(ctx context.Context) error {
+	status, err := o.run(ctx)
+	if err != nil {
+		return err
+	}
+	printHumanReadableOutput(status)
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *StatusOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.run(ctx)
+}
+
+func (o *StatusOptions) run(ctx context.Context) (api.Status, error) {
+	componentName := odocontext.GetComponentName(ctx)
+
+	sessions, err := o.clientset.StateClient.GetSessions(ctx)
+	if err != nil {
+		return api.Status{}, err
+	}
+
+	runningModes, err := component.GetRunningModes(ctx, o.clientset.KubernetesClient, o.clientset.PodmanClient, componentName)
+	if err != nil && !errors.As(err, &component.NoComponentFoundError{}) {
+		return api.Status{}, err
+	}
+	runningOn := make(map[string]api.RunningModes, len(runningModes))
+	if o.clientset.KubernetesClient != nil && runningModes[o.clientset.KubernetesClient] != nil {
+		runningOn[commonflags.PlatformCluster] = runningModes[o.clientset.KubernetesClient]
+	}
+	if o.clientset.PodmanClient != nil && runningModes[o.clientset.PodmanClient] != nil {
+		runningOn[commonflags.PlatformPodman] = runningModes[o.clientset.PodmanClient]
+	}
+
+	var lastSession *api.DevSessionStatus
+	if len(sessions) == 0 {
+		lastSession, err = o.clientset.StateClient.GetLastSession(ctx)
+		if err != nil {
+			return api.Status{}, err
+		}
+	}
+
+	return api.Status{
+		ComponentName: componentName,
+		RunningIn:     api.MergeRunningModes(runningOn),
+		DevSessions:   sessions,
+		LastSession:   lastSession,
+	}, nil
+}
+
+func printHumanReadableOutput(status api.Status) {
+	log.Describef("Component: ", status.ComponentName)
+	log.Describef("Running in: ", status.RunningIn.String())
+	log.Println()
+
+	if len(status.DevSessions) == 0 {
+		log.Info("No Dev session running for this component")
+		if status.LastSession != nil {
+			log.Println()
+			log.Info(fmt.Sprintf("Last Dev session on %s (PID %d) has ended:", status.LastSession.Platform, status.LastSession.PID))
+			printSession(*status.LastSession)
+		}
+		return
+	}
+
+	for _, session := range status.DevSessions {
+		log.Info(fmt.Sprintf("Dev session on %s (PID %d):", session.Platform, session.PID))
+		printSession(session)
+		log.Println()
+	}
+}
+
+// printSession prints the status of a Dev session
+func printSession(session api.DevSessionStatus) {
+	if session.LastPush != nil {
+		lastPush := session.LastPush.Format(time.RFC1123)
+		if session.LastPushError != "" {
+			lastPush += " (failed: " + session.LastPushError + ")"
+		}
+		log.Printf("Last push: %s", lastPush)
+	} else {
+		log.Printf("Last push: none")
+	}
+	if session.LastBuild != nil {
+		result := "succeeded"
+		if !session.LastBuild.Success {
+			result = "failed: " + session.LastBuild.Error
+		}
+		log.Printf("Last build: %s at %s", result, session.LastBuild.Time.Format(time.RFC1123))
+	} else {
+		log.Printf("Last build: none")
+	}
+	if session.LastTests != nil {
+		result := "passed"
+		if !session.LastTests.Success {
+			result = "failed: " + session.LastTests.Error
+		}
+		log.Printf("Last tests: %s at %s (%d runs, %d failed)", result, session.LastTests.Time.Format(time.RFC1123), session.TestRuns, session.FailedTestRuns)
+	}
+	log.Printf("Pushes: %d (%d failed)", session.Pushes, session.FailedPushes)
+	log.Printf("Synced files: %d, deleted files: %d", session.SyncedFiles, session.DeletedFiles)
+	switch {
+	case session.Watch.Error != "":
+		log.Printf("Watch: error: %s", session.Watch.Error)
+	case session.Watch.Watching:
+		log.Printf("Watch: healthy")
+	default:
+		log.Printf("Watch: disabled")
+	}
+	if session.ResourceUsage != nil {
+		for _, container := range session.ResourceUsage.Containers {
+			log.Printf("Resource usage at %s: %s", session.ResourceUsage.Time.Format(time.RFC1123), container)
+		}
+	}
+	for _, port := range session.ForwardedPorts {
+		log.Printf("Forwarded port: %s:%d -> %s:%d", port.LocalAddress, port.LocalPort, port.ContainerName, port.ContainerPort)
+	}
+}
+
+// NewCmdStatus implements the status odo command
+func NewCmdStatus(ctx context.Context, name, fullName string) *cobra.Command {
+	o := NewStatusOptions()
+	statusCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Show the status of the inner loop of the component",
+		Long:    "Show the status of the inner loop of the component: last push, last build result, sync counters, forwarded ports, health of the files watcher and resource usage",
+		Example: fmt.Sprintf(statusExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(statusCmd, clientset.KUBERNETES_NULLABLE, clientset.STATE)
+	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
+		clientset.Add(statusCmd, clientset.PODMAN_NULLABLE)
+	}
+	odoutil.SetCommandGroup(statusCmd, odoutil.MainGroup)
+	statusCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UseOutputFlag(statusCmd)
+	commonflags.UsePlatformFlag(statusCmd)
+	return statusCmd
+}
@@ -0,0 +1,176 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// newFakeState returns a state client with a Dev session running on the cluster
+func newFakeState(t *testing.T) state.Client {
+	stateClient := state.NewStateClient(filesystem.NewFakeFs())
+	ctx := context.Background()
+	// PID 1 always exists, so the session is considered as running
+	ctx = odocontext.WithPID(ctx, 1)
+	ctx = fcontext.WithPlatform(ctx, commonflags.PlatformCluster)
+	err := stateClient.SetForwardedPorts(ctx, []api.ForwardedPort{
+		{
+			ContainerName: "runtime",
+			LocalAddress:  "127.0.0.1",
+			LocalPort:     20001,
+			ContainerPort: 3000,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = stateClient.SetWatchHealth(ctx, true, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = stateClient.RecordBuild(ctx, errors.New("exit status 1")); err != nil {
+		t.Fatal(err)
+	}
+	if err = stateClient.RecordPush(ctx, 4, 1, nil); err != nil {
+		t.Fatal(err)
+	}
+	return stateClient
+}
+
+// captureStdout returns what f writes to the standard output
+func captureStdout(t *testing.T, f func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+	}()
+	f()
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestStatusOptions_RunForJsonOutput(t *testing.T) {
+	o := NewStatusOptions()
+	o.SetClientset(&clientset.Clientset{
+		StateClient: newFakeState(t),
+	})
+	ctx := odocontext.WithComponentName(context.Background(), "my-component")
+
+	out, err := o.RunForJsonOutput(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status, ok := out.(api.Status)
+	if !ok {
+		t.Fatalf("expected api.Status, got %T", out)
+	}
+	if status.ComponentName != "my-component" {
+		t.Errorf("expected component name %q, got %q", "my-component", status.ComponentName)
+	}
+	if len(status.DevSessions) != 1 {
+		t.Fatalf("expected 1 Dev session, got %d", len(status.DevSessions))
+	}
+	session := status.DevSessions[0]
+	if session.Platform != commonflags.PlatformCluster || session.PID != 1 {
+		t.Errorf("unexpected session platform %q and PID %d", session.Platform, session.PID)
+	}
+	if session.Pushes != 1 || session.SyncedFiles != 4 || session.DeletedFiles != 1 {
+		t.Errorf("unexpected push counters: %+v", session.DevStatus)
+	}
+	if session.LastBuild == nil || session.LastBuild.Success {
+		t.Errorf("expected failed last build, got %+v", session.LastBuild)
+	}
+	if len(session.ForwardedPorts) != 1 {
+		t.Errorf("expected 1 forwarded port, got %+v", session.ForwardedPorts)
+	}
+}
+
+func TestStatusOptions_Run(t *testing.T) {
+	tests := []struct {
+		name        string
+		stateClient func(t *testing.T) state.Client
+		want        []string
+	}{
+		{
+			name:        "no Dev session",
+			stateClient: func(t *testing.T) state.Client { return state.NewStateClient(filesystem.NewFakeFs()) },
+			want: []string{
+				"my-component",
+				"No Dev session running for this component",
+			},
+		},
+		{
+			name: "ended Dev session",
+			stateClient: func(t *testing.T) state.Client {
+				stateClient := newFakeState(t)
+				ctx := odocontext.WithPID(context.Background(), 1)
+				ctx = fcontext.WithPlatform(ctx, commonflags.PlatformCluster)
+				if err := stateClient.SetWatchHealth(ctx, false, errors.New("too many open files")); err != nil {
+					t.Fatal(err)
+				}
+				if err := stateClient.SaveExit(ctx); err != nil {
+					t.Fatal(err)
+				}
+				return stateClient
+			},
+			want: []string{
+				"No Dev session running for this component",
+				"Last Dev session on cluster (PID 1) has ended:",
+				"Last build: failed: exit status 1",
+				"Watch: error: too many open files",
+			},
+		},
+		{
+			name:        "Dev session on cluster",
+			stateClient: newFakeState,
+			want: []string{
+				"Dev session on cluster (PID 1):",
+				"Last build: failed: exit status 1",
+				"Pushes: 1 (0 failed)",
+				"Synced files: 4, deleted files: 1",
+				"Watch: healthy",
+				"Forwarded port: 127.0.0.1:20001 -> runtime:3000",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewStatusOptions()
+			o.SetClientset(&clientset.Clientset{
+				StateClient: tt.stateClient(t),
+			})
+			ctx := odocontext.WithComponentName(context.Background(), "my-component")
+
+			var err error
+			out := captureStdout(t, func() {
+				err = o.Run(ctx)
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package _switch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/profile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+)
+
+const (
+	RecommendedCommandName = "switch"
+)
+
+type SwitchOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Args
+	profileName string
+}
+
+var _ genericclioptions.Runnable = (*SwitchOptions)(nil)
+
+func NewSwitchOptions() *SwitchOptions {
+	return &SwitchOptions{}
+}
+
+var switchExample = ktemplates.Examples(`
+	# List the profiles defined in the Devfile, and the active one
+	%[1]s
+
+	# Switch to the "dev-debug" profile, in the running Dev session and in the next ones
+	%[1]s dev-debug
+`)
+
+func (o *SwitchOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *SwitchOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	if len(args) == 1 {
+		o.profileName = args[0]
+	}
+	return nil
+}
+
+func (o *SwitchOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	if o.profileName == "" {
+		return nil
+	}
+	_, err := profile.Get(*devfileObj, o.profileName)
+	return err
+}
+
+func (o *SwitchOptions) Run(ctx context.Context) error {
+	if o.profileName == "" {
+		return o.listProfiles(ctx)
+	}
+
+	err := profile.SetActive(o.clientset.FS, odocontext.GetWorkingDirectory(ctx), o.profileName)
+	if err != nil {
+		return err
+	}
+	log.Successf("Switched to profile %q", o.profileName)
+	log.Info("The commands and environment variables of this profile are used by the running Dev session, and by the next ones")
+	return nil
+}
+
+// listProfiles displays the profiles defined in the Devfile, marking the active one
+func (o *SwitchOptions) listProfiles(ctx context.Context) error {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		workingDir = odocontext.GetWorkingDirectory(ctx)
+	)
+	profiles, err := profile.List(*devfileObj)
+	if err != nil {
+		return err
+	}
+	if len(profiles) == 0 {
+		log.Infof("No profile defined in the %q attribute of the Devfile", profile.ProfilesAttribute)
+		return nil
+	}
+	active, err := profile.GetActive(o.clientset.FS, workingDir)
+	if err != nil {
+		return err
+	}
+	for _, p := range profiles {
+		marker := " "
+		if p.Name == active {
+			marker = "*"
+		}
+		fmt.Fprintf(log.GetStdout(), "%s %s\n", marker, p.Name)
+	}
+	return nil
+}
+
+// NewCmdSwitch implements the odo switch command
+func NewCmdSwitch(name, fullName string) *cobra.Command {
+	o := NewSwitchOptions()
+	switchCmd := &cobra.Command{
+		Use:   name + " [profile]",
+		Short: "Switch to a profile defined in the Devfile",
+		Long: `odo switch selects the active profile of the component, among the profiles defined in the "odo.dev/profiles" attribute of the Devfile.
+A profile selects the build, run and debug commands, and the environment variables used by odo dev.
+The running Dev session, if any, switches to the new profile, and the next sessions use it.
+Without argument, the profiles are listed, and the active one is marked with a *.`,
+		Example:           fmt.Sprintf(switchExample, fullName),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.DevfileProfileNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(switchCmd, clientset.FILESYSTEM)
+
+	odoutil.SetCommandGroup(switchCmd, odoutil.MainGroup)
+	switchCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return switchCmd
+}
@@ -66,9 +66,10 @@ func (o *TelemetryOptions) Run(ctx context.Context) (err error) {
 		return util.WriteToJSONFile(o.telemetryData, dt)
 	}
 
-	segmentClient, err := segment.NewClient()
+	segmentClient, err := segment.NewClient(o.clientset.PreferenceClient.GetTelemetryEndpoint())
 	if err != nil {
 		klog.V(4).Infof("Cannot create a segment client. Will not send any data: %q", err)
+		return nil
 	}
 	defer segmentClient.Close()
 
@@ -0,0 +1,130 @@
+package test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/errors"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/podman"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	RecommendedCommandName = "test"
+)
+
+type TestOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	testCommandFlag string
+}
+
+var _ genericclioptions.Runnable = (*TestOptions)(nil)
+
+func NewTestOptions() *TestOptions {
+	return &TestOptions{}
+}
+
+var testExample = ktemplates.Examples(`
+	# Execute the default test command in the Dev mode
+	%[1]s
+
+	# Execute the test command "my-tests" in the Dev mode
+	%[1]s --test-command my-tests
+
+	# Execute the default test command in the Dev mode, and output the result as JSON events
+	%[1]s -o json
+`)
+
+func (o *TestOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *TestOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *TestOptions) Validate(ctx context.Context) error {
+	var (
+		devfileObj = odocontext.GetEffectiveDevfileObj(ctx)
+		platform   = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	if !libdevfile.HasTestCommand(devfileObj.Data) {
+		return errors.NewNoCommandInDevfileError("test")
+	}
+	if _, err := libdevfile.ValidateAndGetCommand(*devfileObj, o.testCommandFlag, v1alpha2.TestCommandGroupKind); err != nil {
+		return err
+	}
+
+	switch platform {
+
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	}
+	return nil
+}
+
+func (o *TestOptions) Run(ctx context.Context) (err error) {
+	return o.clientset.DevClient.Test(ctx, o.testCommandFlag, log.GetStdout())
+}
+
+func NewCmdTest(name, fullName string) *cobra.Command {
+	o := NewTestOptions()
+	testCmd := &cobra.Command{
+		Use:   name,
+		Short: "Execute the test command in the Dev mode",
+		Long: `odo test executes the test command of the Devfile once during the Dev mode ("odo dev" needs to be running), and reports whether the tests passed.
+
+With -o json, the output of the test command and its result are displayed as JSON events.`,
+		Example: fmt.Sprintf(testExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	testCmd.Flags().StringVar(&o.testCommandFlag, "test-command", "",
+		"Alternative test command to execute. The default one will be used if this flag is not set.")
+	clientset.Add(testCmd,
+		clientset.FILESYSTEM,
+		clientset.KUBERNETES_NULLABLE,
+		clientset.PODMAN_NULLABLE,
+		clientset.DEV,
+	)
+
+	odoutil.SetCommandGroup(testCmd, odoutil.MainGroup)
+	testCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(testCmd)
+	commonflags.UseOutputFlag(testCmd)
+	return testCmd
+}
@@ -1,11 +1,14 @@
 package ui
 
 import (
+	"errors"
+	"fmt"
 	"os"
 
-	"gopkg.in/AlecAivazis/survey.v1"
-	"gopkg.in/AlecAivazis/survey.v1/terminal"
+	"github.com/AlecAivazis/survey/v2/terminal"
 	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/prompt"
 )
 
 // HandleError handles UI-related errors, in particular useful to gracefully handle ctrl-c interrupts gracefully
@@ -19,20 +22,18 @@ func HandleError(err error) {
 	}
 }
 
-// Proceed displays a given message and asks the user if they want to proceed using the optionally specified Stdio instance (useful
-// for testing purposes)
-func Proceed(message string, stdio ...terminal.Stdio) bool {
-	var response bool
-	prompt := &survey.Confirm{
-		Message: message,
+// Proceed displays a given message and asks the user if they want to proceed.
+// An error is returned if the standard input is not a terminal, indicating to use the --force flag instead.
+func Proceed(message string) (bool, error) {
+	client, err := prompt.NewClient("")
+	if err != nil {
+		return false, err
 	}
-
-	if len(stdio) == 1 {
-		prompt.WithStdio(stdio[0])
+	response, err := client.Confirm(prompt.Question{Name: "proceed", Message: message}, false)
+	var noTerminalErr *prompt.NoTerminalError
+	if errors.As(err, &noTerminalErr) {
+		return false, fmt.Errorf("%w; use the --force flag to proceed without confirmation", err)
 	}
-
-	err := survey.AskOne(prompt, &response, survey.Required)
 	HandleError(err)
-
-	return response
+	return response, nil
 }
@@ -0,0 +1,168 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/devfile/validate/report"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// RecommendedCommandName is the recommended validate command name
+const RecommendedCommandName = "validate"
+
+var validateLongDesc = ktemplates.LongDesc(`
+	Validate a Devfile without running it.
+
+	The Devfile is parsed and checked for problems: unsupported schema version, invalid commands and groups,
+	endpoints collisions, unresolved variables, unreachable parent.
+	All the errors and warnings are reported with the line of the Devfile they relate to, when it can be determined.
+
+	The command exits with a non-zero status if errors are found (or warnings, with --strict),
+	so it can be used as a pre-commit hook.
+`)
+
+var validateExample = ktemplates.Examples(`
+	# Validate the Devfile of the component in the current directory
+	%[1]s
+
+	# Validate a specific Devfile, or the Devfile of a specific directory
+	%[1]s path/to/devfile.yaml
+	%[1]s path/to/component
+
+	# Fail if warnings are found
+	%[1]s --strict
+
+	# Get the problems in JSON format
+	%[1]s -o json
+`)
+
+// ValidateOptions encapsulates the options for the odo validate command
+type ValidateOptions struct {
+	// Flags
+	strictFlag bool
+
+	// devfilePath is the path of the Devfile to validate
+	devfilePath string
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*ValidateOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*ValidateOptions)(nil)
+
+// NewValidateOptions returns a new instance of ValidateOptions
+func NewValidateOptions() *ValidateOptions {
+	return &ValidateOptions{}
+}
+
+func (o *ValidateOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete computes the path of the Devfile to validate
+func (o *ValidateOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	// The FILESYSTEM dependency is not used, so an invalid Devfile is not parsed before the command runs
+	path := "."
+	if len(args) == 1 {
+		path = args[0]
+	}
+	if info, statErr := os.Stat(path); statErr == nil && info.IsDir() {
+		path = location.DevfileLocation(path)
+	}
+	o.devfilePath, err = dfutil.GetAbsPath(path)
+	return err
+}
+
+// Validate checks that the Devfile exists
+func (o *ValidateOptions) Validate(ctx context.Context) error {
+	if !util.CheckPathExists(o.devfilePath) {
+		return fmt.Errorf("no Devfile found at %s", o.devfilePath)
+	}
+	return nil
+}
+
+// Run contains the logic for the odo validate command
+func (o *ValidateOptions) Run(ctx context.Context) error {
+	result, err := report.ValidateFile(o.devfilePath)
+	if err != nil {
+		return err
+	}
+	printHumanReadableOutput(result)
+	err = o.resultError(result)
+	if err == nil {
+		log.Successf("The Devfile %s is valid", result.DevfilePath)
+	}
+	return err
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *ValidateOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	result, err := report.ValidateFile(o.devfilePath)
+	if err != nil {
+		return nil, err
+	}
+	if err = o.resultError(result); err != nil {
+		// The report is still output, and the error makes odo exit with a non-zero status
+		result.Valid = false
+	}
+	return result, err
+}
+
+// resultError returns an error if the Devfile must be considered invalid
+func (o *ValidateOptions) resultError(result api.DevfileValidation) error {
+	if result.HasErrors() {
+		return fmt.Errorf("the Devfile %s is not valid", result.DevfilePath)
+	}
+	if o.strictFlag && result.HasWarnings() {
+		return errors.New("warnings found in strict mode")
+	}
+	return nil
+}
+
+func printHumanReadableOutput(result api.DevfileValidation) {
+	name := filepath.Base(result.DevfilePath)
+	for _, problem := range result.Problems {
+		pos := name
+		if problem.Line > 0 {
+			pos = fmt.Sprintf("%s:%d", name, problem.Line)
+		}
+		log.Printf("%s: %s: %s", pos, problem.Severity, problem.Message)
+	}
+}
+
+// NewCmdValidate implements the odo validate command
+func NewCmdValidate(name, fullName string) *cobra.Command {
+	o := NewValidateOptions()
+	validateCmd := &cobra.Command{
+		Use:     name + " [DEVFILE|DIRECTORY]",
+		Short:   "Validate a Devfile",
+		Long:    validateLongDesc,
+		Example: fmt.Sprintf(validateExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	validateCmd.Flags().BoolVar(&o.strictFlag, "strict", false, "Consider the Devfile as invalid if warnings are found")
+	odoutil.SetCommandGroup(validateCmd, odoutil.UtilityGroup)
+	commonflags.UseOutputFlag(validateCmd)
+	validateCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return validateCmd
+}
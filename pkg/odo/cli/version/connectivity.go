@@ -0,0 +1,108 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/podman"
+
+	"k8s.io/klog"
+)
+
+// registryProbeTimeout is the timeout of the request checking that a Devfile registry is reachable
+const registryProbeTimeout = 5 * time.Second
+
+// getClusterConnectivity returns information about the cluster of the selected Kubernetes context.
+// If connectivity is not checked, nil is returned when the cluster is not reachable.
+func getClusterConnectivity(selection kclient.ConfigSelection, timeout time.Duration, checkConnectivity bool) *api.ClusterConnectivity {
+	client, err := kclient.NewForConfigSelection(selection, kclient.DefaultRetryPolicy)
+	if err != nil {
+		if !checkConnectivity {
+			return nil
+		}
+		return &api.ClusterConnectivity{Error: strings.TrimSpace(err.Error())}
+	}
+
+	var result api.ClusterConnectivity
+	if currentContext, err := client.GetCurrentContext(); err == nil {
+		result.Context = currentContext
+	}
+	if config, err := client.GetConfig().ClientConfig(); err == nil {
+		result.ServerURL = config.Host
+	}
+
+	serverInfo, err := client.GetServerVersion(timeout)
+	if err != nil {
+		klog.V(4).Info("unable to fetch the server version: ", err)
+		if !checkConnectivity {
+			return nil
+		}
+		result.Error = strings.TrimSpace(err.Error())
+		return &result
+	}
+	result.Reachable = true
+	result.ServerURL = serverInfo.Address
+	result.KubernetesVersion = serverInfo.KubernetesVersion
+	result.OpenShiftVersion = serverInfo.OpenShiftVersion
+	return &result
+}
+
+// getPodmanConnectivity returns information about the Podman client and, if Podman is used remotely, about the Podman service
+func getPodmanConnectivity(ctx context.Context) *api.PodmanConnectivity {
+	client, err := podman.NewPodmanCli(ctx)
+	if err != nil {
+		return &api.PodmanConnectivity{Error: err.Error()}
+	}
+	version, err := client.Version(ctx)
+	if err != nil {
+		return &api.PodmanConnectivity{Error: err.Error()}
+	}
+	result := api.PodmanConnectivity{
+		Reachable:     true,
+		ClientVersion: version.Client.Version,
+	}
+	if version.Server != nil {
+		result.ServerVersion = version.Server.Version
+	}
+	return &result
+}
+
+// getRegistriesConnectivity checks that the index of each Devfile registry can be fetched
+func getRegistriesConnectivity(ctx context.Context, registries []api.Registry) []api.RegistryConnectivity {
+	httpClient := &http.Client{Timeout: registryProbeTimeout}
+	result := make([]api.RegistryConnectivity, 0, len(registries))
+	for _, registry := range registries {
+		connectivity := api.RegistryConnectivity{
+			Name: registry.Name,
+			URL:  registry.URL,
+		}
+		if err := probeRegistry(ctx, httpClient, registry.URL); err != nil {
+			connectivity.Error = err.Error()
+		} else {
+			connectivity.Reachable = true
+		}
+		result = append(result, connectivity)
+	}
+	return result
+}
+
+func probeRegistry(ctx context.Context, httpClient *http.Client, registryURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(registryURL, "/")+"/index", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %q fetching the registry index", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package version
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_getRegistriesConnectivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/index" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	unreachable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	unreachableURL := unreachable.URL
+	unreachable.Close()
+
+	registries := []api.Registry{
+		{Name: "reachable", URL: server.URL},
+		{Name: "reachable-trailing-slash", URL: server.URL + "/"},
+		{Name: "not-found", URL: server.URL + "/not-a-registry"},
+		{Name: "unreachable", URL: unreachableURL},
+	}
+	got := getRegistriesConnectivity(context.Background(), registries)
+	want := []api.RegistryConnectivity{
+		{Name: "reachable", URL: server.URL, Reachable: true},
+		{Name: "reachable-trailing-slash", URL: server.URL + "/", Reachable: true},
+		{Name: "not-found", URL: server.URL + "/not-a-registry", Reachable: false},
+		{Name: "unreachable", URL: unreachableURL, Reachable: false},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(api.RegistryConnectivity{}, "Error")); diff != "" {
+		t.Errorf("getRegistriesConnectivity() mismatch (-want +got):\n%s", diff)
+	}
+	for _, c := range got {
+		if c.Reachable != (c.Error == "") {
+			t.Errorf("registry %q: error should be set only when not reachable, got %q", c.Name, c.Error)
+		}
+	}
+}
+
+func TestVersionOptions_Validate(t *testing.T) {
+	o := NewVersionOptions()
+	o.clientFlag = true
+	o.checkConnectivityFlag = true
+	if err := o.Validate(context.Background()); err == nil {
+		t.Error("expected an error when using --client and --check-connectivity together")
+	}
+}
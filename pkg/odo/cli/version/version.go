@@ -2,12 +2,15 @@ package version
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
-	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoversion "github.com/redhat-developer/odo/pkg/version"
@@ -29,21 +32,29 @@ var versionLongDesc = ktemplates.LongDesc("Print the client version information"
 
 var versionExample = ktemplates.Examples(`
 # Print the client version of odo
-%[1]s`,
+%[1]s
+
+# Print the version of odo and check the connectivity to the cluster, Podman and the Devfile registries
+%[1]s --check-connectivity -o json`,
 )
 
 // VersionOptions encapsulates all options for odo version command
 type VersionOptions struct {
 	// Flags
-	clientFlag bool
+	clientFlag            bool
+	checkConnectivityFlag bool
 
-	// serverInfo contains the remote server information if the user asked for it, nil otherwise
-	serverInfo *kclient.ServerInfo
+	// cluster contains the remote server information if the user asked for it, nil otherwise
+	cluster *api.ClusterConnectivity
+	// podman and registries contain the connectivity information if the user asked for it, nil otherwise
+	podman     *api.PodmanConnectivity
+	registries []api.RegistryConnectivity
 
 	clientset *clientset.Clientset
 }
 
 var _ genericclioptions.Runnable = (*VersionOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*VersionOptions)(nil)
 
 // NewVersionOptions creates a new VersionOptions instance
 func NewVersionOptions() *VersionOptions {
@@ -56,22 +67,23 @@ func (o *VersionOptions) SetClientset(clientset *clientset.Clientset) {
 
 // Complete completes VersionOptions after they have been created
 func (o *VersionOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
-	if !o.clientFlag {
-		// Let's fetch the info about the server, ignoring errors
-		client, err := kclient.New()
-
-		if err == nil {
-			o.serverInfo, err = client.GetServerVersion(o.clientset.PreferenceClient.GetTimeout())
-			if err != nil {
-				klog.V(4).Info("unable to fetch the server version: ", err)
-			}
-		}
+	if o.clientFlag {
+		return nil
+	}
+	// Let's fetch the info about the server, ignoring errors unless connectivity is checked
+	o.cluster = getClusterConnectivity(commonflags.GetKubeconfigSelection(), o.clientset.PreferenceClient.GetTimeout(), o.checkConnectivityFlag)
+	if o.checkConnectivityFlag {
+		o.podman = getPodmanConnectivity(ctx)
+		o.registries = getRegistriesConnectivity(ctx, o.clientset.PreferenceClient.RegistryList())
 	}
 	return nil
 }
 
 // Validate validates the VersionOptions based on completed values
 func (o *VersionOptions) Validate(ctx context.Context) (err error) {
+	if o.clientFlag && o.checkConnectivityFlag {
+		return errors.New("--client and --check-connectivity cannot be used together")
+	}
 	return nil
 }
 
@@ -85,26 +97,74 @@ func (o *VersionOptions) Run(ctx context.Context) (err error) {
 		}
 	}
 
-	fmt.Println("odo " + odoversion.VERSION + " (" + odoversion.GITCOMMIT + ")")
+	fmt.Fprintln(log.GetStdout(), "odo "+odoversion.VERSION+" ("+odoversion.GITCOMMIT+")")
 
-	if !o.clientFlag && o.serverInfo != nil {
+	if !o.clientFlag && o.cluster != nil && o.cluster.Reachable {
 		// make sure we only include OpenShift info if we actually have it
 		openshiftStr := ""
-		if len(o.serverInfo.OpenShiftVersion) > 0 {
-			openshiftStr = fmt.Sprintf("OpenShift: %v\n", o.serverInfo.OpenShiftVersion)
+		if len(o.cluster.OpenShiftVersion) > 0 {
+			openshiftStr = fmt.Sprintf("OpenShift: %v\n", o.cluster.OpenShiftVersion)
 		}
-		fmt.Printf("\n"+
+		fmt.Fprintf(log.GetStdout(), "\n"+
 			"Server: %v\n"+
 			"%v"+
 			"Kubernetes: %v\n",
-			o.serverInfo.Address,
+			o.cluster.ServerURL,
 			openshiftStr,
-			o.serverInfo.KubernetesVersion)
+			o.cluster.KubernetesVersion)
+	}
+
+	if o.checkConnectivityFlag {
+		printConnectivity(o.cluster, o.podman, o.registries)
 	}
 
 	return nil
 }
 
+// RunForJsonOutput returns the version information, and the connectivity information if requested
+func (o *VersionOptions) RunForJsonOutput(ctx context.Context) (result interface{}, err error) {
+	return api.VersionInfo{
+		Version:    odoversion.VERSION,
+		GitCommit:  odoversion.GITCOMMIT,
+		Cluster:    o.cluster,
+		Podman:     o.podman,
+		Registries: o.registries,
+	}, nil
+}
+
+func printConnectivity(cluster *api.ClusterConnectivity, podman *api.PodmanConnectivity, registries []api.RegistryConnectivity) {
+	log.Println()
+	log.Info("Connectivity:")
+	if cluster != nil {
+		name := "Cluster"
+		if cluster.Context != "" {
+			name = fmt.Sprintf("Cluster (context %q)", cluster.Context)
+		}
+		printReachability(name, cluster.ServerURL, cluster.Reachable, cluster.Error)
+	}
+	if podman != nil {
+		version := podman.ClientVersion
+		if podman.ServerVersion != "" {
+			version = fmt.Sprintf("client %s, server %s", podman.ClientVersion, podman.ServerVersion)
+		}
+		printReachability("Podman", version, podman.Reachable, podman.Error)
+	}
+	for _, registry := range registries {
+		printReachability(fmt.Sprintf("Registry %q", registry.Name), registry.URL, registry.Reachable, registry.Error)
+	}
+}
+
+func printReachability(name string, details string, reachable bool, errMsg string) {
+	if details != "" {
+		name = fmt.Sprintf("%s: %s", name, details)
+	}
+	if reachable {
+		log.Successf("%s", name)
+		return
+	}
+	log.Warningf("%s is not reachable: %s", name, errMsg)
+}
+
 // NewCmdVersion implements the version odo command
 func NewCmdVersion(name, fullName string) *cobra.Command {
 	o := NewVersionOptions()
@@ -119,10 +179,13 @@ func NewCmdVersion(name, fullName string) *cobra.Command {
 		},
 	}
 	clientset.Add(versionCmd, clientset.PREFERENCE)
+	commonflags.UseKubeconfigFlags(versionCmd)
 	util.SetCommandGroup(versionCmd, util.UtilityGroup)
+	commonflags.UseOutputFlag(versionCmd)
 
 	versionCmd.SetUsageTemplate(util.CmdUsageTemplate)
 	versionCmd.Flags().BoolVar(&o.clientFlag, "client", false, "Client version only (no server required).")
+	versionCmd.Flags().BoolVar(&o.checkConnectivityFlag, "check-connectivity", false, "Check the connectivity to the cluster, Podman and the Devfile registries.")
 
 	return versionCmd
 }
@@ -0,0 +1,63 @@
+package commonflags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+const (
+	// KubeconfigFlagName is the name of the flag allowing user to specify the kubeconfig file to use
+	KubeconfigFlagName = "kubeconfig"
+	// ContextFlagName is the name of the flag allowing user to specify the kubeconfig context to use
+	ContextFlagName = "context"
+)
+
+// UseKubeconfigFlags indicates that a command accepts the --kubeconfig and --context flags
+func UseKubeconfigFlags(cmd *cobra.Command) {
+	UseKubeconfigFlag(cmd)
+	cmd.Annotations[ContextFlagName] = "true"
+}
+
+// UseKubeconfigFlag indicates that a command accepts the --kubeconfig flag, but not the --context flag
+func UseKubeconfigFlag(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[KubeconfigFlagName] = "true"
+}
+
+// AddKubeconfigFlags adds the --kubeconfig and --context flags to all commands
+// We use "flag" in order to make this accessible throughtout ALL of odo, rather than the
+// above traditional "persistentflags" usage that does not make it a pointer within the 'pflag'
+// package
+func AddKubeconfigFlags() {
+	pflag.CommandLine.String(KubeconfigFlagName, "", "Path to the kubeconfig file to use, instead of the KUBECONFIG environment variable and ~/.kube/config")
+	pflag.CommandLine.String(ContextFlagName, "", "Name of the kubeconfig context to use, instead of the current context")
+}
+
+// CheckKubeconfigCommand checks if commands enabling --kubeconfig and --context flags are used correctly
+func CheckKubeconfigCommand(cmd *cobra.Command) error {
+	for _, name := range []string{KubeconfigFlagName, ContextFlagName} {
+		f := pflag.Lookup(name)
+		if f != nil && f.Changed && cmd.Annotations[name] != "true" {
+			return fmt.Errorf("--%s flag is not supported for this command", name)
+		}
+	}
+	return nil
+}
+
+// GetKubeconfigSelection returns the kubeconfig file and context selected with the --kubeconfig and --context flags
+func GetKubeconfigSelection() kclient.ConfigSelection {
+	var selection kclient.ConfigSelection
+	if f := pflag.Lookup(KubeconfigFlagName); f != nil {
+		selection.Kubeconfig = f.Value.String()
+	}
+	if f := pflag.Lookup(ContextFlagName); f != nil {
+		selection.Context = f.Value.String()
+	}
+	return selection
+}
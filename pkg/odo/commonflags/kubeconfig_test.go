@@ -0,0 +1,47 @@
+package commonflags
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func TestUseKubeconfigFlags(t *testing.T) {
+	defer func() {
+		_ = pflag.CommandLine.Set(KubeconfigFlagName, "")
+		_ = pflag.CommandLine.Set(ContextFlagName, "")
+		pflag.Lookup(KubeconfigFlagName).Changed = false
+		pflag.Lookup(ContextFlagName).Changed = false
+	}()
+
+	if err := pflag.CommandLine.Set(KubeconfigFlagName, "/path/to/kubeconfig"); err != nil {
+		t.Fatalf("Set error should be nil but is %v", err)
+	}
+	if err := pflag.CommandLine.Set(ContextFlagName, "my-context"); err != nil {
+		t.Fatalf("Set error should be nil but is %v", err)
+	}
+
+	cmd := &cobra.Command{}
+	UseKubeconfigFlags(cmd)
+	if err := CheckKubeconfigCommand(cmd); err != nil {
+		t.Errorf("Check error should be nil but is %v", err)
+	}
+
+	cmd = &cobra.Command{}
+	UseKubeconfigFlag(cmd)
+	err := CheckKubeconfigCommand(cmd)
+	if err == nil || err.Error() != "--context flag is not supported for this command" {
+		t.Errorf("Check error is %v", err)
+	}
+
+	err = CheckKubeconfigCommand(&cobra.Command{})
+	if err == nil || err.Error() != "--kubeconfig flag is not supported for this command" {
+		t.Errorf("Check error is %v", err)
+	}
+
+	selection := GetKubeconfigSelection()
+	if selection.Kubeconfig != "/path/to/kubeconfig" || selection.Context != "my-context" {
+		t.Errorf("GetKubeconfigSelection() = %+v", selection)
+	}
+}
@@ -20,6 +20,7 @@ func TestMain(m *testing.M) {
 	klog.InitFlags(nil)
 	AddOutputFlag()
 	AddPlatformFlag(ctx)
+	AddKubeconfigFlags()
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 
 	os.Exit(m.Run())
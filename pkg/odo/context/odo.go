@@ -13,6 +13,8 @@ type (
 	devfilePathKeyType         struct{}
 	effectiveDevfileObjKeyType struct{}
 	componentNameKeyType       struct{}
+	extraLabelsKeyType         struct{}
+	extraAnnotationsKeyType    struct{}
 )
 
 var (
@@ -22,6 +24,8 @@ var (
 	devfilePathKey         devfilePathKeyType
 	effectiveDevfileObjKey effectiveDevfileObjKeyType
 	componentNameKey       componentNameKeyType
+	extraLabelsKey         extraLabelsKeyType
+	extraAnnotationsKey    extraAnnotationsKeyType
 )
 
 // WithApplication sets the value of the application in ctx
@@ -129,3 +133,27 @@ func GetComponentName(ctx context.Context) string {
 	}
 	panic("this should not happen, either the original context is not passed or WithComponentName is not called as it should. Check that FILESYSTEM dependency is added to the command")
 }
+
+// WithExtraLabels sets in ctx the labels to add to all the resources created by odo, defined in the preferences
+func WithExtraLabels(ctx context.Context, val map[string]string) context.Context {
+	return context.WithValue(ctx, extraLabelsKey, val)
+}
+
+// GetExtraLabels gets the labels to add to all the resources created by odo, defined in the preferences
+// This function returns nil if the context does not contain the value
+func GetExtraLabels(ctx context.Context) map[string]string {
+	value, _ := ctx.Value(extraLabelsKey).(map[string]string)
+	return value
+}
+
+// WithExtraAnnotations sets in ctx the annotations to add to all the resources created by odo, defined in the preferences
+func WithExtraAnnotations(ctx context.Context, val map[string]string) context.Context {
+	return context.WithValue(ctx, extraAnnotationsKey, val)
+}
+
+// GetExtraAnnotations gets the annotations to add to all the resources created by odo, defined in the preferences
+// This function returns nil if the context does not contain the value
+func GetExtraAnnotations(ctx context.Context) map[string]string {
+	value, _ := ctx.Value(extraAnnotationsKey).(map[string]string)
+	return value
+}
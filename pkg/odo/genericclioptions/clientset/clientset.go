@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
 
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev"
 	"github.com/redhat-developer/odo/pkg/dev/podmandev"
@@ -37,7 +38,9 @@ import (
 	_init "github.com/redhat-developer/odo/pkg/init"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/preflight"
 	"github.com/redhat-developer/odo/pkg/project"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	"github.com/redhat-developer/odo/pkg/registry"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/watch"
@@ -76,8 +79,12 @@ const (
 	PORT_FORWARD = "PORT_FORWARD"
 	// PREFERENCE instantiates client for pkg/preference
 	PREFERENCE = "DEP_PREFERENCE"
+	// PREFLIGHT instantiates client for pkg/preflight
+	PREFLIGHT = "DEP_PREFLIGHT"
 	// PROJECT instantiates client for pkg/project
 	PROJECT = "DEP_PROJECT"
+	// PROMPT instantiates client for pkg/prompt
+	PROMPT = "DEP_PROMPT"
 	// REGISTRY instantiates client for pkg/registry
 	REGISTRY = "DEP_REGISTRY"
 	// STATE instantiates client for pkg/state
@@ -111,15 +118,16 @@ var subdeps map[string][]string = map[string][]string{
 		WATCH,
 	},
 	EXEC:         {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
-	INIT:         {ALIZER, FILESYSTEM, PREFERENCE, REGISTRY},
+	INIT:         {ALIZER, FILESYSTEM, PREFERENCE, PROMPT, REGISTRY},
 	LOGS:         {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
 	PORT_FORWARD: {KUBERNETES_NULLABLE, EXEC, STATE},
+	PREFLIGHT:    {FILESYSTEM, PREFERENCE},
 	PROJECT:      {KUBERNETES},
 	REGISTRY:     {FILESYSTEM, PREFERENCE, KUBERNETES_NULLABLE},
 	STATE:        {FILESYSTEM},
 	SYNC:         {EXEC},
-	WATCH:        {KUBERNETES_NULLABLE},
-	BINDING:      {PROJECT, KUBERNETES_NULLABLE},
+	WATCH:        {FILESYSTEM, KUBERNETES_NULLABLE, PREFERENCE, STATE},
+	BINDING:      {PROJECT, PROMPT, KUBERNETES_NULLABLE},
 	/* Add sub-dependencies here, if any */
 }
 
@@ -138,7 +146,9 @@ type Clientset struct {
 	PodmanClient          podman.Client
 	PortForwardClient     portForward.Client
 	PreferenceClient      preference.Client
+	PreflightClient       preflight.Client
 	ProjectClient         project.Client
+	PromptClient          prompt.Client
 	RegistryClient        registry.Client
 	StateClient           state.Client
 	SyncClient            sync.Client
@@ -155,6 +165,9 @@ func Add(command *cobra.Command, dependencies ...string) {
 		// prevent infinite loop with circular dependencies
 		if !ok {
 			command.Annotations[dependency] = "true"
+			if dependency == KUBERNETES || dependency == KUBERNETES_NULLABLE {
+				commonflags.UseKubeconfigFlags(command)
+			}
 			Add(command, subdeps[dependency]...)
 		}
 	}
@@ -177,7 +190,11 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		dep.FS = filesystem.DefaultFs{}
 	}
 	if isDefined(command, KUBERNETES) || isDefined(command, KUBERNETES_NULLABLE) {
-		dep.KubernetesClient, err = kclient.New()
+		envConfig := envcontext.GetEnvConfig(ctx)
+		dep.KubernetesClient, err = kclient.NewForConfigSelection(commonflags.GetKubeconfigSelection(), kclient.RetryPolicy{
+			Retries: envConfig.OdoClusterAPIRetries,
+			Backoff: envConfig.OdoClusterAPIRetryBackoff,
+		})
 		if err != nil {
 			// only return error is KUBERNETES_NULLABLE is not defined in combination with KUBERNETES
 			if isDefined(command, KUBERNETES) && !isDefined(command, KUBERNETES_NULLABLE) {
@@ -205,6 +222,16 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 			return nil, err
 		}
 	}
+	if isDefined(command, PROMPT) {
+		var answersFile string
+		if flag := command.Flags().Lookup(prompt.AnswersFileFlagName); flag != nil {
+			answersFile = flag.Value.String()
+		}
+		dep.PromptClient, err = prompt.NewClient(answersFile)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if isDefined(command, REGISTRY) {
 		dep.RegistryClient = registry.NewRegistryClient(dep.FS, dep.PreferenceClient, dep.KubernetesClient)
 	}
@@ -236,7 +263,7 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient, dep.ConfigAutomountClient, dep.FS)
 	}
 	if isDefined(command, INIT) {
-		dep.InitClient = _init.NewInitClient(dep.FS, dep.PreferenceClient, dep.RegistryClient, dep.AlizerClient)
+		dep.InitClient = _init.NewInitClient(dep.FS, dep.PreferenceClient, dep.RegistryClient, dep.AlizerClient, dep.PromptClient)
 	}
 	if isDefined(command, LOGS) {
 		switch platform {
@@ -246,6 +273,9 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 			dep.LogsClient = logs.NewLogsClient(dep.KubernetesClient)
 		}
 	}
+	if isDefined(command, PREFLIGHT) {
+		dep.PreflightClient = preflight.NewPreflightClient(dep.KubernetesClient, dep.PreferenceClient, dep.FS)
+	}
 	if isDefined(command, PROJECT) {
 		dep.ProjectClient = project.NewClient(dep.KubernetesClient)
 	}
@@ -261,10 +291,10 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		}
 	}
 	if isDefined(command, WATCH) {
-		dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient)
+		dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient, dep.StateClient, dep.PreferenceClient, dep.FS)
 	}
 	if isDefined(command, BINDING) {
-		dep.BindingClient = binding.NewBindingClient(dep.ProjectClient, dep.KubernetesClient)
+		dep.BindingClient = binding.NewBindingClient(dep.ProjectClient, dep.KubernetesClient, dep.PromptClient)
 	}
 	if isDefined(command, PORT_FORWARD) {
 		switch platform {
@@ -299,6 +329,7 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 				dep.ExecClient,
 				dep.DeleteClient,
 				dep.ConfigAutomountClient,
+				dep.StateClient,
 			)
 		}
 	}
@@ -36,6 +36,11 @@ Visit https://odo.dev for more information.`
 	return message
 }
 
+// ErrorCode returns the code identifying the error in telemetry
+func (o NoDevfileError) ErrorCode() string {
+	return "no-devfile"
+}
+
 func IsNoDevfileError(err error) bool {
 	_, ok := err.(NoDevfileError)
 	return ok
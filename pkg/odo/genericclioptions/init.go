@@ -2,17 +2,22 @@ package genericclioptions
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path/filepath"
 
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/init/backend"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/files"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 	"github.com/redhat-developer/odo/pkg/version"
 )
@@ -33,8 +38,8 @@ func runPreInit(ctx context.Context, workingDir string, deps *clientset.Clientse
 		func(interactiveMode bool) {
 			scontext.SetInteractive(cmdline.Context(), interactiveMode)
 			if interactiveMode {
-				log.Title(msg, messages.SourceCodeDetected, "odo version: "+version.VERSION)
-				log.Info("\n" + messages.InteractiveModeEnabled)
+				log.Title(msg, i18n.T(messages.SourceCodeDetected), fmt.Sprintf(i18n.T("odo version: %s"), version.VERSION))
+				log.Info("\n" + i18n.T(messages.InteractiveModeEnabled))
 			}
 		},
 		func(newDevfileObj parser.DevfileObj) error {
@@ -48,8 +53,9 @@ func runPreInit(ctx context.Context, workingDir string, deps *clientset.Clientse
 			}
 			return nil
 		})
-	if err != nil {
-		return err
+	var noTerminalErr *prompt.NoTerminalError
+	if errors.As(err, &noTerminalErr) {
+		return fmt.Errorf("%w\nrun \"odo init\" first, in a terminal or with the --%s and --%s flags", err, backend.FLAG_NAME, backend.FLAG_DEVFILE)
 	}
-	return nil
+	return err
 }
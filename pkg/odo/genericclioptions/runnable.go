@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
-	"gopkg.in/AlecAivazis/survey.v1/terminal"
+	"github.com/AlecAivazis/survey/v2/terminal"
 
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 
@@ -23,8 +24,6 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	commonutil "github.com/redhat-developer/odo/pkg/util"
 
-	"gopkg.in/AlecAivazis/survey.v1"
-
 	"github.com/redhat-developer/odo/pkg/odo/cli/feature"
 	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 
@@ -35,11 +34,14 @@ import (
 	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/prompt"
 	"github.com/redhat-developer/odo/pkg/segment"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 
 	"github.com/spf13/cobra"
 
+	"github.com/redhat-developer/odo/pkg/i18n"
+	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 )
 
@@ -69,6 +71,8 @@ type PreIniter interface {
 // For these commands, the `-o json` flag will be added
 // when err is not nil, the text of the error will be returned in a `message` field on stderr with an exit status of 1
 // when err is nil, the result of RunForJsonOutput will be returned in JSON format on stdout with an exit status of 0
+// when both err and the result are not nil, the result is also returned in JSON format on stdout (e.g. a report
+// explaining the error), and the text of the error on stderr with an exit status of 1
 type JsonOutputter interface {
 	RunForJsonOutput(ctx context.Context) (result interface{}, err error)
 }
@@ -95,6 +99,20 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 
 	userConfig, _ := preference.NewClient(ctx)
 	envConfig := envcontext.GetEnvConfig(ctx)
+	i18n.SetLanguage(i18n.GetLanguage(userConfig.GetLocale(), envConfig))
+
+	if logFilePath := getLogFilePath(cmd, userConfig); logFilePath != "" {
+		if err1 := log.SetLogFile(logFilePath); err1 != nil {
+			log.Warningf("Unable to copy the output to %s: %v", logFilePath, err1)
+		} else {
+			defer func() {
+				if err1 := log.CloseLogFile(); err1 != nil {
+					klog.V(4).Infof("Failed to close the log file: %v", err1)
+				}
+			}()
+			klog.V(4).Infof("Running %q", strings.Join(os.Args, " "))
+		}
+	}
 
 	//lint:ignore SA1019 We deprecated this env var, but until it is removed, we still need to support it
 	disableTelemetryEnvSet := envConfig.OdoDisableTelemetry != nil
@@ -141,8 +159,10 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 			}
 			if askConsent {
 				var consentTelemetry bool
-				prompt := &survey.Confirm{Message: "Help odo improve by allowing it to collect usage data. Read about our privacy statement: https://developers.redhat.com/article/tool-data-collection. You can change your preference later by changing the ConsentTelemetry preference.", Default: true}
-				err = survey.AskOne(prompt, &consentTelemetry, nil)
+				consentTelemetry, err = prompt.NewSurveyClient().Confirm(prompt.Question{
+					Name:    "consentTelemetry",
+					Message: "Help odo improve by allowing it to collect usage data. Read about our privacy statement: https://developers.redhat.com/article/tool-data-collection. You can change your preference later by changing the ConsentTelemetry preference.",
+				}, true)
 				ui.HandleError(err)
 				if err == nil {
 					if err1 := userConfig.SetConfiguration(preference.ConsentTelemetrySetting, strconv.FormatBool(consentTelemetry)); err1 != nil {
@@ -195,6 +215,10 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	err = commonflags.CheckKubeconfigCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	cmdLineObj := cmdline.NewCobra(cmd)
 	platform := commonflags.GetPlatformValue(cmdLineObj)
@@ -214,6 +238,8 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 		ctx = fcontext.WithPlatform(ctx, platform)
 	}
 	ctx = odocontext.WithApplication(ctx, defaultAppName)
+	ctx = odocontext.WithExtraLabels(ctx, userConfig.GetExtraLabels())
+	ctx = odocontext.WithExtraAnnotations(ctx, userConfig.GetExtraAnnotations())
 
 	if deps.KubernetesClient != nil {
 		namespace := deps.KubernetesClient.GetCurrentNamespace()
@@ -275,11 +301,16 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 	if jsonOutputter, ok := o.(JsonOutputter); ok && log.IsJSON() {
 		var out interface{}
 		out, err = jsonOutputter.RunForJsonOutput(ctx)
-		if err == nil {
+		if err == nil || out != nil {
 			machineoutput.OutputSuccess(out)
 		}
 	} else {
 		err = o.Run(ctx)
+		if deps.KubernetesClient != nil {
+			if warning := kclient.GetRetriedErrorsWarning(deps.KubernetesClient.PopRetriedErrors()); warning != "" {
+				log.Warning(warning)
+			}
+		}
 	}
 	startTelemetry(cmd, err, startTime)
 	if cleanuper, ok := o.(Cleanuper); ok {
@@ -288,6 +319,24 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 	return err
 }
 
+// getLogFilePath returns the path of the file to which the output of odo is copied, in the working directory,
+// or an empty string if neither the --log-file flag nor the LogFile preference are set.
+// The output of the telemetry command, run in the background after each command, is never copied.
+func getLogFilePath(cmd *cobra.Command, userConfig preference.Client) string {
+	if !log.IsLogFileFlagSet() && (userConfig == nil || !userConfig.GetLogFile()) {
+		return ""
+	}
+	if cmd.Name() == "telemetry" && cmd.Parent() == cmd.Root() {
+		return ""
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		klog.V(4).Infof("Unable to get the working directory: %v", err)
+		return ""
+	}
+	return filepath.Join(cwd, commonutil.DotOdoDirectory, "logs", "odo.log")
+}
+
 // startTelemetry uploads the data to segment if user has consented to usage data collection and the command is not telemetry
 // TODO: move this function to a more suitable place, preferably pkg/segment
 func startTelemetry(cmd *cobra.Command, err error, startTime time.Time) {
@@ -306,19 +355,22 @@ func startTelemetry(cmd *cobra.Command, err error, startTime time.Time) {
 		return
 	}
 	scontext.SetTelemetryStatus(ctx, isTelemetryEnabled)
+	duration := time.Since(startTime)
 	uploadData := &segment.TelemetryData{
 		Event: cmd.CommandPath(),
 		Properties: segment.TelemetryProperties{
-			Duration:      time.Since(startTime).Milliseconds(),
-			Success:       err == nil,
-			Tty:           segment.RunningInTerminal(),
-			Version:       fmt.Sprintf("odo %v (%v)", version.VERSION, version.GITCOMMIT),
-			CmdProperties: scontext.GetContextProperties(ctx),
+			Duration:       duration.Milliseconds(),
+			DurationBucket: segment.DurationBucket(duration),
+			Success:        err == nil,
+			Tty:            segment.RunningInTerminal(),
+			Version:        fmt.Sprintf("odo %v (%v)", version.VERSION, version.GITCOMMIT),
+			CmdProperties:  scontext.GetContextProperties(ctx),
 		},
 	}
 	if err != nil {
 		uploadData.Properties.Error = segment.SetError(err)
 		uploadData.Properties.ErrorType = segment.ErrorType(err)
+		uploadData.Properties.ErrorCode = segment.ErrorCode(err)
 	}
 	data, err1 := json.Marshal(uploadData)
 	if err1 != nil {
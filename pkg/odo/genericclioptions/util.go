@@ -1,7 +1,6 @@
 package genericclioptions
 
 import (
-	"fmt"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 	pkgUtil "github.com/redhat-developer/odo/pkg/util"
@@ -46,7 +45,7 @@ func WarnIfDefaultNamespace(namespace string, kubeClient kclient.ClientInterface
 		if isOC, _ := kubeClient.IsProjectSupported(); isOC {
 			noun = "project"
 		}
-		fmt.Println()
+		log.Println()
 		log.Warningf("You are using \"default\" %[1]s, odo may not work as expected in the default %[1]s.", noun)
 		log.Warningf("You may set a new %[1]s by running `odo create %[1]s <name>`, or set an existing one by running `odo set %[1]s <name>`", noun)
 	}
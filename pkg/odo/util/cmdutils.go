@@ -1,10 +1,12 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/spf13/cobra"
@@ -43,7 +45,8 @@ func LogError(err error, context string) {
 	}
 }
 
-// LogErrorAndExit prints the given error and exits the code with an exit code of 1.
+// LogErrorAndExit prints the given error and exits the code with an exit code of 1,
+// or with the exit code of the command executed in a container if the error was caused by it.
 // If the context is provided, then that is printed alongside the error.
 // *If* we are using the global json parameter, we instead output the json output
 func LogErrorAndExit(err error, context string) {
@@ -51,7 +54,10 @@ func LogErrorAndExit(err error, context string) {
 	LogError(err, context)
 
 	if err != nil {
-		// Always exit 1 anyways
+		var exitCodeErr *exec.ExitCodeError
+		if errors.As(err, &exitCodeErr) && exitCodeErr.ExitCode() > 0 {
+			os.Exit(exitCodeErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,221 @@
+package completion
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/spf13/cobra"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/devfile/profile"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/registry"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// The functions of this file are used as ValidArgsFunction of commands or registered with RegisterFlagCompletionFunc,
+// to dynamically complete arguments and flags values from the shell completion generated by `odo completion`.
+// They are executed without running the command, so they fetch the clients they need themselves.
+// Errors are not displayed to the user, as they would break the completion, they are only logged.
+
+const (
+	// RegistryFlagName is the name of the flag used to restrict the Devfile names completed by DevfileNames to a registry
+	RegistryFlagName = "devfile-registry"
+	// NamespaceFlagName is the name of the flag used to select the namespace of the components completed by ComponentNames
+	NamespaceFlagName = "namespace"
+)
+
+// DevfileNames completes the names of the Devfile stacks available in the registries,
+// restricted to the registry passed with the --devfile-registry flag, if any
+func DevfileNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	deps, err := fetchClientset(cmd, clientset.REGISTRY)
+	if err != nil {
+		klog.V(4).Infof("unable to complete Devfile names: %v", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var registryName string
+	if f := cmd.Flags().Lookup(RegistryFlagName); f != nil {
+		registryName = f.Value.String()
+	}
+	return devfileNames(cmd.Context(), deps.RegistryClient, registryName), cobra.ShellCompDirectiveNoFileComp
+}
+
+func devfileNames(ctx context.Context, registryClient registry.Client, registryName string) []string {
+	stacks, err := registryClient.ListDevfileStacks(ctx, registryName, "", "", false, false)
+	if err != nil {
+		klog.V(4).Infof("unable to list Devfile stacks: %v", err)
+		return nil
+	}
+	names := make([]string, 0, len(stacks.Items))
+	for _, stack := range stacks.Items {
+		names = append(names, stack.Name)
+	}
+	return sortedUnique(names)
+}
+
+// RegistryNames completes the names of the Devfile registries
+func RegistryNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	deps, err := fetchClientset(cmd, clientset.REGISTRY)
+	if err != nil {
+		klog.V(4).Infof("unable to complete registry names: %v", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return registryNames(deps.RegistryClient), cobra.ShellCompDirectiveNoFileComp
+}
+
+func registryNames(registryClient registry.Client) []string {
+	registries, err := registryClient.GetDevfileRegistries("")
+	if err != nil {
+		klog.V(4).Infof("unable to list Devfile registries: %v", err)
+		return nil
+	}
+	names := make([]string, 0, len(registries))
+	for _, reg := range registries {
+		names = append(names, reg.Name)
+	}
+	return sortedUnique(names)
+}
+
+// PreferenceRegistryNames completes the name of one of the Devfile registries configured in the preferences
+func PreferenceRegistryNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		// only one registry can be passed
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	deps, err := fetchClientset(cmd, clientset.PREFERENCE)
+	if err != nil {
+		klog.V(4).Infof("unable to complete registry names: %v", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	registries := deps.PreferenceClient.RegistryList()
+	names := make([]string, 0, len(registries))
+	for _, reg := range registries {
+		names = append(names, reg.Name)
+	}
+	return sortedUnique(names), cobra.ShellCompDirectiveNoFileComp
+}
+
+// ComponentNames completes the names of the components running on the cluster, in the namespace
+// passed with the --namespace flag if any, or in the current namespace
+func ComponentNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	deps, err := fetchClientset(cmd, clientset.KUBERNETES)
+	if err != nil {
+		klog.V(4).Infof("unable to complete component names: %v", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	namespace := deps.KubernetesClient.GetCurrentNamespace()
+	if f := cmd.Flags().Lookup(NamespaceFlagName); f != nil && f.Value.String() != "" {
+		namespace = f.Value.String()
+	}
+	return componentNames(deps.KubernetesClient, namespace), cobra.ShellCompDirectiveNoFileComp
+}
+
+func componentNames(kubeClient kclient.ClientInterface, namespace string) []string {
+	components, err := component.ListAllClusterComponents(kubeClient, namespace)
+	if err != nil {
+		klog.V(4).Infof("unable to list components: %v", err)
+		return nil
+	}
+	names := make([]string, 0, len(components))
+	for _, comp := range components {
+		names = append(names, comp.Name)
+	}
+	return sortedUnique(names)
+}
+
+// DevfileCommandNames completes the names of the commands defined in the Devfile of the current directory
+func DevfileCommandNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		// only one command can be passed
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	devfileObj, directive := parseCurrentDevfile()
+	if devfileObj == nil {
+		return nil, directive
+	}
+	return devfileCommandNames(*devfileObj), cobra.ShellCompDirectiveNoFileComp
+}
+
+// DevfileProfileNames completes the names of the profiles defined in the Devfile of the current directory
+func DevfileProfileNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		// only one profile can be passed
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	devfileObj, directive := parseCurrentDevfile()
+	if devfileObj == nil {
+		return nil, directive
+	}
+	profiles, err := profile.List(*devfileObj)
+	if err != nil {
+		klog.V(4).Infof("unable to get Devfile profiles: %v", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// parseCurrentDevfile parses the Devfile of the current directory. If the Devfile cannot be parsed,
+// nil is returned with the directive to return for the completion.
+func parseCurrentDevfile() (*parser.DevfileObj, cobra.ShellCompDirective) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		klog.V(4).Infof("unable to get the current directory: %v", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	devfilePath := location.DevfileLocation(cwd)
+	if !util.CheckPathExists(devfilePath) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		klog.V(4).Infof("unable to parse Devfile %s: %v", devfilePath, err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return &devfileObj, cobra.ShellCompDirectiveNoFileComp
+}
+
+func devfileCommandNames(devfileObj parser.DevfileObj) []string {
+	commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{})
+	if err != nil {
+		klog.V(4).Infof("unable to get Devfile commands: %v", err)
+		return nil
+	}
+	names := make([]string, 0, len(commands))
+	for _, command := range commands {
+		names = append(names, command.Id)
+	}
+	return sortedUnique(names)
+}
+
+// fetchClientset returns the clients for the dependencies, independently of the dependencies declared by the command
+func fetchClientset(cmd *cobra.Command, dependencies ...string) (*clientset.Clientset, error) {
+	depsCmd := &cobra.Command{}
+	depsCmd.SetContext(cmd.Context())
+	clientset.Add(depsCmd, dependencies...)
+	return clientset.Fetch(depsCmd, "")
+}
+
+func sortedUnique(values []string) []string {
+	set := map[string]struct{}{}
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, found := set[v]; found {
+			continue
+		}
+		set[v] = struct{}{}
+		result = append(result, v)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,79 @@
+package completion
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/registry"
+)
+
+func Test_devfileNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registryClient := registry.NewMockClient(ctrl)
+	registryClient.EXPECT().ListDevfileStacks(gomock.Any(), "MyRegistry", "", "", false, false).Return(registry.DevfileStackList{
+		Items: []api.DevfileStack{
+			{Name: "nodejs", Registry: api.Registry{Name: "MyRegistry"}},
+			{Name: "go", Registry: api.Registry{Name: "MyRegistry"}},
+			{Name: "nodejs", Registry: api.Registry{Name: "MyRegistry"}},
+		},
+	}, nil)
+
+	got := devfileNames(context.Background(), registryClient, "MyRegistry")
+	if diff := cmp.Diff([]string{"go", "nodejs"}, got); diff != "" {
+		t.Errorf("devfileNames() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_registryNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registryClient := registry.NewMockClient(ctrl)
+	registryClient.EXPECT().GetDevfileRegistries("").Return([]api.Registry{
+		{Name: "StagingRegistry"},
+		{Name: "DefaultDevfileRegistry"},
+	}, nil)
+
+	got := registryNames(registryClient)
+	if diff := cmp.Diff([]string{"DefaultDevfileRegistry", "StagingRegistry"}, got); diff != "" {
+		t.Errorf("registryNames() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_devfileCommandNames(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	err := os.WriteFile(devfilePath, []byte(`schemaVersion: 2.2.0
+metadata:
+  name: my-component
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := devfileCommandNames(devfileObj)
+	if diff := cmp.Diff([]string{"install", "run"}, got); diff != "" {
+		t.Errorf("devfileCommandNames() mismatch (-want +got):\n%s", diff)
+	}
+}
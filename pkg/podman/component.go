@@ -78,6 +78,8 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 			ManagedBy:        managedBy,
 			Type:             componentType,
 			ManagedByVersion: managedByVersion,
+			Language:         odolabels.GetLanguage(labels, nil),
+			ManagerType:      odolabels.GetManagerType(labels, nil),
 			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
 			RunningOn: commonflags.PlatformPodman,
 			Platform:  commonflags.PlatformPodman,
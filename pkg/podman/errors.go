@@ -19,3 +19,8 @@ func (o PodmanNotFoundError) Error() string {
 	}
 	return fmt.Errorf("%s cause: %w", msg, o.err).Error()
 }
+
+// ErrorCode returns the code identifying the error in telemetry
+func (o PodmanNotFoundError) ErrorCode() string {
+	return "podman-not-found"
+}
@@ -25,11 +25,8 @@ func (o *PodmanCli) ExecCMDInContainer(ctx context.Context, containerName, podNa
 	command := exec.CommandContext(ctx, o.podmanCmd, append(o.containerRunGlobalExtraArgs, args...)...)
 	klog.V(3).Infof("executing %v", command.Args)
 	command.Stdin = stdin
+	command.Stdout = stdout
+	command.Stderr = stderr
 
-	out, err := command.Output()
-	if err != nil {
-		return err
-	}
-	_, err = stdout.Write(out)
-	return err
+	return command.Run()
 }
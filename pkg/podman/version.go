@@ -26,6 +26,8 @@ type Version struct {
 
 type SystemVersionReport struct {
 	Client *Version `json:",omitempty"`
+	// Server is set when the client connects to a remote Podman service
+	Server *Version `json:",omitempty"`
 }
 
 // Version returns the version of the Podman client.
@@ -3,6 +3,7 @@ package portForward
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
@@ -33,4 +34,15 @@ type Client interface {
 
 	// GetForwardedPorts returns the list of ports for each container currently forwarded.
 	GetForwardedPorts() map[string][]v1alpha2.Endpoint
+
+	// GetForwardedLocalPorts returns the ports currently forwarded, with the local address and port they are forwarded to.
+	GetForwardedLocalPorts() []api.ForwardedPort
+
+	// SetForwardedPortsHandler sets a handler called with the forwarded local ports
+	// each time the port forwarding is established, including after a lost connection to the pod.
+	SetForwardedPortsHandler(handler func(ports []api.ForwardedPort))
+
+	// GetLastConnectionTime returns the time at which the last connection to a forwarded port has been accepted,
+	// or the zero time if no connection has been accepted yet.
+	GetLastConnectionTime() time.Time
 }
@@ -7,6 +7,7 @@ import (
 	"io"
 	"reflect"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -32,6 +33,16 @@ type PFClient struct {
 
 	appliedEndpoints map[string][]v1alpha2.Endpoint
 
+	// forwardedPorts are the ports forwarded by the last port forwarding setup
+	forwardedPorts   []api.ForwardedPort
+	forwardedPortsMu sync.Mutex
+	// forwardedPortsHandler is called each time forwardedPorts is set by a port forwarding setup, without forwardedPortsMu held
+	forwardedPortsHandler func(ports []api.ForwardedPort)
+
+	// lastConnectionTime is the time at which the last connection to a forwarded port has been accepted
+	lastConnectionTime   time.Time
+	lastConnectionTimeMu sync.Mutex
+
 	// stopChan on which to write to stop the port forwarding
 	stopChan chan struct{}
 	// finishedChan is written when the port forwarding is finished
@@ -75,11 +86,11 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 
 	var portPairs map[string][]string
 	if len(definedPorts) != 0 {
-		portPairs = getCustomPortPairs(definedPorts, ceMapping, customAddress)
+		portPairs = getCustomPortPairs(definedPorts, ceMapping, customAddress, o.getPreferredPorts(ctx, componentName))
 	} else if randomPorts {
 		portPairs = randomPortPairsFromContainerEndpoints(ceMapping)
 	} else {
-		portPairs = portPairsFromContainerEndpoints(ceMapping, customAddress)
+		portPairs = portPairsFromContainerEndpoints(ceMapping, customAddress, o.getPreferredPorts(ctx, componentName))
 	}
 	var portPairsSlice []string
 	for _, v1 := range portPairs {
@@ -106,7 +117,8 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 
 	o.isRunning = true
 
-	devstateChan := make(chan error)
+	// Only the result of the first port forwarding setup is waited for, the results of the next ones are dropped
+	devstateChan := make(chan error, 1)
 	go func() {
 		backo := watch.NewExpBackoff()
 		for {
@@ -115,14 +127,28 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 
 			go func() {
 				portsBuf.Wait()
-				err = o.stateClient.SetForwardedPorts(ctx, portsBuf.GetForwardedPorts())
+				forwardedPorts := portsBuf.GetForwardedPorts()
+				o.forwardedPortsMu.Lock()
+				o.forwardedPorts = forwardedPorts
+				handler := o.forwardedPortsHandler
+				o.forwardedPortsMu.Unlock()
+				// The handler can take time (e.g. calls to the cluster), it is called without the lock held
+				if handler != nil {
+					handler(forwardedPorts)
+				}
+				err := o.stateClient.SetForwardedPorts(ctx, portsBuf.GetForwardedPorts())
 				if err != nil {
 					err = fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+				} else if !randomPorts {
+					o.setPreferredPorts(ctx, componentName, portsBuf.GetForwardedPorts())
+				}
+				select {
+				case devstateChan <- err:
+				default:
 				}
-				devstateChan <- err
 			}()
 
-			err = o.kubernetesClient.SetupPortForwarding(pod, portPairsSlice, portsBuf, errOut, o.stopChan, customAddress)
+			err := o.kubernetesClient.SetupPortForwarding(pod, portPairsSlice, portsBuf, errOut, o.stopChan, customAddress, o.recordConnection)
 			if err != nil {
 				fmt.Fprintf(errOut, "Failed to setup port-forwarding: %v\n", err)
 				d := backo.Delay()
@@ -163,19 +189,66 @@ func (o *PFClient) StopPortForwarding(ctx context.Context, componentName string)
 	<-o.finishedChan
 	o.finishedChan = nil
 	runtime.ErrorHandlers = o.originalErrorHandlers
+
+	o.forwardedPortsMu.Lock()
+	o.forwardedPorts = nil
+	o.forwardedPortsMu.Unlock()
+}
+
+// getPreferredPorts returns the local ports forwarded to the ports of the containers of the component by previous sessions.
+// Errors are not fatal, the ports are assigned from 20001 in this case.
+func (o *PFClient) getPreferredPorts(ctx context.Context, componentName string) state.PreferredPorts {
+	preferred, err := o.stateClient.GetPreferredPorts(ctx, componentName)
+	if err != nil {
+		klog.V(4).Infof("unable to get the ports forwarded by previous sessions: %v", err)
+	}
+	return preferred
+}
+
+// setPreferredPorts records the forwarded local ports, to forward the same ports in the next sessions. Errors are not fatal.
+func (o *PFClient) setPreferredPorts(ctx context.Context, componentName string, fwPorts []api.ForwardedPort) {
+	err := o.stateClient.SetPreferredPorts(ctx, componentName, fwPorts)
+	if err != nil {
+		klog.V(4).Infof("unable to record the forwarded ports for the next sessions: %v", err)
+	}
+}
+
+// recordConnection is called for each connection accepted on a forwarded port
+func (o *PFClient) recordConnection() {
+	o.lastConnectionTimeMu.Lock()
+	defer o.lastConnectionTimeMu.Unlock()
+	o.lastConnectionTime = time.Now()
+}
+
+func (o *PFClient) GetLastConnectionTime() time.Time {
+	o.lastConnectionTimeMu.Lock()
+	defer o.lastConnectionTimeMu.Unlock()
+	return o.lastConnectionTime
 }
 
 func (o *PFClient) GetForwardedPorts() map[string][]v1alpha2.Endpoint {
 	return o.appliedEndpoints
 }
 
+func (o *PFClient) GetForwardedLocalPorts() []api.ForwardedPort {
+	o.forwardedPortsMu.Lock()
+	defer o.forwardedPortsMu.Unlock()
+	return o.forwardedPorts
+}
+
+func (o *PFClient) SetForwardedPortsHandler(handler func(ports []api.ForwardedPort)) {
+	o.forwardedPortsMu.Lock()
+	defer o.forwardedPortsMu.Unlock()
+	o.forwardedPortsHandler = handler
+}
+
 // getCustomPortPairs assigns custom port on localhost to a container port if provided by the definedPorts config,
-// if not, it assigns a port starting from 20001 as done in portPairsFromContainerEndpoints
-func getCustomPortPairs(definedPorts []api.ForwardedPort, ceMapping map[string][]v1alpha2.Endpoint, address string) map[string][]string {
+// if not, it assigns a port as done in portPairsFromContainerEndpoints
+func getCustomPortPairs(definedPorts []api.ForwardedPort, ceMapping map[string][]v1alpha2.Endpoint, address string, preferred state.PreferredPorts) map[string][]string {
 	portPairs := make(map[string][]string)
-	usedPorts := make(map[int]struct{})
+	allocator := newLocalPortAllocator(preferred, address)
 	for _, dPort := range definedPorts {
-		usedPorts[dPort.LocalPort] = struct{}{}
+		allocator.reserved[dPort.LocalPort] = struct{}{}
 	}
 	// getCustomLocalPort analyzes the definedPorts i.e. custom port forwarding to see if a containerPort has a custom localPort, if a container name is provided, it also takes that into account.
 	getCustomLocalPort := func(containerPort int, container string) int {
@@ -192,37 +265,18 @@ func getCustomPortPairs(definedPorts []api.ForwardedPort, ceMapping map[string][
 		}
 		return 0
 	}
-	startPort := 20001
-	endPort := startPort + 10000
 
-	// Prepare to iterate over containers so that we can iterate in an orderly manner
-	// This is better to ensure same result every time
-	var containers []string
-	for container := range ceMapping {
-		containers = append(containers, container)
-	}
-	sort.Strings(containers)
-
-	for _, name := range containers {
+	for _, name := range sortedContainerNames(ceMapping) {
 		ports := ceMapping[name]
 		for _, p := range ports {
 			freePort := getCustomLocalPort(p.TargetPort, name)
 			if freePort == 0 {
-				for {
-					var err error
-					freePort, err = util.NextFreePort(startPort, endPort, nil, address)
-					if err != nil {
-						klog.Infof("%s", err)
-						continue
-					}
-					// if the free port matches any of the custom local port, try again
-					if _, isPortUsed := usedPorts[freePort]; isPortUsed {
-						startPort = freePort + 1
-						continue
-					}
-					break
+				var err error
+				freePort, err = allocator.next(name, p.TargetPort)
+				if err != nil {
+					klog.Infof("%s", err)
+					continue
 				}
-				startPort = freePort + 1
 			}
 			pair := fmt.Sprintf("%d:%d", freePort, p.TargetPort)
 			portPairs[name] = append(portPairs[name], pair)
@@ -247,24 +301,79 @@ func randomPortPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint)
 	return portPairs
 }
 
-// portPairsFromContainerEndpoints assigns a port on localhost to each port in the provided containerEndpoints map
+// portPairsFromContainerEndpoints assigns a port on localhost to each port in the provided containerEndpoints map:
+// the port forwarded by a previous session if it is free, or a port starting from 20001.
 // it returns a map of the format "<container-name>":{"<local-port-1>:<remote-port-1>", "<local-port-2>:<remote-port-2>"}
 // "container1": {"20001:3000", "20002:3001"}
-func portPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint, address string) map[string][]string {
+func portPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint, address string, preferred state.PreferredPorts) map[string][]string {
 	portPairs := make(map[string][]string)
-	startPort := 20001
-	endPort := startPort + 10000
-	for name, ports := range ceMap {
-		for _, p := range ports {
-			freePort, err := util.NextFreePort(startPort, endPort, nil, address)
+	allocator := newLocalPortAllocator(preferred, address)
+	for _, name := range sortedContainerNames(ceMap) {
+		for _, p := range ceMap[name] {
+			freePort, err := allocator.next(name, p.TargetPort)
 			if err != nil {
 				klog.Infof("%s", err)
 				continue
 			}
 			pair := fmt.Sprintf("%d:%d", freePort, p.TargetPort)
 			portPairs[name] = append(portPairs[name], pair)
-			startPort = freePort + 1
 		}
 	}
 	return portPairs
 }
+
+// sortedContainerNames returns the names of the containers of ceMap, sorted,
+// to iterate over the containers in the same order and obtain the same result every time
+func sortedContainerNames(ceMap map[string][]v1alpha2.Endpoint) []string {
+	containers := make([]string, 0, len(ceMap))
+	for container := range ceMap {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	return containers
+}
+
+// localPortAllocator assigns local ports to the ports of the containers, starting from 20001.
+// The local port forwarded to a container port by a previous session is assigned again if it is free,
+// and is not assigned to other container ports, so the forwarded ports are stable across sessions.
+type localPortAllocator struct {
+	preferred state.PreferredPorts
+	address   string
+	// reserved are the local ports which cannot be assigned: custom local ports, and ports already assigned
+	reserved  map[int]struct{}
+	startPort int
+	endPort   int
+}
+
+func newLocalPortAllocator(preferred state.PreferredPorts, address string) *localPortAllocator {
+	return &localPortAllocator{
+		preferred: preferred,
+		address:   address,
+		reserved:  make(map[int]struct{}),
+		startPort: 20001,
+		endPort:   20001 + 10000,
+	}
+}
+
+// next returns the local port to forward to the containerPort of the container
+func (o *localPortAllocator) next(containerName string, containerPort int) (int, error) {
+	if port := o.preferred.LocalPort(containerName, containerPort); port != 0 {
+		if _, isReserved := o.reserved[port]; !isReserved && util.IsPortFree(port, o.address) {
+			o.reserved[port] = struct{}{}
+			return port, nil
+		}
+		klog.V(2).Infof("local port %d previously forwarded to port %d of container %q is not available, another port is used", port, containerPort, containerName)
+	}
+	for {
+		port, err := util.NextFreePort(o.startPort, o.endPort, nil, o.address)
+		if err != nil {
+			return 0, err
+		}
+		o.startPort = port + 1
+		if _, isReserved := o.reserved[port]; isReserved || o.preferred.IsPreferred(port) {
+			continue
+		}
+		o.reserved[port] = struct{}{}
+		return port, nil
+	}
+}
@@ -1,10 +1,25 @@
 package kubeportforward
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/redhat-developer/odo/pkg/api"
-	"testing"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
 func Test_getCompleteCustomPortPairs(t *testing.T) {
@@ -72,10 +87,125 @@ func Test_getCompleteCustomPortPairs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotPortPairs := getCustomPortPairs(tt.args.definedPorts, tt.args.ceMapping, "")
+			gotPortPairs := getCustomPortPairs(tt.args.definedPorts, tt.args.ceMapping, "", nil)
 			if diff := cmp.Diff(gotPortPairs, tt.wantPortPairs); diff != "" {
 				t.Errorf("getCompleteCustomPortPairs() (got vs want) diff = %v", diff)
 			}
 		})
 	}
 }
+
+func Test_portPairsFromContainerEndpoints(t *testing.T) {
+	// A local port previously forwarded, but used by another process
+	listener, err := net.Listen("tcp", "127.0.0.1:20010")
+	if err != nil {
+		t.Skipf("unable to listen on port 20010: %v", err)
+	}
+	defer listener.Close()
+
+	ceMapping := map[string][]v1alpha2.Endpoint{
+		"runtime": {{TargetPort: 8000}, {TargetPort: 9000}},
+		"tools":   {{TargetPort: 5000}},
+	}
+	tests := []struct {
+		name          string
+		preferred     state.PreferredPorts
+		wantPortPairs map[string][]string
+	}{
+		{
+			name: "no port forwarded by previous sessions",
+			wantPortPairs: map[string][]string{
+				"runtime": {"20001:8000", "20002:9000"},
+				"tools":   {"20003:5000"},
+			},
+		},
+		{
+			name: "ports forwarded by previous sessions are used again, and not assigned to other ports",
+			preferred: state.PreferredPorts{
+				{ContainerName: "tools", ContainerPort: 5000, LocalPort: 20001},
+				{ContainerName: "runtime", ContainerPort: 9000, LocalPort: 20005},
+			},
+			wantPortPairs: map[string][]string{
+				"runtime": {"20002:8000", "20005:9000"},
+				"tools":   {"20001:5000"},
+			},
+		},
+		{
+			name: "another port is used when the port forwarded by a previous session is busy",
+			preferred: state.PreferredPorts{
+				{ContainerName: "runtime", ContainerPort: 8000, LocalPort: 20010},
+			},
+			wantPortPairs: map[string][]string{
+				"runtime": {"20001:8000", "20002:9000"},
+				"tools":   {"20003:5000"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPortPairs := portPairsFromContainerEndpoints(ceMapping, "127.0.0.1", tt.preferred)
+			if diff := cmp.Diff(tt.wantPortPairs, gotPortPairs); diff != "" {
+				t.Errorf("portPairsFromContainerEndpoints() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPFClient_ForwardedPortsHandlerOnReconnection(t *testing.T) {
+	dData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dData.AddComponents([]v1alpha2.Component{{
+		Name: "runtime",
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Container: &v1alpha2.ContainerComponent{
+				Endpoints: []v1alpha2.Endpoint{{Name: "http", TargetPort: 3000}},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: dData}
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetPodUsingComponentName("my-component").Return(&corev1.Pod{}, nil)
+	setups := 0
+	kubeClient.EXPECT().SetupPortForwarding(gomock.Any(), []string{"20001:3000"}, gomock.Any(), gomock.Any(), gomock.Any(), "", gomock.Any()).
+		DoAndReturn(func(_ *corev1.Pod, _ []string, out io.Writer, _ io.Writer, stopChan chan struct{}, _ string, _ func()) error {
+			setups++
+			fmt.Fprintln(out, "Forwarding from 127.0.0.1:20001 -> 3000")
+			if setups == 1 {
+				// the connection to the pod is lost, the port forwarding is set up again
+				return nil
+			}
+			<-stopChan
+			return nil
+		}).Times(2)
+
+	ctx := odocontext.WithPID(context.Background(), 1)
+	client := NewPFClient(kubeClient, state.NewStateClient(filesystem.NewFakeFs()))
+	handled := make(chan []api.ForwardedPort, 2)
+	client.SetForwardedPortsHandler(func(ports []api.ForwardedPort) {
+		handled <- ports
+	})
+
+	err = client.StartPortForwarding(ctx, devfileObj, "my-component", false, false, io.Discard, io.Discard, nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []api.ForwardedPort{{ContainerName: "runtime", PortName: "http", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000}}
+	for i := 1; i <= 2; i++ {
+		select {
+		case got := <-handled:
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("forwarded ports of setup %d mismatch (-want +got):\n%s", i, diff)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("forwarded ports handler not called for setup %d", i)
+		}
+	}
+	client.StopPortForwarding(ctx, "my-component")
+}
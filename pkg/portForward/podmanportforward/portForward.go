@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
@@ -25,6 +27,8 @@ type PFClient struct {
 	remoteProcessHandler remotecmd.RemoteProcessHandler
 
 	appliedPorts map[api.ForwardedPort]struct{}
+
+	forwardedPortsHandler func(ports []api.ForwardedPort)
 }
 
 var _ portForward.Client = (*PFClient)(nil)
@@ -82,6 +86,9 @@ func (o *PFClient) StartPortForwarding(
 		}
 		o.appliedPorts[port] = struct{}{}
 	}
+	if o.forwardedPortsHandler != nil {
+		o.forwardedPortsHandler(o.GetForwardedLocalPorts())
+	}
 	return nil
 }
 
@@ -119,6 +126,26 @@ func (o *PFClient) GetForwardedPorts() map[string][]v1alpha2.Endpoint {
 	return result
 }
 
+func (o *PFClient) GetForwardedLocalPorts() []api.ForwardedPort {
+	result := make([]api.ForwardedPort, 0, len(o.appliedPorts))
+	for port := range o.appliedPorts {
+		result = append(result, port)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LocalPort < result[j].LocalPort
+	})
+	return result
+}
+
+func (o *PFClient) SetForwardedPortsHandler(handler func(ports []api.ForwardedPort)) {
+	o.forwardedPortsHandler = handler
+}
+
+// GetLastConnectionTime returns the zero time, as the connections are forwarded by podman itself and cannot be observed
+func (o *PFClient) GetLastConnectionTime() time.Time {
+	return time.Time{}
+}
+
 func getPodName(componentName string) string {
 	return fmt.Sprintf("%s-app", componentName)
 }
@@ -2,7 +2,9 @@ package preference
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -12,6 +14,8 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/api"
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/i18n"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -19,6 +23,7 @@ import (
 	dfutil "github.com/devfile/library/v2/pkg/util"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog"
 	kpointer "k8s.io/utils/pointer"
 )
@@ -50,6 +55,33 @@ type odoSettings struct {
 	// ImageRegistry is the image registry to which relative image names in Devfile Image Components will be pushed to.
 	// This will also serve as the base path for replacing matching images in other components like Container and Kubernetes/OpenShift ones.
 	ImageRegistry *string `yaml:"ImageRegistry,omitempty"`
+
+	// WatchMode is the method used by odo to detect changes in the component sources (native or polling)
+	WatchMode *string `yaml:"WatchMode,omitempty"`
+
+	// ExtraLabels are the labels, as comma-separated key=value pairs, added to all the resources created by odo
+	ExtraLabels *string `yaml:"ExtraLabels,omitempty"`
+
+	// ExtraAnnotations are the annotations, as comma-separated key=value pairs, added to all the resources created by odo
+	ExtraAnnotations *string `yaml:"ExtraAnnotations,omitempty"`
+
+	// ServiceAccount is the service account used by the pod of the component running in Dev mode on the cluster
+	ServiceAccount *string `yaml:"ServiceAccount,omitempty"`
+
+	// ImagePullSecrets are the names of the secrets, comma-separated, used to pull the images of the component running in Dev mode on the cluster
+	ImagePullSecrets *string `yaml:"ImagePullSecrets,omitempty"`
+
+	// TelemetryEndpoint is the URL of the collector, or of the local file, to which telemetry data is sent instead of Segment
+	TelemetryEndpoint *string `yaml:"TelemetryEndpoint,omitempty"`
+
+	// LogFile if true copies the output of odo to a log file in the component directory
+	LogFile *bool `yaml:"LogFile,omitempty"`
+
+	// Locale is the language of the messages displayed by odo
+	Locale *string `yaml:"Locale,omitempty"`
+
+	// ResourceUsageInterval is the interval at which odo dev displays the resource usage of the component, 0 to disable
+	ResourceUsageInterval *time.Duration `yaml:"ResourceUsageInterval,omitempty"`
 }
 
 // Registry includes the registry metadata
@@ -117,26 +149,9 @@ func newPreferenceInfo(ctx context.Context) (*preferenceInfo, error) {
 	}
 
 	c := preferenceInfo{
-		Preference: newPreference(),
-		Filename:   preferenceFile,
-	}
-
-	// Default devfile registry
-	defaultRegistryList := []Registry{
-		{
-			Name:   DefaultDevfileRegistryName,
-			URL:    DefaultDevfileRegistryURL,
-			Secure: false,
-		},
-	}
-
-	// If the preference file doesn't exist then we return with default preference
-	if _, err = os.Stat(preferenceFile); os.IsNotExist(err) {
-		c.OdoSettings.RegistryList = &defaultRegistryList
-		return &c, nil
+		Filename: preferenceFile,
 	}
-
-	err = util.GetFromFile(&c.Preference, c.Filename)
+	err = c.load()
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +172,33 @@ func newPreferenceInfo(ctx context.Context) (*preferenceInfo, error) {
 		log.Warningf("Please change the preference value for %s, the value does not comply with the minimum value of %s; e.g. of acceptable formats: 4s, 5m, 1h", strings.Join(requiresChange, ", "), minimumDurationValue)
 	}
 
+	return &c, nil
+}
+
+// load reads the preferences from the preference file, or sets the default preferences if the file does not exist
+func (c *preferenceInfo) load() error {
+	c.Preference = newPreference()
+
+	// Default devfile registry
+	defaultRegistryList := []Registry{
+		{
+			Name:   DefaultDevfileRegistryName,
+			URL:    DefaultDevfileRegistryURL,
+			Secure: false,
+		},
+	}
+
+	// If the preference file doesn't exist then we return with default preference
+	if _, err := os.Stat(c.Filename); os.IsNotExist(err) {
+		c.OdoSettings.RegistryList = &defaultRegistryList
+		return nil
+	}
+
+	err := util.GetFromFile(&c.Preference, c.Filename)
+	if err != nil {
+		return err
+	}
+
 	// Handle user has preference file but doesn't use dynamic registry before
 	if c.OdoSettings.RegistryList == nil {
 		c.OdoSettings.RegistryList = &defaultRegistryList
@@ -173,47 +215,113 @@ func newPreferenceInfo(ctx context.Context) (*preferenceInfo, error) {
 		}
 	}
 
-	return &c, nil
+	return nil
+}
+
+// Reload reads again the preferences from the preference file, to get the changes done by other odo processes
+func (c *preferenceInfo) Reload() error {
+	return c.load()
+}
+
+// GetPreferenceFile returns the path of the preference file
+func (c *preferenceInfo) GetPreferenceFile() string {
+	return c.Filename
+}
+
+// update modifies the preferences and writes them to the preference file.
+// The preferences are read again from the file before being modified, while holding a lock on the file,
+// so that the changes done concurrently by other odo processes are not lost.
+func (c *preferenceInfo) update(modify func() error) error {
+	unlock, err := lockFile(c.Filename)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = c.load()
+	if err != nil {
+		return err
+	}
+	err = modify()
+	if err != nil {
+		return err
+	}
+	err = util.WriteToYAMLFile(&c.Preference, c.Filename)
+	if err != nil {
+		return writeFileError{err: err}
+	}
+	return nil
+}
+
+// writeFileError is returned by update when the preferences cannot be written to the preference file
+type writeFileError struct {
+	err error
+}
+
+func (e writeFileError) Error() string {
+	return e.err.Error()
+}
+
+func (e writeFileError) Unwrap() error {
+	return e.err
 }
 
 // RegistryHandler handles registry add, and remove operations
 func (c *preferenceInfo) RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error {
-	var registryList []Registry
-	var err error
-	var registryExist bool
-
-	// Registry list is empty
-	if c.OdoSettings.RegistryList == nil {
-		registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure)
+	// The confirmation is asked before locking the preference file, not to block the other odo processes while waiting for the user
+	if operation == "remove" && !forceFlag && c.OdoSettings.RegistryList != nil && c.RegistryNameExists(registryName) {
+		proceed, err := ui.Proceed(fmt.Sprintf("Are you sure you want to %s registry %q", operation, registryName))
 		if err != nil {
 			return err
 		}
-	} else {
-		// The target registry exists in the registry list
-		registryList = *c.OdoSettings.RegistryList
-		for index, registry := range registryList {
-			if registry.Name == registryName {
-				registryExist = true
-				registryList, err = handleWithRegistryExist(index, registryList, operation, registryName, forceFlag)
-				if err != nil {
-					return err
-				}
-			}
+		if !proceed {
+			log.Info("Aborted by the user")
+			return nil
 		}
+		forceFlag = true
+	}
 
-		// The target registry doesn't exist in the registry list
-		if !registryExist {
+	err := c.update(func() error {
+		var registryList []Registry
+		var err error
+		var registryExist bool
+
+		// Registry list is empty
+		if c.OdoSettings.RegistryList == nil {
 			registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure)
 			if err != nil {
 				return err
 			}
+		} else {
+			// The target registry exists in the registry list
+			registryList = *c.OdoSettings.RegistryList
+			for index, registry := range registryList {
+				if registry.Name == registryName {
+					registryExist = true
+					registryList, err = handleWithRegistryExist(index, registryList, operation, registryName, forceFlag)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// The target registry doesn't exist in the registry list
+			if !registryExist {
+				registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure)
+				if err != nil {
+					return err
+				}
+			}
 		}
-	}
 
-	c.OdoSettings.RegistryList = &registryList
-	err = util.WriteToYAMLFile(&c.Preference, c.Filename)
+		c.OdoSettings.RegistryList = &registryList
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("unable to write the configuration of %q operation to preference file", operation)
+		if errors.As(err, &writeFileError{}) {
+			return fmt.Errorf("unable to write the configuration of %q operation to preference file", operation)
+		}
+		return err
 	}
 
 	return nil
@@ -247,7 +355,11 @@ func handleWithRegistryExist(index int, registryList []Registry, operation strin
 
 	case "remove":
 		if !forceFlag {
-			if !ui.Proceed(fmt.Sprintf("Are you sure you want to %s registry %q", operation, registryName)) {
+			proceed, err := ui.Proceed(fmt.Sprintf("Are you sure you want to %s registry %q", operation, registryName))
+			if err != nil {
+				return nil, err
+			}
+			if !proceed {
 				log.Info("Aborted by the user")
 				return registryList, nil
 			}
@@ -265,7 +377,12 @@ func handleWithRegistryExist(index int, registryList []Registry, operation strin
 // SetConfiguration modifies odo preferences in the preference file
 // TODO: Use reflect to set parameters
 func (c *preferenceInfo) SetConfiguration(parameter string, value string) error {
-	if p, ok := asSupportedParameter(parameter); ok {
+	p, ok := asSupportedParameter(parameter)
+	if !ok {
+		return fmt.Errorf("unknown parameter : %q is not a parameter in odo preference, run `odo preference -h` to see list of available parameters", parameter)
+	}
+
+	err := c.update(func() error {
 		// processing values according to the parameter names
 		switch p {
 
@@ -313,16 +430,89 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 
 		case "imageregistry":
 			c.OdoSettings.ImageRegistry = &value
+
+		case "watchmode":
+			val := strings.ToLower(value)
+			if val != WatchModeNative && val != WatchModePolling {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %q or %q", parameter, value, WatchModeNative, WatchModePolling)
+			}
+			c.OdoSettings.WatchMode = &val
+
+		case "extralabels":
+			extra, err := odolabels.ParseExtra(value)
+			if err == nil {
+				err = odolabels.ValidateExtraLabels(extra)
+			}
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q: %w", parameter, value, err)
+			}
+			val := odolabels.FormatExtra(extra)
+			c.OdoSettings.ExtraLabels = &val
+
+		case "extraannotations":
+			extra, err := odolabels.ParseExtra(value)
+			if err == nil {
+				err = odolabels.ValidateExtraAnnotations(extra)
+			}
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q: %w", parameter, value, err)
+			}
+			val := odolabels.FormatExtra(extra)
+			c.OdoSettings.ExtraAnnotations = &val
+
+		case "serviceaccount":
+			if errs := validation.IsDNS1123Subdomain(value); len(errs) > 0 {
+				return fmt.Errorf("unable to set %q to %q: %s", parameter, value, strings.Join(errs, "; "))
+			}
+			c.OdoSettings.ServiceAccount = &value
+
+		case "imagepullsecrets":
+			names := splitList(value)
+			for _, name := range names {
+				if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+					return fmt.Errorf("unable to set %q to %q: invalid secret name %q: %s", parameter, value, name, strings.Join(errs, "; "))
+				}
+			}
+			val := strings.Join(names, ",")
+			c.OdoSettings.ImagePullSecrets = &val
+
+		case "telemetryendpoint":
+			if err := validateTelemetryEndpoint(value); err != nil {
+				return fmt.Errorf("unable to set %q to %q: %w", parameter, value, err)
+			}
+			c.OdoSettings.TelemetryEndpoint = &value
+
+		case "logfile":
+			val, err := strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
+			}
+			c.OdoSettings.LogFile = &val
+
+		case "locale":
+			if _, err := i18n.ParseLocale(value); err != nil {
+				return fmt.Errorf("unable to set %q to %q, value must be a language tag such as \"fr\": %w", parameter, value, err)
+			}
+			c.OdoSettings.Locale = &value
+
+		case "resourceusageinterval":
+			// 0 disables the display, other values must be at least minimumDurationValue
+			typedval, err := time.ParseDuration(value)
+			if err != nil || typedval != 0 {
+				typedval, err = parseDuration(value, parameter)
+				if err != nil {
+					return err
+				}
+			}
+			c.OdoSettings.ResourceUsageInterval = &typedval
 		}
-	} else {
-		return fmt.Errorf("unknown parameter : %q is not a parameter in odo preference, run `odo preference -h` to see list of available parameters", parameter)
-	}
 
-	err := util.WriteToYAMLFile(&c.Preference, c.Filename)
-	if err != nil {
+		return nil
+	})
+	if errors.As(err, &writeFileError{}) {
 		return fmt.Errorf("unable to set %q, something is wrong with odo, kindly raise an issue at https://github.com/redhat-developer/odo/issues/new?template=Bug.md", parameter)
 	}
-	return nil
+	return err
 }
 
 // parseDuration parses the value set for a parameter;
@@ -341,21 +531,18 @@ func parseDuration(value, parameter string) (time.Duration, error) {
 
 // DeleteConfiguration deletes odo preference from the odo preference file
 func (c *preferenceInfo) DeleteConfiguration(parameter string) error {
-	if p, ok := asSupportedParameter(parameter); ok {
-		// processing values according to the parameter names
-
-		if err := util.DeleteConfiguration(&c.OdoSettings, p); err != nil {
-			return err
-		}
-	} else {
+	p, ok := asSupportedParameter(parameter)
+	if !ok {
 		return fmt.Errorf("unknown parameter :%q is not a parameter in the odo preference", parameter)
 	}
 
-	err := util.WriteToYAMLFile(&c.Preference, c.Filename)
-	if err != nil {
+	err := c.update(func() error {
+		return util.DeleteConfiguration(&c.OdoSettings, p)
+	})
+	if errors.As(err, &writeFileError{}) {
 		return fmt.Errorf("unable to set %q, something is wrong with odo, kindly raise an issue at https://github.com/redhat-developer/odo/issues/new?template=Bug.md", parameter)
 	}
-	return nil
+	return err
 }
 
 // IsSet checks if the value is set in the preference
@@ -387,6 +574,98 @@ func (c *preferenceInfo) GetImageRegistry() string {
 	return kpointer.StringDeref(c.OdoSettings.ImageRegistry, "")
 }
 
+// GetWatchMode returns the value of WatchMode from the preferences
+// and, if absent, then returns default
+func (c *preferenceInfo) GetWatchMode() string {
+	return kpointer.StringDeref(c.OdoSettings.WatchMode, DefaultWatchMode)
+}
+
+// GetExtraLabels returns the labels defined by ExtraLabels in the preferences
+func (c *preferenceInfo) GetExtraLabels() map[string]string {
+	return parseExtra(ExtraLabelsSetting, c.OdoSettings.ExtraLabels)
+}
+
+// GetExtraAnnotations returns the annotations defined by ExtraAnnotations in the preferences
+func (c *preferenceInfo) GetExtraAnnotations() map[string]string {
+	return parseExtra(ExtraAnnotationsSetting, c.OdoSettings.ExtraAnnotations)
+}
+
+func parseExtra(parameter string, value *string) map[string]string {
+	extra, err := odolabels.ParseExtra(kpointer.StringDeref(value, ""))
+	if err != nil {
+		klog.V(2).Infof("ignoring invalid value for %s preference: %v", parameter, err)
+		return nil
+	}
+	return extra
+}
+
+// GetServiceAccount returns the value of ServiceAccount from the preferences
+// and, if absent, then returns default empty string.
+func (c *preferenceInfo) GetServiceAccount() string {
+	return kpointer.StringDeref(c.OdoSettings.ServiceAccount, "")
+}
+
+// GetImagePullSecrets returns the secrets defined by ImagePullSecrets in the preferences
+func (c *preferenceInfo) GetImagePullSecrets() []string {
+	return splitList(kpointer.StringDeref(c.OdoSettings.ImagePullSecrets, ""))
+}
+
+// GetTelemetryEndpoint returns the value of TelemetryEndpoint from the preferences
+// and, if absent, then returns default empty string.
+func (c *preferenceInfo) GetTelemetryEndpoint() string {
+	return kpointer.StringDeref(c.OdoSettings.TelemetryEndpoint, "")
+}
+
+// GetLogFile returns the value of LogFile from the preferences
+// and, if absent, then returns default false.
+func (c *preferenceInfo) GetLogFile() bool {
+	return kpointer.BoolDeref(c.OdoSettings.LogFile, DefaultLogFileSetting)
+}
+
+// GetLocale returns the value of Locale from the preferences
+// and, if absent, then returns default empty string.
+func (c *preferenceInfo) GetLocale() string {
+	return kpointer.StringDeref(c.OdoSettings.Locale, "")
+}
+
+// GetResourceUsageInterval returns the value of ResourceUsageInterval from the preferences
+// and, if absent, then returns default 0, disabling the display.
+func (c *preferenceInfo) GetResourceUsageInterval() time.Duration {
+	return kpointer.DurationDeref(c.OdoSettings.ResourceUsageInterval, DefaultResourceUsageInterval)
+}
+
+// validateTelemetryEndpoint checks that the endpoint is either an HTTP(S) URL with a host, or a file URL with a path
+func validateTelemetryEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return errors.New("the URL must contain a host")
+		}
+	case "file":
+		if u.Path == "" {
+			return errors.New("the URL must contain a path")
+		}
+	default:
+		return errors.New("the URL scheme must be one of http, https or file")
+	}
+	return nil
+}
+
+// splitList returns the non-empty elements of a comma-separated list
+func splitList(value string) []string {
+	var result []string
+	for _, elt := range strings.Split(value, ",") {
+		if elt = strings.TrimSpace(elt); elt != "" {
+			result = append(result, elt)
+		}
+	}
+	return result
+}
+
 // GetUpdateNotification returns the value of UpdateNotification from preferences
 // and if absent then returns default
 func (c *preferenceInfo) GetUpdateNotification() bool {
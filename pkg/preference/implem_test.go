@@ -347,6 +347,163 @@ func TestSetConfiguration(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name:           fmt.Sprintf("set %s to polling", WatchModeSetting),
+			parameter:      WatchModeSetting,
+			value:          "Polling",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           WatchModePolling,
+		},
+		{
+			name:           fmt.Sprintf("set %s to invalid value", WatchModeSetting),
+			parameter:      WatchModeSetting,
+			value:          "inotify",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s", ExtraLabelsSetting),
+			parameter:      ExtraLabelsSetting,
+			value:          "team=my-team, cost-center=1234",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "cost-center=1234,team=my-team",
+		},
+		{
+			name:           fmt.Sprintf("set %s to invalid label value", ExtraLabelsSetting),
+			parameter:      ExtraLabelsSetting,
+			value:          "owner=me@example.com",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s", ExtraAnnotationsSetting),
+			parameter:      ExtraAnnotationsSetting,
+			value:          "owner=me@example.com",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "owner=me@example.com",
+		},
+		{
+			name:           fmt.Sprintf("set %s without value", ExtraAnnotationsSetting),
+			parameter:      ExtraAnnotationsSetting,
+			value:          "owner",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s", ServiceAccountSetting),
+			parameter:      ServiceAccountSetting,
+			value:          "my-sa",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "my-sa",
+		},
+		{
+			name:           fmt.Sprintf("set %s to invalid name", ServiceAccountSetting),
+			parameter:      ServiceAccountSetting,
+			value:          "My_SA",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s", ImagePullSecretsSetting),
+			parameter:      ImagePullSecretsSetting,
+			value:          "secret1, secret2",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "secret1,secret2",
+		},
+		{
+			name:           fmt.Sprintf("set %s to an HTTPS URL", TelemetryEndpointSetting),
+			parameter:      TelemetryEndpointSetting,
+			value:          "https://collector.example.com/segment",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "https://collector.example.com/segment",
+		},
+		{
+			name:           fmt.Sprintf("set %s to a file URL", TelemetryEndpointSetting),
+			parameter:      TelemetryEndpointSetting,
+			value:          "file:///var/log/odo/telemetry.jsonl",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "file:///var/log/odo/telemetry.jsonl",
+		},
+		{
+			name:           fmt.Sprintf("set %s to a URL without host", TelemetryEndpointSetting),
+			parameter:      TelemetryEndpointSetting,
+			value:          "https:///segment",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a URL with an unsupported scheme", TelemetryEndpointSetting),
+			parameter:      TelemetryEndpointSetting,
+			value:          "ftp://collector.example.com",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to true", LogFileSetting),
+			parameter:      LogFileSetting,
+			value:          "true",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a non-boolean value", LogFileSetting),
+			parameter:      LogFileSetting,
+			value:          "yes",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to a language tag", LocaleSetting),
+			parameter:      LocaleSetting,
+			value:          "fr-FR",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "fr-FR",
+		},
+		{
+			name:           fmt.Sprintf("set %s to a POSIX locale", LocaleSetting),
+			parameter:      LocaleSetting,
+			value:          "fr_FR.UTF-8",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "fr_FR.UTF-8",
+		},
+		{
+			name:           fmt.Sprintf("set %s to an invalid value", LocaleSetting),
+			parameter:      LocaleSetting,
+			value:          "not a language",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to 30 seconds", ResourceUsageIntervalSetting),
+			parameter:      ResourceUsageIntervalSetting,
+			value:          "30s",
+			existingConfig: Preference{},
+			want:           30 * time.Second,
+		},
+		{
+			name:           fmt.Sprintf("set %s to 0 to disable it", ResourceUsageIntervalSetting),
+			parameter:      ResourceUsageIntervalSetting,
+			value:          "0",
+			existingConfig: Preference{},
+			want:           time.Duration(0),
+		},
+		{
+			name:           fmt.Sprintf("set %s to a value less than the minimum", ResourceUsageIntervalSetting),
+			parameter:      ResourceUsageIntervalSetting,
+			value:          "500ms",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -376,6 +533,42 @@ func TestSetConfiguration(t *testing.T) {
 					if *cfg.OdoSettings.RegistryCacheTime != tt.want {
 						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %d\n", *cfg.OdoSettings.RegistryCacheTime, tt.want)
 					}
+				case "WatchMode":
+					if *cfg.OdoSettings.WatchMode != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.WatchMode, tt.want)
+					}
+				case "ExtraLabels":
+					if *cfg.OdoSettings.ExtraLabels != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.ExtraLabels, tt.want)
+					}
+				case "ServiceAccount":
+					if *cfg.OdoSettings.ServiceAccount != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.ServiceAccount, tt.want)
+					}
+				case "ImagePullSecrets":
+					if *cfg.OdoSettings.ImagePullSecrets != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.ImagePullSecrets, tt.want)
+					}
+				case "TelemetryEndpoint":
+					if *cfg.OdoSettings.TelemetryEndpoint != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.TelemetryEndpoint, tt.want)
+					}
+				case "Locale":
+					if *cfg.OdoSettings.Locale != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.Locale, tt.want)
+					}
+				case "LogFile":
+					if *cfg.OdoSettings.LogFile != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %t \nexpected: %t\n", *cfg.OdoSettings.LogFile, tt.want)
+					}
+				case "ResourceUsageInterval":
+					if *cfg.OdoSettings.ResourceUsageInterval != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.ResourceUsageInterval, tt.want)
+					}
+				case "ExtraAnnotations":
+					if *cfg.OdoSettings.ExtraAnnotations != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.ExtraAnnotations, tt.want)
+					}
 				}
 			} else if tt.wantErr && err != nil {
 				// negative cases
@@ -0,0 +1,63 @@
+package preference
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog"
+)
+
+const (
+	// lockTimeout is the maximum time to wait for the lock on the preference file held by another odo process
+	lockTimeout = 10 * time.Second
+	// lockRetryInterval is the interval between two attempts to acquire the lock
+	lockRetryInterval = 50 * time.Millisecond
+)
+
+// errLocked is returned by tryLock when the lock is held by another process
+var errLocked = errors.New("file locked")
+
+// getLockFile returns the path of the lock file of the preference file
+func getLockFile(filename string) string {
+	return filename + ".lock"
+}
+
+// lockFile acquires an exclusive lock on the file, shared by all the odo processes, by taking an advisory lock
+// on a lock file next to it. The lock is released by the operating system if the process holding it ends,
+// so a crashed odo process never leaves the file locked.
+// The returned function releases the lock.
+func lockFile(filename string) (unlock func(), err error) {
+	if err = os.MkdirAll(filepath.Dir(filename), 0750); err != nil {
+		return nil, fmt.Errorf("unable to create directory: %w", err)
+	}
+	lock := getLockFile(filename)
+	// The lock file is never removed: another process could have opened it and be waiting for the lock
+	f, err := os.OpenFile(lock, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lock the file %s: %w", filename, err)
+	}
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		err = tryLock(f)
+		if err == nil {
+			return func() {
+				if unlockErr := unlockFile(f); unlockErr != nil {
+					klog.V(4).Infof("unable to unlock the file %s: %v", lock, unlockErr)
+				}
+				_ = f.Close()
+			}, nil
+		}
+		if !errors.Is(err, errLocked) {
+			_ = f.Close()
+			return nil, fmt.Errorf("unable to lock the file %s: %w", filename, err)
+		}
+		if time.Now().After(deadline) {
+			_ = f.Close()
+			return nil, fmt.Errorf("unable to lock the file %s, held by another odo process", filename)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
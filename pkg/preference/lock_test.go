@@ -0,0 +1,150 @@
+package preference
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+)
+
+func newTestPreferenceInfo(t *testing.T, filename string) *preferenceInfo {
+	ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{
+		Globalodoconfig: &filename,
+	})
+	c, err := newPreferenceInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestPreferenceInfo_update(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "preference.yaml")
+
+	// both clients are created before any change
+	first := newTestPreferenceInfo(t, filename)
+	second := newTestPreferenceInfo(t, filename)
+
+	if err := first.SetConfiguration("timeout", "10s"); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.SetConfiguration("pushtimeout", "20s"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := newTestPreferenceInfo(t, filename)
+	if got.GetTimeout() != 10*time.Second {
+		t.Errorf("the timeout set by the first client is lost, got %s", got.GetTimeout())
+	}
+	if got.GetPushTimeout() != 20*time.Second {
+		t.Errorf("the push timeout set by the second client is lost, got %s", got.GetPushTimeout())
+	}
+	// the lock is released after the update
+	unlock, err := lockFile(filename)
+	if err != nil {
+		t.Errorf("the lock should be released after the update, got error %v", err)
+	} else {
+		unlock()
+	}
+}
+
+func TestPreferenceInfo_updateConcurrently(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "preference.yaml")
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		c := newTestPreferenceInfo(t, filename)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- c.RegistryHandler("add", fmt.Sprintf("registry%d", i), fmt.Sprintf("https://registry%d.example.com", i), false, false)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := newTestPreferenceInfo(t, filename)
+	for i := 0; i < n; i++ {
+		if name := fmt.Sprintf("registry%d", i); !got.RegistryNameExists(name) {
+			t.Errorf("registry %q added concurrently is lost", name)
+		}
+	}
+}
+
+func TestPreferenceInfo_Reload(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "preference.yaml")
+	c := newTestPreferenceInfo(t, filename)
+
+	if err := newTestPreferenceInfo(t, filename).SetConfiguration("imageregistry", "quay.io/user"); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.GetImageRegistry(); got != "" {
+		t.Errorf("GetImageRegistry() before Reload() = %q, want empty", got)
+	}
+	if err := c.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.GetImageRegistry(); got != "quay.io/user" {
+		t.Errorf("GetImageRegistry() after Reload() = %q, want %q", got, "quay.io/user")
+	}
+}
+
+func Test_lockFile_leftLockFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "preference.yaml")
+	lock := getLockFile(filename)
+	// a lock file left by a crashed odo process is not locked anymore
+	if err := os.WriteFile(lock, []byte("12345\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	unlock, err := lockFile(filename)
+	if err != nil {
+		t.Fatalf("lockFile() should take the lock of a left lock file, got error %v", err)
+	}
+	unlock()
+}
+
+func Test_lockFile_exclusive(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "preference.yaml")
+
+	unlock, err := lockFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locked := make(chan struct{})
+	go func() {
+		secondUnlock, secondErr := lockFile(filename)
+		if secondErr != nil {
+			t.Errorf("lockFile() error = %v", secondErr)
+		} else {
+			secondUnlock()
+		}
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the lock should not be taken while it is held")
+	case <-time.After(10 * lockRetryInterval):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(lockTimeout):
+		t.Fatal("the lock should be taken once released")
+	}
+}
@@ -3,6 +3,8 @@ package preference
 import (
 	"reflect"
 
+	kpointer "k8s.io/utils/pointer"
+
 	"github.com/redhat-developer/odo/pkg/api"
 )
 
@@ -64,6 +66,69 @@ func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 			Type:        getType(prefInfo.GetImageRegistry()),
 			Description: ImageRegistrySettingDescription,
 		},
+		{
+			Name:        WatchModeSetting,
+			Value:       settings.WatchMode,
+			Default:     DefaultWatchMode,
+			Type:        getType(prefInfo.GetWatchMode()),
+			Description: WatchModeSettingDescription,
+		},
+		{
+			Name:        ExtraLabelsSetting,
+			Value:       settings.ExtraLabels,
+			Default:     "",
+			Type:        getType(kpointer.StringDeref(settings.ExtraLabels, "")),
+			Description: ExtraLabelsSettingDescription,
+		},
+		{
+			Name:        ExtraAnnotationsSetting,
+			Value:       settings.ExtraAnnotations,
+			Default:     "",
+			Type:        getType(kpointer.StringDeref(settings.ExtraAnnotations, "")),
+			Description: ExtraAnnotationsSettingDescription,
+		},
+		{
+			Name:        ServiceAccountSetting,
+			Value:       settings.ServiceAccount,
+			Default:     "",
+			Type:        getType(prefInfo.GetServiceAccount()),
+			Description: ServiceAccountSettingDescription,
+		},
+		{
+			Name:        ImagePullSecretsSetting,
+			Value:       settings.ImagePullSecrets,
+			Default:     "",
+			Type:        getType(kpointer.StringDeref(settings.ImagePullSecrets, "")),
+			Description: ImagePullSecretsSettingDescription,
+		},
+		{
+			Name:        TelemetryEndpointSetting,
+			Value:       settings.TelemetryEndpoint,
+			Default:     "",
+			Type:        getType(prefInfo.GetTelemetryEndpoint()),
+			Description: TelemetryEndpointSettingDescription,
+		},
+		{
+			Name:        LogFileSetting,
+			Value:       settings.LogFile,
+			Default:     DefaultLogFileSetting,
+			Type:        getType(prefInfo.GetLogFile()),
+			Description: LogFileSettingDescription,
+		},
+		{
+			Name:        LocaleSetting,
+			Value:       settings.Locale,
+			Default:     "",
+			Type:        getType(prefInfo.GetLocale()),
+			Description: LocaleSettingDescription,
+		},
+		{
+			Name:        ResourceUsageIntervalSetting,
+			Value:       settings.ResourceUsageInterval,
+			Default:     DefaultResourceUsageInterval,
+			Type:        getType(prefInfo.GetResourceUsageInterval()),
+			Description: ResourceUsageIntervalSettingDescription,
+		},
 	}
 }
 
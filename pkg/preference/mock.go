@@ -105,6 +105,48 @@ func (mr *MockClientMockRecorder) GetEphemeralSourceVolume() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEphemeralSourceVolume", reflect.TypeOf((*MockClient)(nil).GetEphemeralSourceVolume))
 }
 
+// GetExtraAnnotations mocks base method.
+func (m *MockClient) GetExtraAnnotations() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExtraAnnotations")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// GetExtraAnnotations indicates an expected call of GetExtraAnnotations.
+func (mr *MockClientMockRecorder) GetExtraAnnotations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtraAnnotations", reflect.TypeOf((*MockClient)(nil).GetExtraAnnotations))
+}
+
+// GetExtraLabels mocks base method.
+func (m *MockClient) GetExtraLabels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExtraLabels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// GetExtraLabels indicates an expected call of GetExtraLabels.
+func (mr *MockClientMockRecorder) GetExtraLabels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtraLabels", reflect.TypeOf((*MockClient)(nil).GetExtraLabels))
+}
+
+// GetImagePullSecrets mocks base method.
+func (m *MockClient) GetImagePullSecrets() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagePullSecrets")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetImagePullSecrets indicates an expected call of GetImagePullSecrets.
+func (mr *MockClientMockRecorder) GetImagePullSecrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagePullSecrets", reflect.TypeOf((*MockClient)(nil).GetImagePullSecrets))
+}
+
 // GetImageRegistry mocks base method.
 func (m *MockClient) GetImageRegistry() string {
 	m.ctrl.T.Helper()
@@ -119,6 +161,48 @@ func (mr *MockClientMockRecorder) GetImageRegistry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageRegistry", reflect.TypeOf((*MockClient)(nil).GetImageRegistry))
 }
 
+// GetLocale mocks base method.
+func (m *MockClient) GetLocale() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocale")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetLocale indicates an expected call of GetLocale.
+func (mr *MockClientMockRecorder) GetLocale() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocale", reflect.TypeOf((*MockClient)(nil).GetLocale))
+}
+
+// GetLogFile mocks base method.
+func (m *MockClient) GetLogFile() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogFile")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetLogFile indicates an expected call of GetLogFile.
+func (mr *MockClientMockRecorder) GetLogFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogFile", reflect.TypeOf((*MockClient)(nil).GetLogFile))
+}
+
+// GetPreferenceFile mocks base method.
+func (m *MockClient) GetPreferenceFile() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferenceFile")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPreferenceFile indicates an expected call of GetPreferenceFile.
+func (mr *MockClientMockRecorder) GetPreferenceFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferenceFile", reflect.TypeOf((*MockClient)(nil).GetPreferenceFile))
+}
+
 // GetPushTimeout mocks base method.
 func (m *MockClient) GetPushTimeout() time.Duration {
 	m.ctrl.T.Helper()
@@ -147,6 +231,48 @@ func (mr *MockClientMockRecorder) GetRegistryCacheTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryCacheTime", reflect.TypeOf((*MockClient)(nil).GetRegistryCacheTime))
 }
 
+// GetResourceUsageInterval mocks base method.
+func (m *MockClient) GetResourceUsageInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceUsageInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetResourceUsageInterval indicates an expected call of GetResourceUsageInterval.
+func (mr *MockClientMockRecorder) GetResourceUsageInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceUsageInterval", reflect.TypeOf((*MockClient)(nil).GetResourceUsageInterval))
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockClient) GetServiceAccount() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockClientMockRecorder) GetServiceAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockClient)(nil).GetServiceAccount))
+}
+
+// GetTelemetryEndpoint mocks base method.
+func (m *MockClient) GetTelemetryEndpoint() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTelemetryEndpoint")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetTelemetryEndpoint indicates an expected call of GetTelemetryEndpoint.
+func (mr *MockClientMockRecorder) GetTelemetryEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTelemetryEndpoint", reflect.TypeOf((*MockClient)(nil).GetTelemetryEndpoint))
+}
+
 // GetTimeout mocks base method.
 func (m *MockClient) GetTimeout() time.Duration {
 	m.ctrl.T.Helper()
@@ -175,6 +301,20 @@ func (mr *MockClientMockRecorder) GetUpdateNotification() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateNotification", reflect.TypeOf((*MockClient)(nil).GetUpdateNotification))
 }
 
+// GetWatchMode mocks base method.
+func (m *MockClient) GetWatchMode() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchMode")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetWatchMode indicates an expected call of GetWatchMode.
+func (mr *MockClientMockRecorder) GetWatchMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchMode", reflect.TypeOf((*MockClient)(nil).GetWatchMode))
+}
+
 // IsSet mocks base method.
 func (m *MockClient) IsSet(parameter string) bool {
 	m.ctrl.T.Helper()
@@ -273,6 +413,20 @@ func (mr *MockClientMockRecorder) RegistryNameExists(name interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistryNameExists", reflect.TypeOf((*MockClient)(nil).RegistryNameExists), name)
 }
 
+// Reload mocks base method.
+func (m *MockClient) Reload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockClientMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockClient)(nil).Reload))
+}
+
 // SetConfiguration mocks base method.
 func (m *MockClient) SetConfiguration(parameter, value string) error {
 	m.ctrl.T.Helper()
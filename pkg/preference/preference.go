@@ -10,6 +10,8 @@ type Client interface {
 	IsSet(parameter string) bool
 	SetConfiguration(parameter string, value string) error
 	DeleteConfiguration(parameter string) error
+	Reload() error
+	GetPreferenceFile() string
 
 	GetUpdateNotification() bool
 	GetTimeout() time.Duration
@@ -18,6 +20,15 @@ type Client interface {
 	GetConsentTelemetry() bool
 	GetRegistryCacheTime() time.Duration
 	GetImageRegistry() string
+	GetWatchMode() string
+	GetExtraLabels() map[string]string
+	GetExtraAnnotations() map[string]string
+	GetServiceAccount() string
+	GetImagePullSecrets() []string
+	GetTelemetryEndpoint() string
+	GetLogFile() bool
+	GetLocale() string
+	GetResourceUsageInterval() time.Duration
 	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error
 
 	UpdateNotification() *bool
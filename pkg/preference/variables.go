@@ -64,13 +64,55 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// WatchModeSetting is the name of the setting controlling how odo detects changes in the sources
+	WatchModeSetting = "WatchMode"
+
+	// WatchModeNative uses the file notifications of the operating system to detect changes
+	WatchModeNative = "native"
+
+	// WatchModePolling periodically scans the sources to detect changes, useful for network filesystems
+	WatchModePolling = "polling"
+
+	// DefaultWatchMode is a default value for WatchMode preference
+	DefaultWatchMode = WatchModeNative
+
+	// ExtraLabelsSetting is the name of the setting defining the labels added to all the resources created by odo
+	ExtraLabelsSetting = "ExtraLabels"
+
+	// ExtraAnnotationsSetting is the name of the setting defining the annotations added to all the resources created by odo
+	ExtraAnnotationsSetting = "ExtraAnnotations"
+
+	// ServiceAccountSetting is the name of the setting defining the service account of the Dev pod
+	ServiceAccountSetting = "ServiceAccount"
+
+	// ImagePullSecretsSetting is the name of the setting defining the image pull secrets of the Dev pod
+	ImagePullSecretsSetting = "ImagePullSecrets"
+
+	// TelemetryEndpointSetting is the name of the setting defining where telemetry data is sent
+	TelemetryEndpointSetting = "TelemetryEndpoint"
+
+	// LogFileSetting is the name of the setting controlling whether the output of odo is copied to a log file
+	LogFileSetting = "LogFile"
+
+	// DefaultLogFileSetting is a default value for LogFile preference
+	DefaultLogFileSetting = false
+
+	// LocaleSetting is the name of the setting defining the language of the messages displayed by odo
+	LocaleSetting = "Locale"
+
+	// ResourceUsageIntervalSetting is the name of the setting controlling how often odo dev displays the resource usage of the component
+	ResourceUsageIntervalSetting = "ResourceUsageInterval"
+
+	// DefaultResourceUsageInterval is the default value for ResourceUsageInterval preference, disabling the display
+	DefaultResourceUsageInterval = time.Duration(0)
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
 var TimeoutSettingDescription = fmt.Sprintf("Timeout (in Duration) for cluster server connection check (Default: %s)", DefaultTimeout)
 
 // PushTimeoutSettingDescription adds a description for PushTimeout
-var PushTimeoutSettingDescription = fmt.Sprintf("PushTimeout (in Duration) for waiting for a Pod to come up (Default: %s)", DefaultPushTimeout)
+var PushTimeoutSettingDescription = fmt.Sprintf("PushTimeout (in Duration) for waiting for a Pod to come up, before collecting diagnostics (Default: %s)", DefaultPushTimeout)
 
 // RegistryCacheTimeSettingDescription adds a description for RegistryCacheTime
 var RegistryCacheTimeSettingDescription = fmt.Sprintf("For how long (in Duration) odo will cache information from the Devfile registry (Default: %s)", DefaultRegistryCacheTime)
@@ -83,6 +125,27 @@ var ConsentTelemetrySettingDescription = fmt.Sprintf("If true, odo will collect
 
 const ImageRegistrySettingDescription = "Image Registry to which relative image names in Devfile Image Components will be pushed to (Example: quay.io/my-user/)"
 
+// WatchModeSettingDescription adds a description for WatchMode
+var WatchModeSettingDescription = fmt.Sprintf("Method used to detect changes in the sources, %q or %q; use %q for sources on network filesystems (Default: %s)", WatchModeNative, WatchModePolling, WatchModePolling, DefaultWatchMode)
+
+const ExtraLabelsSettingDescription = "Labels added to all the resources created by odo on the cluster, as comma-separated key=value pairs (Example: team=my-team,cost-center=1234)"
+
+const ExtraAnnotationsSettingDescription = "Annotations added to all the resources created by odo on the cluster, as comma-separated key=value pairs (Example: owner=me@example.com)"
+
+const ServiceAccountSettingDescription = "Service account used by the pod of the component running in Dev mode on the cluster (Default: the default service account of the namespace)"
+
+const ImagePullSecretsSettingDescription = "Comma-separated names of the secrets used to pull the images of the component running in Dev mode on the cluster (Example: my-registry-secret)"
+
+const TelemetryEndpointSettingDescription = "HTTP(S) URL of a Segment-compatible collector, or file:// URL of a local file, to which telemetry data is sent instead of Segment (Example: https://collector.example.com)"
+
+const LocaleSettingDescription = "Language of the messages displayed by odo, as a language tag (Example: fr); defaults to the language defined by the LC_ALL, LC_MESSAGES or LANG environment variables"
+
+// LogFileSettingDescription adds a description for LogFile
+var LogFileSettingDescription = fmt.Sprintf("If true, odo will copy its output, including the debug logs enabled with -v, to .odo/logs/odo.log in the component directory (Default: %t)", DefaultLogFileSetting)
+
+// ResourceUsageIntervalSettingDescription adds a description for ResourceUsageInterval
+var ResourceUsageIntervalSettingDescription = fmt.Sprintf("Interval (in Duration) at which odo dev displays the CPU and memory usage of the component running on the cluster, from the metrics API; 0 to disable (Default: %s)", DefaultResourceUsageInterval)
+
 // This value can be provided to set a seperate directory for users 'homedir' resolution
 // note for mocking purpose ONLY
 var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
@@ -90,13 +153,22 @@ var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
 var (
 	// records information on supported parameters
 	supportedParameterDescriptions = map[string]string{
-		UpdateNotificationSetting: UpdateNotificationSettingDescription,
-		TimeoutSetting:            TimeoutSettingDescription,
-		PushTimeoutSetting:        PushTimeoutSettingDescription,
-		RegistryCacheTimeSetting:  RegistryCacheTimeSettingDescription,
-		EphemeralSetting:          EphemeralSettingDescription,
-		ConsentTelemetrySetting:   ConsentTelemetrySettingDescription,
-		ImageRegistrySetting:      ImageRegistrySettingDescription,
+		UpdateNotificationSetting:    UpdateNotificationSettingDescription,
+		TimeoutSetting:               TimeoutSettingDescription,
+		PushTimeoutSetting:           PushTimeoutSettingDescription,
+		RegistryCacheTimeSetting:     RegistryCacheTimeSettingDescription,
+		EphemeralSetting:             EphemeralSettingDescription,
+		ConsentTelemetrySetting:      ConsentTelemetrySettingDescription,
+		ImageRegistrySetting:         ImageRegistrySettingDescription,
+		WatchModeSetting:             WatchModeSettingDescription,
+		ExtraLabelsSetting:           ExtraLabelsSettingDescription,
+		ExtraAnnotationsSetting:      ExtraAnnotationsSettingDescription,
+		ServiceAccountSetting:        ServiceAccountSettingDescription,
+		ImagePullSecretsSetting:      ImagePullSecretsSettingDescription,
+		TelemetryEndpointSetting:     TelemetryEndpointSettingDescription,
+		LogFileSetting:               LogFileSettingDescription,
+		LocaleSetting:                LocaleSettingDescription,
+		ResourceUsageIntervalSetting: ResourceUsageIntervalSettingDescription,
 	}
 
 	// set-like map to quickly check if a parameter is supported
@@ -0,0 +1,4 @@
+// preflight package provides the checks run before starting odo dev and odo deploy on a cluster,
+// so that all the problems preventing the command to succeed are reported at once, with hints to fix them,
+// instead of failing midway.
+package preflight
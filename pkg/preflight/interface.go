@@ -0,0 +1,11 @@
+package preflight
+
+import (
+	"context"
+)
+
+type Client interface {
+	// Run runs the pre-flight checks for the mode (labels.ComponentDevMode or labels.ComponentDeployMode)
+	// on the current namespace, for the Devfile in context, and returns all the problems found.
+	Run(ctx context.Context, mode string) []Problem
+}
@@ -0,0 +1,289 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const (
+	CheckCluster     = "cluster"
+	CheckNamespace   = "namespace"
+	CheckPermissions = "permissions"
+	CheckQuota       = "quota"
+	CheckResources   = "resources"
+	CheckRegistry    = "registry"
+)
+
+// quotaResources are the resources created by odo, for which an exhausted quota prevents the command to succeed
+var quotaResources = []corev1.ResourceName{
+	corev1.ResourcePods,
+	corev1.ResourceServices,
+	corev1.ResourcePersistentVolumeClaims,
+	corev1.ResourceRequestsCPU,
+	corev1.ResourceRequestsMemory,
+	corev1.ResourceRequestsStorage,
+	corev1.ResourceLimitsCPU,
+	corev1.ResourceLimitsMemory,
+	corev1.ResourceCPU,
+	corev1.ResourceMemory,
+	"count/deployments.apps",
+}
+
+// storageQuotaResources are the resources needed only when volumes are not ephemeral; exhausted quotas for them are reported as warnings
+var storageQuotaResources = map[corev1.ResourceName]bool{
+	corev1.ResourcePersistentVolumeClaims: true,
+	corev1.ResourceRequestsStorage:        true,
+}
+
+type PreflightClient struct {
+	kubeClient       kclient.ClientInterface
+	preferenceClient preference.Client
+	fs               filesystem.Filesystem
+}
+
+var _ Client = (*PreflightClient)(nil)
+
+func NewPreflightClient(kubeClient kclient.ClientInterface, preferenceClient preference.Client, fs filesystem.Filesystem) *PreflightClient {
+	return &PreflightClient{
+		kubeClient:       kubeClient,
+		preferenceClient: preferenceClient,
+		fs:               fs,
+	}
+}
+
+func (o *PreflightClient) Run(ctx context.Context, mode string) []Problem {
+	var problems []Problem
+
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if mode == odolabels.ComponentDeployMode && devfileObj != nil {
+		problems = append(problems, o.checkRegistryCredentials(*devfileObj)...)
+	}
+
+	if o.kubeClient == nil {
+		return problems
+	}
+	if p := o.checkCluster(); p != nil {
+		// The other checks need the cluster
+		return append([]Problem{*p}, problems...)
+	}
+
+	namespace := o.kubeClient.GetCurrentNamespace()
+	if p := o.checkNamespace(namespace); p != nil {
+		// The other checks are done in the namespace
+		return append([]Problem{*p}, problems...)
+	}
+
+	var clusterProblems []Problem
+	if mode == odolabels.ComponentDevMode {
+		clusterProblems = append(clusterProblems, o.checkPermissions(namespace)...)
+	}
+	clusterProblems = append(clusterProblems, o.checkQuotas(namespace)...)
+	if devfileObj != nil {
+		clusterProblems = append(clusterProblems, o.checkResources(ctx, *devfileObj, mode)...)
+	}
+	return append(clusterProblems, problems...)
+}
+
+// checkCluster checks that the cluster is reachable
+func (o *PreflightClient) checkCluster() *Problem {
+	_, err := o.kubeClient.GetServerVersion(o.preferenceClient.GetTimeout())
+	if err == nil {
+		return nil
+	}
+	return &Problem{
+		Check:   CheckCluster,
+		Message: fmt.Sprintf("The cluster is not reachable: %v", err),
+		Hint:    "Check your network connection and that you are logged in to the cluster, or select another cluster with the --kubeconfig and --context flags",
+	}
+}
+
+// checkNamespace checks that the namespace exists.
+// The namespace is considered to exist if the user is not allowed to get it.
+func (o *PreflightClient) checkNamespace(namespace string) *Problem {
+	_, err := o.kubeClient.GetNamespaceNormal(namespace)
+	if err == nil || !kerrors.IsNotFound(err) {
+		if err != nil {
+			klog.V(4).Infof("unable to get namespace %q: %v", namespace, err)
+		}
+		return nil
+	}
+	hint := "Ask your cluster administrator to create it, or select another namespace with `odo set namespace`"
+	if o.isAllowed("create", "", "namespaces", "") || o.isAllowed("create", "project.openshift.io", "projectrequests", "") {
+		hint = fmt.Sprintf("Create it with `odo create namespace %s`, or select another namespace with `odo set namespace`", namespace)
+	}
+	return &Problem{
+		Check:   CheckNamespace,
+		Message: fmt.Sprintf("The namespace %q does not exist", namespace),
+		Hint:    hint,
+	}
+}
+
+// checkPermissions checks that the user can create the resources of the Dev mode in the namespace
+func (o *PreflightClient) checkPermissions(namespace string) []Problem {
+	var problems []Problem
+	for _, r := range []struct {
+		group    string
+		resource string
+		kind     string
+	}{
+		{group: "apps", resource: "deployments", kind: "Deployments"},
+		{group: "", resource: "services", kind: "Services"},
+	} {
+		allowed, err := o.kubeClient.IsActionAllowed("create", r.group, r.resource, namespace)
+		if err != nil {
+			klog.V(4).Infof("unable to check permissions: %v", err)
+			return nil
+		}
+		if allowed {
+			continue
+		}
+		problems = append(problems, Problem{
+			Check:   CheckPermissions,
+			Message: fmt.Sprintf("You are not allowed to create %s in the namespace %q", r.kind, namespace),
+			Hint:    "Ask your cluster administrator for the edit role in the namespace, or select another namespace with `odo set namespace`",
+		})
+	}
+	return problems
+}
+
+// checkQuotas checks that no quota of the namespace is exhausted for the resources created by odo
+func (o *PreflightClient) checkQuotas(namespace string) []Problem {
+	quotas, err := o.kubeClient.ListResourceQuotas()
+	if err != nil {
+		klog.V(4).Infof("unable to list resource quotas: %v", err)
+		return nil
+	}
+	var problems []Problem
+	for _, quota := range quotas {
+		for _, name := range quotaResources {
+			hard, ok := quota.Status.Hard[name]
+			if !ok {
+				continue
+			}
+			used := quota.Status.Used[name]
+			if used.Cmp(hard) < 0 {
+				continue
+			}
+			problems = append(problems, Problem{
+				Check: CheckQuota,
+				Message: fmt.Sprintf("The quota %q of the namespace %q is exhausted for %s (used: %s, hard: %s)",
+					quota.GetName(), namespace, name, used.String(), hard.String()),
+				Hint:    "Delete unused resources from the namespace (for example components with `odo delete component`), or ask your cluster administrator to increase the quota",
+				Warning: storageQuotaResources[name],
+			})
+		}
+	}
+	return problems
+}
+
+// checkResources checks that the kinds of the resources defined in the Kubernetes and OpenShift components
+// applied by the mode are supported by the cluster
+func (o *PreflightClient) checkResources(ctx context.Context, devfileObj parser.DevfileObj, mode string) []Problem {
+	path := filepath.Dir(odocontext.GetDevfilePath(ctx))
+	components, err := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, mode == odolabels.ComponentDeployMode)
+	if err != nil {
+		klog.V(4).Infof("unable to get the Kubernetes components: %v", err)
+		return nil
+	}
+	var problems []Problem
+	for _, c := range components {
+		kind, err := component.ValidateResourcesExistInK8sComponent(o.kubeClient, devfileObj, c, path)
+		if err == nil {
+			continue
+		}
+		if kind == "" {
+			// Errors parsing the manifests are reported when applying them
+			klog.V(4).Infof("unable to validate the resources of component %q: %v", c.Name, err)
+			continue
+		}
+		problems = append(problems, Problem{
+			Check:   CheckResources,
+			Message: fmt.Sprintf("The kind %q defined in the component %q is not supported by the cluster", kind, c.Name),
+			Hint:    "Install the Operator or the CustomResourceDefinition providing this kind on the cluster",
+		})
+	}
+	return problems
+}
+
+// checkRegistryCredentials checks that credentials are defined for the registries the images built by the Deploy mode are pushed to.
+// Missing credentials are reported as warnings, as some registries accept anonymous pushes.
+func (o *PreflightClient) checkRegistryCredentials(devfileObj parser.DevfileObj) []Problem {
+	images, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ImageComponentType},
+	})
+	if err != nil {
+		klog.V(4).Infof("unable to get the Image components: %v", err)
+		return nil
+	}
+	auths := getRegistryAuths(o.fs)
+	missing := make(map[string]struct{})
+	for _, img := range images {
+		registry, repository := parseImageName(img.Image.ImageName)
+		if auths.hasCredentials(registry, repository) {
+			continue
+		}
+		missing[registry] = struct{}{}
+	}
+	registries := make([]string, 0, len(missing))
+	for registry := range missing {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+	var problems []Problem
+	for _, registry := range registries {
+		problems = append(problems, Problem{
+			Check:   CheckRegistry,
+			Message: fmt.Sprintf("No credentials found for the image registry %q", registry),
+			Hint:    fmt.Sprintf("Log in with `podman login %[1]s` or `docker login %[1]s` if the registry requires authentication to push images", registry),
+			Warning: true,
+		})
+	}
+	return problems
+}
+
+func (o *PreflightClient) isAllowed(verb, group, resource, namespace string) bool {
+	allowed, err := o.kubeClient.IsActionAllowed(verb, group, resource, namespace)
+	if err != nil {
+		klog.V(4).Infof("unable to check permissions: %v", err)
+		return false
+	}
+	return allowed
+}
+
+// parseImageName returns the registry and the repository (including the registry) of the image name,
+// using the Docker Hub registry for images without registry
+func parseImageName(imageName string) (registry string, repository string) {
+	name := imageName
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 && !strings.Contains(name[i:], "/") {
+		name = name[:i]
+	}
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0], name
+	}
+	if len(parts) == 1 {
+		name = "library/" + name
+	}
+	return dockerHubRegistry, dockerHubRegistry + "/" + name
+}
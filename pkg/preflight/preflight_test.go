@@ -0,0 +1,328 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/devfile/library/v2/pkg/devfile"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestPreflightClient_Run(t *testing.T) {
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{
+		Data: []byte(`schemaVersion: 2.2.0
+metadata:
+  name: my-component
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-16:latest
+- name: db
+  kubernetes:
+    inlined: |
+      apiVersion: postgresql.example.com/v1
+      kind: Database
+      metadata:
+        name: db
+`),
+		FlattenedDevfile:   pointer.Bool(false),
+		SetBooleanDefaults: pointer.Bool(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "my-ns")
+
+	tests := []struct {
+		name       string
+		mode       string
+		devfileObj *parser.DevfileObj
+		kubeClient func(ctrl *gomock.Controller) kclient.ClientInterface
+		want       []Problem
+	}{
+		{
+			name: "no problem",
+			mode: odolabels.ComponentDevMode,
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetServerVersion(gomock.Any()).Return(&kclient.ServerInfo{}, nil)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns")
+				client.EXPECT().GetNamespaceNormal("my-ns").Return(&corev1.Namespace{}, nil)
+				client.EXPECT().IsActionAllowed("create", gomock.Any(), gomock.Any(), "my-ns").Return(true, nil).Times(2)
+				client.EXPECT().ListResourceQuotas().Return(nil, nil)
+				return client
+			},
+		},
+		{
+			name: "cluster not reachable",
+			mode: odolabels.ComponentDevMode,
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetServerVersion(gomock.Any()).Return(nil, errors.New("connection refused"))
+				return client
+			},
+			want: []Problem{
+				{
+					Check:   CheckCluster,
+					Message: "The cluster is not reachable: connection refused",
+					Hint:    "Check your network connection and that you are logged in to the cluster, or select another cluster with the --kubeconfig and --context flags",
+				},
+			},
+		},
+		{
+			name: "namespace not existing and creatable",
+			mode: odolabels.ComponentDevMode,
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetServerVersion(gomock.Any()).Return(&kclient.ServerInfo{}, nil)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns")
+				client.EXPECT().GetNamespaceNormal("my-ns").Return(nil, notFound)
+				client.EXPECT().IsActionAllowed("create", "", "namespaces", "").Return(true, nil)
+				return client
+			},
+			want: []Problem{
+				{
+					Check:   CheckNamespace,
+					Message: `The namespace "my-ns" does not exist`,
+					Hint:    "Create it with `odo create namespace my-ns`, or select another namespace with `odo set namespace`",
+				},
+			},
+		},
+		{
+			name: "namespace not existing and not creatable",
+			mode: odolabels.ComponentDeployMode,
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetServerVersion(gomock.Any()).Return(&kclient.ServerInfo{}, nil)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns")
+				client.EXPECT().GetNamespaceNormal("my-ns").Return(nil, notFound)
+				client.EXPECT().IsActionAllowed("create", gomock.Any(), gomock.Any(), "").Return(false, nil).Times(2)
+				return client
+			},
+			want: []Problem{
+				{
+					Check:   CheckNamespace,
+					Message: `The namespace "my-ns" does not exist`,
+					Hint:    "Ask your cluster administrator to create it, or select another namespace with `odo set namespace`",
+				},
+			},
+		},
+		{
+			name:       "all the problems in the namespace are reported",
+			mode:       odolabels.ComponentDevMode,
+			devfileObj: &devfileObj,
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetServerVersion(gomock.Any()).Return(&kclient.ServerInfo{}, nil)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns")
+				client.EXPECT().GetNamespaceNormal("my-ns").Return(&corev1.Namespace{}, nil)
+				client.EXPECT().IsActionAllowed("create", "apps", "deployments", "my-ns").Return(false, nil)
+				client.EXPECT().IsActionAllowed("create", "", "services", "my-ns").Return(true, nil)
+				client.EXPECT().ListResourceQuotas().Return([]corev1.ResourceQuota{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "compute"},
+						Status: corev1.ResourceQuotaStatus{
+							Hard: corev1.ResourceList{
+								corev1.ResourcePods:                   resource.MustParse("10"),
+								corev1.ResourceRequestsMemory:         resource.MustParse("4Gi"),
+								corev1.ResourcePersistentVolumeClaims: resource.MustParse("2"),
+							},
+							Used: corev1.ResourceList{
+								corev1.ResourcePods:                   resource.MustParse("10"),
+								corev1.ResourceRequestsMemory:         resource.MustParse("1Gi"),
+								corev1.ResourcePersistentVolumeClaims: resource.MustParse("2"),
+							},
+						},
+					},
+				}, nil)
+				client.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).Return(nil, &meta.NoKindMatchError{})
+				return client
+			},
+			want: []Problem{
+				{
+					Check:   CheckPermissions,
+					Message: `You are not allowed to create Deployments in the namespace "my-ns"`,
+					Hint:    "Ask your cluster administrator for the edit role in the namespace, or select another namespace with `odo set namespace`",
+				},
+				{
+					Check:   CheckQuota,
+					Message: `The quota "compute" of the namespace "my-ns" is exhausted for pods (used: 10, hard: 10)`,
+					Hint:    "Delete unused resources from the namespace (for example components with `odo delete component`), or ask your cluster administrator to increase the quota",
+				},
+				{
+					Check:   CheckQuota,
+					Message: `The quota "compute" of the namespace "my-ns" is exhausted for persistentvolumeclaims (used: 2, hard: 2)`,
+					Hint:    "Delete unused resources from the namespace (for example components with `odo delete component`), or ask your cluster administrator to increase the quota",
+					Warning: true,
+				},
+				{
+					Check:   CheckResources,
+					Message: `The kind "Database" defined in the component "db" is not supported by the cluster`,
+					Hint:    "Install the Operator or the CustomResourceDefinition providing this kind on the cluster",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetTimeout().Return(time.Second).AnyTimes()
+
+			ctx := odocontext.WithEffectiveDevfileObj(context.Background(), tt.devfileObj)
+			ctx = odocontext.WithDevfilePath(ctx, "/path/to/devfile.yaml")
+
+			o := NewPreflightClient(tt.kubeClient(ctrl), prefClient, filesystem.NewFakeFs())
+			got := o.Run(ctx, tt.mode)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PreflightClient.Run() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreflightClient_checkRegistryCredentials(t *testing.T) {
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{
+		Data: []byte(`schemaVersion: 2.2.0
+metadata:
+  name: my-component
+components:
+- name: backend
+  image:
+    imageName: quay.io/my-org/backend:1.0
+    dockerfile:
+      uri: ./Dockerfile
+- name: frontend
+  image:
+    imageName: ghcr.io/my-org/frontend
+    dockerfile:
+      uri: ./Dockerfile
+- name: worker
+  image:
+    imageName: my-user/worker
+    dockerfile:
+      uri: ./Dockerfile
+`),
+		FlattenedDevfile:   pointer.Bool(false),
+		SetBooleanDefaults: pointer.Bool(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		authFile string
+		want     []string
+	}{
+		{
+			name: "no credentials file",
+			want: []string{"docker.io", "ghcr.io", "quay.io"},
+		},
+		{
+			name:     "credentials for registries and repositories",
+			authFile: `{"auths": {"quay.io/my-org": {}, "https://index.docker.io/v1/": {}}}`,
+			want:     []string{"ghcr.io"},
+		},
+		{
+			name:     "credentials helper for a registry",
+			authFile: `{"credHelpers": {"ghcr.io": "gh"}}`,
+			want:     []string{"docker.io", "quay.io"},
+		},
+		{
+			name:     "credentials store for all registries",
+			authFile: `{"credsStore": "desktop"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			dir := t.TempDir()
+			authFile := filepath.Join(dir, "auth.json")
+			t.Setenv("REGISTRY_AUTH_FILE", authFile)
+			t.Setenv("XDG_RUNTIME_DIR", "")
+			t.Setenv("HOME", dir)
+			t.Setenv("DOCKER_CONFIG", dir)
+			if tt.authFile != "" {
+				if err := fs.WriteFile(authFile, []byte(tt.authFile), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			o := NewPreflightClient(nil, nil, fs)
+			var got []string
+			for _, p := range o.checkRegistryCredentials(devfileObj) {
+				if p.Check != CheckRegistry || !p.Warning {
+					t.Errorf("unexpected problem %+v", p)
+				}
+				got = append(got, p.Message[len("No credentials found for the image registry "):])
+			}
+			var want []string
+			for _, r := range tt.want {
+				want = append(want, `"`+r+`"`)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("checkRegistryCredentials() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseImageName(t *testing.T) {
+	tests := []struct {
+		imageName      string
+		wantRegistry   string
+		wantRepository string
+	}{
+		{imageName: "nginx", wantRegistry: "docker.io", wantRepository: "docker.io/library/nginx"},
+		{imageName: "my-user/app:1.0", wantRegistry: "docker.io", wantRepository: "docker.io/my-user/app"},
+		{imageName: "quay.io/my-org/app:latest", wantRegistry: "quay.io", wantRepository: "quay.io/my-org/app"},
+		{imageName: "localhost:5000/app", wantRegistry: "localhost:5000", wantRepository: "localhost:5000/app"},
+		{imageName: "localhost/app@sha256:abcd", wantRegistry: "localhost", wantRepository: "localhost/app"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.imageName, func(t *testing.T) {
+			registry, repository := parseImageName(tt.imageName)
+			if registry != tt.wantRegistry || repository != tt.wantRepository {
+				t.Errorf("parseImageName() = (%q, %q), want (%q, %q)", registry, repository, tt.wantRegistry, tt.wantRepository)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	err := Report([]Problem{
+		{Check: CheckRegistry, Message: "No credentials", Warning: true},
+		{Check: CheckNamespace, Message: `The namespace "my-ns" does not exist`, Hint: "Create it"},
+		{Check: CheckResources, Message: `The kind "Database" is not supported`},
+	})
+	want := `2 pre-flight check(s) failed:
+  - The namespace "my-ns" does not exist
+    Hint: Create it
+  - The kind "Database" is not supported`
+	if err == nil || err.Error() != want {
+		t.Errorf("Report() = %v, want %s", err, want)
+	}
+
+	if err = Report([]Problem{{Check: CheckRegistry, Message: "No credentials", Warning: true}}); err != nil {
+		t.Errorf("Report() with only warnings = %v, want nil", err)
+	}
+}
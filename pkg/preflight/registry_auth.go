@@ -0,0 +1,100 @@
+package preflight
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const dockerHubRegistry = "docker.io"
+
+// authFile is the content of a Podman auth.json or Docker config.json file
+type authFile struct {
+	Auths       map[string]json.RawMessage `json:"auths"`
+	CredHelpers map[string]string          `json:"credHelpers"`
+	CredsStore  string                     `json:"credsStore"`
+}
+
+// registryAuths are the registries and repositories for which credentials are defined
+type registryAuths struct {
+	keys []string
+	// credsStore is true if a credentials store is used for all the registries
+	credsStore bool
+}
+
+// getRegistryAuths reads the credentials files used by Podman and Docker
+func getRegistryAuths(fs filesystem.Filesystem) registryAuths {
+	var result registryAuths
+	for _, path := range getAuthFilePaths() {
+		content, err := fs.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var f authFile
+		if err = json.Unmarshal(content, &f); err != nil {
+			klog.V(4).Infof("unable to parse credentials file %q: %v", path, err)
+			continue
+		}
+		for key := range f.Auths {
+			result.keys = append(result.keys, normalizeAuthKey(key))
+		}
+		for key := range f.CredHelpers {
+			result.keys = append(result.keys, normalizeAuthKey(key))
+		}
+		if f.CredsStore != "" {
+			result.credsStore = true
+		}
+	}
+	return result
+}
+
+// hasCredentials returns true if credentials are defined for the registry or the repository
+func (o registryAuths) hasCredentials(registry, repository string) bool {
+	if o.credsStore {
+		return true
+	}
+	for _, key := range o.keys {
+		if key == registry || key == repository || strings.HasPrefix(repository, key+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// getAuthFilePaths returns the paths of the credentials files used by Podman and Docker
+func getAuthFilePaths() []string {
+	var paths []string
+	if p := os.Getenv("REGISTRY_AUTH_FILE"); p != "" {
+		paths = append(paths, p)
+	}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		paths = append(paths, filepath.Join(dir, "containers", "auth.json"))
+	}
+	home, err := os.UserHomeDir()
+	if err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "containers", "auth.json"))
+	}
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		paths = append(paths, filepath.Join(dir, "config.json"))
+	} else if err == nil {
+		paths = append(paths, filepath.Join(home, ".docker", "config.json"))
+	}
+	return paths
+}
+
+// normalizeAuthKey removes the scheme and the Docker Hub API path from a key of a credentials file
+func normalizeAuthKey(key string) string {
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	key = strings.TrimSuffix(key, "/")
+	switch key {
+	case "index.docker.io/v1", "index.docker.io", "registry-1.docker.io":
+		return dockerHubRegistry
+	}
+	return strings.TrimPrefix(key, "index.docker.io/")
+}
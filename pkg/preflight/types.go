@@ -0,0 +1,57 @@
+package preflight
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// Problem is a problem found by a pre-flight check
+type Problem struct {
+	// Check is the name of the check having found the problem
+	Check string
+	// Message describes the problem
+	Message string
+	// Hint describes how to fix the problem
+	Hint string
+	// Warning is true if the problem may not prevent the command to succeed
+	Warning bool
+}
+
+// ChecksFailedError is returned when pre-flight checks have found blocking problems
+type ChecksFailedError struct {
+	Problems []Problem
+}
+
+func (e ChecksFailedError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d pre-flight check(s) failed:", len(e.Problems))
+	for _, p := range e.Problems {
+		fmt.Fprintf(&sb, "\n  - %s", p.Message)
+		if p.Hint != "" {
+			fmt.Fprintf(&sb, "\n    Hint: %s", p.Hint)
+		}
+	}
+	return sb.String()
+}
+
+// Report displays the warnings and returns a ChecksFailedError listing all the blocking problems, or nil if there is none
+func Report(problems []Problem) error {
+	var blocking []Problem
+	for _, p := range problems {
+		if !p.Warning {
+			blocking = append(blocking, p)
+			continue
+		}
+		msg := p.Message
+		if p.Hint != "" {
+			msg += ". " + p.Hint
+		}
+		log.Warning(msg)
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+	return ChecksFailedError{Problems: blocking}
+}
@@ -0,0 +1,135 @@
+package prompt
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// answersClient reads the answers from a YAML file, indexed by the names of the questions.
+// The answer to a question asked several times is a list, whose elements are the successive answers.
+// The answer to a MultiSelect question is a list of options (or a list of lists if asked several times).
+// When a question has no answer, its default value is used if any.
+type answersClient struct {
+	file    string
+	answers map[string]interface{}
+	// asked counts how many times each question has been asked
+	asked map[string]int
+}
+
+var _ Client = (*answersClient)(nil)
+
+func newAnswersClient(file string) (*answersClient, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the answers file: %w", err)
+	}
+	answers := map[string]interface{}{}
+	if err = yaml.Unmarshal(content, &answers); err != nil {
+		return nil, fmt.Errorf("unable to parse the answers file %s: %w", file, err)
+	}
+	return &answersClient{
+		file:    file,
+		answers: answers,
+		asked:   map[string]int{},
+	}, nil
+}
+
+// next returns the next answer to the question, or false if there are no more answers
+func (o *answersClient) next(question Question) (interface{}, bool) {
+	value, ok := o.answers[question.Name]
+	if !ok {
+		return nil, false
+	}
+	n := o.asked[question.Name]
+	o.asked[question.Name]++
+	if list, isList := value.([]interface{}); isList {
+		if n >= len(list) {
+			return nil, false
+		}
+		return list[n], true
+	}
+	if n > 0 {
+		return nil, false
+	}
+	return value, true
+}
+
+func (o *answersClient) noAnswerError(question Question) error {
+	if o.asked[question.Name] > 1 {
+		return fmt.Errorf("no more answers to %q (%s) in the answers file %s", question.Name, question.Message, o.file)
+	}
+	return fmt.Errorf("no answer to %q (%s) in the answers file %s", question.Name, question.Message, o.file)
+}
+
+func (o *answersClient) Select(question Question, options []string, defaultOption string) (int, error) {
+	value, ok := o.next(question)
+	if !ok {
+		if defaultOption == "" {
+			return 0, o.noAnswerError(question)
+		}
+		value = defaultOption
+	}
+	return o.indexOf(question, options, fmt.Sprint(value))
+}
+
+func (o *answersClient) MultiSelect(question Question, options []string) ([]int, error) {
+	value, ok := o.answers[question.Name]
+	if !ok {
+		return nil, o.noAnswerError(question)
+	}
+	// A list of lists contains the answers to successive questions
+	if list, isList := value.([]interface{}); isList && len(list) > 0 {
+		if _, isListOfLists := list[0].([]interface{}); isListOfLists {
+			if value, ok = o.next(question); !ok {
+				return nil, o.noAnswerError(question)
+			}
+		}
+	}
+	values, isList := value.([]interface{})
+	if !isList {
+		return nil, fmt.Errorf("the answer to %q in the answers file %s must be a list", question.Name, o.file)
+	}
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		index, err := o.indexOf(question, options, fmt.Sprint(v))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, index)
+	}
+	return result, nil
+}
+
+func (o *answersClient) Input(question Question, defaultValue string) (string, error) {
+	value, ok := o.next(question)
+	if !ok {
+		return defaultValue, nil
+	}
+	return fmt.Sprint(value), nil
+}
+
+func (o *answersClient) Confirm(question Question, defaultValue bool) (bool, error) {
+	value, ok := o.next(question)
+	if !ok {
+		return defaultValue, nil
+	}
+	answer, err := strconv.ParseBool(fmt.Sprint(value))
+	if err != nil {
+		return false, fmt.Errorf("the answer to %q in the answers file %s must be a boolean: %w", question.Name, o.file, err)
+	}
+	return answer, nil
+}
+
+func (o *answersClient) indexOf(question Question, options []string, answer string) (int, error) {
+	for i, option := range options {
+		if option == answer {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid answer %q to %q in the answers file %s, possible values are: %s",
+		answer, question.Name, o.file, strings.Join(options, ", "))
+}
@@ -0,0 +1,148 @@
+package prompt
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestAnswersClient(t *testing.T, content string) *answersClient {
+	file := filepath.Join(t.TempDir(), "answers.yaml")
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client, err := newAnswersClient(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestAnswersClient_Select(t *testing.T) {
+	options := []string{"Go", "Java", "Python"}
+	tests := []struct {
+		name          string
+		content       string
+		defaultOption string
+		want          []int
+		wantErr       bool
+	}{
+		{
+			name:    "single answer",
+			content: "language: Java",
+			want:    []int{1},
+		},
+		{
+			name:    "successive answers",
+			content: "language: [Python, Go]",
+			want:    []int{2, 0},
+		},
+		{
+			name:          "default option when no more answers",
+			content:       "language: Python",
+			defaultOption: "Java",
+			want:          []int{2, 1},
+		},
+		{
+			name:    "no answer and no default option",
+			content: "other: value",
+			wantErr: true,
+		},
+		{
+			name:    "invalid answer",
+			content: "language: Rust",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestAnswersClient(t, tt.content)
+			question := Question{Name: "language", Message: "What language?"}
+			if tt.wantErr {
+				if _, err := client.Select(question, options, tt.defaultOption); err == nil {
+					t.Errorf("Select() expected an error")
+				}
+				return
+			}
+			var got []int
+			for range tt.want {
+				index, err := client.Select(question, options, tt.defaultOption)
+				if err != nil {
+					t.Fatalf("Select() unexpected error: %v", err)
+				}
+				got = append(got, index)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Select() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnswersClient_MultiSelect(t *testing.T) {
+	options := []string{"a", "b", "c"}
+	question := Question{Name: "options"}
+
+	client := newTestAnswersClient(t, "options: [c, a]")
+	got, err := client.MultiSelect(question, options)
+	if err != nil {
+		t.Fatalf("MultiSelect() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{2, 0}, got); diff != "" {
+		t.Errorf("MultiSelect() mismatch (-want +got):\n%s", diff)
+	}
+
+	client = newTestAnswersClient(t, "options: [[b], [a, c]]")
+	for _, want := range [][]int{{1}, {0, 2}} {
+		got, err = client.MultiSelect(question, options)
+		if err != nil {
+			t.Fatalf("MultiSelect() unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("MultiSelect() mismatch (-want +got):\n%s", diff)
+		}
+	}
+	if _, err = client.MultiSelect(question, options); err == nil {
+		t.Errorf("MultiSelect() expected an error when no more answers")
+	}
+}
+
+func TestAnswersClient_InputAndConfirm(t *testing.T) {
+	client := newTestAnswersClient(t, "name: my-app\ncorrect: false\ninvalid: maybe")
+
+	name, err := client.Input(Question{Name: "name"}, "default")
+	if err != nil || name != "my-app" {
+		t.Errorf("Input() = %q, %v, want %q", name, err, "my-app")
+	}
+	name, err = client.Input(Question{Name: "name"}, "default")
+	if err != nil || name != "default" {
+		t.Errorf("Input() = %q, %v, want the default value", name, err)
+	}
+
+	correct, err := client.Confirm(Question{Name: "correct"}, true)
+	if err != nil || correct {
+		t.Errorf("Confirm() = %v, %v, want false", correct, err)
+	}
+	correct, err = client.Confirm(Question{Name: "missing"}, true)
+	if err != nil || !correct {
+		t.Errorf("Confirm() = %v, %v, want the default value", correct, err)
+	}
+	if _, err = client.Confirm(Question{Name: "invalid"}, true); err == nil {
+		t.Errorf("Confirm() expected an error for a non-boolean answer")
+	}
+}
+
+func TestNoTerminalClient(t *testing.T) {
+	question := Question{Name: "name", Message: "Enter component name:"}
+	_, err := noTerminalClient{}.Input(question, "")
+	var noTerminalErr *NoTerminalError
+	if !errors.As(err, &noTerminalErr) {
+		t.Fatalf("Input() error = %v, want a NoTerminalError", err)
+	}
+	if noTerminalErr.Question != question {
+		t.Errorf("NoTerminalError.Question = %v, want %v", noTerminalErr.Question, question)
+	}
+}
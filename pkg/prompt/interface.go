@@ -0,0 +1,14 @@
+package prompt
+
+// Client asks questions to the user
+type Client interface {
+	// Select asks the user to select one of the options, and returns the index of the option selected.
+	// defaultOption is the option selected by default, if not empty.
+	Select(question Question, options []string, defaultOption string) (int, error)
+	// MultiSelect asks the user to select any number of options, and returns the indexes of the options selected
+	MultiSelect(question Question, options []string) ([]int, error)
+	// Input asks the user to enter a value
+	Input(question Question, defaultValue string) (string, error)
+	// Confirm asks the user a yes/no question
+	Confirm(question Question, defaultValue bool) (bool, error)
+}
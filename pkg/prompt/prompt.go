@@ -0,0 +1,69 @@
+// Package prompt asks questions to the user.
+//
+// When the standard input is a terminal, the questions are displayed and answered interactively.
+// Otherwise, the answers are read from an answers file if one is provided, or a NoTerminalError is returned
+// so that the command can indicate the flags to use instead.
+package prompt
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// AnswersFileFlagName is the name of the flag of the commands asking questions, to read the answers from a file
+const AnswersFileFlagName = "answers-file"
+
+// Question is a question asked to the user
+type Question struct {
+	// Name identifies the question in the answers file
+	Name string
+	// Message is the question displayed to the user
+	Message string
+	// Help is an optional help message displayed to the user
+	Help string
+}
+
+// NewClient returns a client reading the answers from answersFile if not empty,
+// asking the questions interactively if the standard input is a terminal,
+// or returning a NoTerminalError for every question otherwise.
+func NewClient(answersFile string) (Client, error) {
+	if answersFile != "" {
+		return newAnswersClient(answersFile)
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return noTerminalClient{}, nil
+	}
+	return NewSurveyClient(), nil
+}
+
+// NoTerminalError is returned when a question cannot be asked because the standard input is not a terminal
+type NoTerminalError struct {
+	Question Question
+}
+
+func (e *NoTerminalError) Error() string {
+	return fmt.Sprintf("unable to ask %q: the standard input is not a terminal", e.Question.Message)
+}
+
+// noTerminalClient returns a NoTerminalError for every question
+type noTerminalClient struct{}
+
+var _ Client = noTerminalClient{}
+
+func (noTerminalClient) Select(question Question, _ []string, _ string) (int, error) {
+	return 0, &NoTerminalError{Question: question}
+}
+
+func (noTerminalClient) MultiSelect(question Question, _ []string) ([]int, error) {
+	return nil, &NoTerminalError{Question: question}
+}
+
+func (noTerminalClient) Input(question Question, _ string) (string, error) {
+	return "", &NoTerminalError{Question: question}
+}
+
+func (noTerminalClient) Confirm(question Question, _ bool) (bool, error) {
+	return false, &NoTerminalError{Question: question}
+}
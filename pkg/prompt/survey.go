@@ -0,0 +1,61 @@
+package prompt
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// SurveyClient asks the questions interactively in the terminal
+type SurveyClient struct{}
+
+var _ Client = (*SurveyClient)(nil)
+
+func NewSurveyClient() *SurveyClient {
+	return &SurveyClient{}
+}
+
+func (o *SurveyClient) Select(question Question, options []string, defaultOption string) (int, error) {
+	prompt := &survey.Select{
+		Message: question.Message,
+		Help:    question.Help,
+		Options: options,
+	}
+	if defaultOption != "" {
+		prompt.Default = defaultOption
+	}
+	var answer int
+	err := survey.AskOne(prompt, &answer)
+	return answer, err
+}
+
+func (o *SurveyClient) MultiSelect(question Question, options []string) ([]int, error) {
+	prompt := &survey.MultiSelect{
+		Message: question.Message,
+		Help:    question.Help,
+		Options: options,
+	}
+	answer := []int{}
+	err := survey.AskOne(prompt, &answer)
+	return answer, err
+}
+
+func (o *SurveyClient) Input(question Question, defaultValue string) (string, error) {
+	prompt := &survey.Input{
+		Message: question.Message,
+		Help:    question.Help,
+		Default: defaultValue,
+	}
+	var answer string
+	err := survey.AskOne(prompt, &answer)
+	return answer, err
+}
+
+func (o *SurveyClient) Confirm(question Question, defaultValue bool) (bool, error) {
+	prompt := &survey.Confirm{
+		Message: question.Message,
+		Help:    question.Help,
+		Default: defaultValue,
+	}
+	var answer bool
+	err := survey.AskOne(prompt, &answer)
+	return answer, err
+}
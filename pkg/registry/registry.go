@@ -86,7 +86,7 @@ func (o RegistryClient) DownloadStarterProject(starterProject *devfilev1.Starter
 		return containsDevfile, err
 	}
 	if containsDevfile {
-		fmt.Println()
+		log.Println()
 		log.Warning("A Devfile is present inside the starter project; replacing the entire content of the current directory with the starter project")
 		err = removeDirectoryContents(contextDir, o.fsys)
 		if err != nil {
@@ -119,7 +119,7 @@ func (o RegistryClient) DownloadStarterProject(starterProject *devfilev1.Starter
 	if err != nil {
 		return containsDevfile, err
 	}
-	fmt.Println()
+	log.Println()
 	log.Warningf("There are conflicting files (%s) between starter project and the current directory, hence the starter project has been copied to %s", strings.Join(conflictingFiles, ", "), conflictingDirPath)
 
 	return containsDevfile, nil
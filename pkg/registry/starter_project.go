@@ -11,8 +11,12 @@ import (
 	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"k8s.io/klog"
 
+	"github.com/redhat-developer/odo/pkg/credentials"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -28,6 +32,16 @@ func checkoutProject(subDir, zipURL, path, starterToken string, fsys filesystem.
 		subDir = "/"
 	}
 	err := util.GetAndExtractZip(zipURL, path, subDir, starterToken, fsys)
+	if err != nil && starterToken == "" {
+		// The zip file may require authentication, try again with the credentials found for its URL
+		creds, lookupErr := credentials.Lookup(zipURL)
+		if lookupErr != nil {
+			klog.V(4).Infof("unable to get the credentials for %q: %v", zipURL, lookupErr)
+		}
+		if creds != nil {
+			err = util.GetAndExtractZip(zipURL, path, subDir, creds.Password, fsys)
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("failed to download and extract project zip folder: %w", err)
 	}
@@ -138,6 +152,8 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 			Username: RegistryUser,
 			Password: starterToken,
 		}
+	} else {
+		cloneOptions.Auth = getSSHAuth(remoteUrl)
 	}
 
 	originalPath := ""
@@ -149,7 +165,7 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 		}
 	}
 
-	_, err = git.PlainClone(path, false, cloneOptions)
+	err = cloneWithCredentials(path, cloneOptions)
 
 	if err != nil {
 
@@ -163,7 +179,7 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 		cloneOptions.ReferenceName = plumbing.NewTagReferenceName(revision)
 		// remove if any .git folder downloaded in above try
 		_ = os.RemoveAll(filepath.Join(path, ".git"))
-		_, err = git.PlainClone(path, false, cloneOptions)
+		err = cloneWithCredentials(path, cloneOptions)
 		if err != nil {
 			return err
 		}
@@ -190,3 +206,66 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 	return nil
 
 }
+
+// cloneWithCredentials clones the repository in path. If the repository requires authentication
+// and no authentication is set in cloneOptions, it clones it again with the credentials found for its URL,
+// and keeps these credentials in cloneOptions.
+func cloneWithCredentials(path string, cloneOptions *git.CloneOptions) error {
+	_, err := git.PlainClone(path, false, cloneOptions)
+	if err == nil || cloneOptions.Auth != nil || !isAuthenticationError(err) {
+		return err
+	}
+	creds, lookupErr := credentials.Lookup(cloneOptions.URL)
+	if lookupErr != nil {
+		klog.V(4).Infof("unable to get the credentials for %q: %v", cloneOptions.URL, lookupErr)
+	}
+	if creds == nil {
+		return fmt.Errorf("%w\nsave the credentials for the repository with `odo preference add credential`, or in a git credential helper", err)
+	}
+	username := creds.Username
+	if username == "" {
+		username = RegistryUser
+	}
+	cloneOptions.Auth = &http.BasicAuth{
+		Username: username,
+		Password: creds.Password,
+	}
+	_ = os.RemoveAll(filepath.Join(path, ".git"))
+	_, err = git.PlainClone(path, false, cloneOptions)
+	return err
+}
+
+// isAuthenticationError returns true if the error may be caused by a missing authentication;
+// hosts can answer that a private repository is not found to unauthenticated users
+func isAuthenticationError(err error) bool {
+	return errors.Is(err, transport.ErrAuthenticationRequired) ||
+		errors.Is(err, transport.ErrAuthorizationFailed) ||
+		errors.Is(err, transport.ErrRepositoryNotFound)
+}
+
+// getSSHAuth returns the authentication method for an SSH git URL when no SSH agent is running,
+// using the first private key found in the ~/.ssh directory.
+// It returns nil for other URLs, or when an SSH agent is running as it is used by default.
+func getSSHAuth(remoteUrl string) transport.AuthMethod {
+	endpoint, err := transport.NewEndpoint(remoteUrl)
+	if err != nil || endpoint.Protocol != "ssh" || os.Getenv("SSH_AUTH_SOCK") != "" {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	for _, name := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+		keyFile := filepath.Join(home, ".ssh", name)
+		if _, err = os.Stat(keyFile); err != nil {
+			continue
+		}
+		auth, err := ssh.NewPublicKeysFromFile(endpoint.User, keyFile, "")
+		if err != nil {
+			klog.V(4).Infof("unable to use the SSH key %q: %v", keyFile, err)
+			continue
+		}
+		return auth
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+)
+
+func Test_getSSHAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		remoteUrl   string
+		withKey     bool
+		sshAuthSock string
+		wantAuth    bool
+	}{
+		{
+			name:      "HTTPS URL",
+			remoteUrl: "https://github.com/org/repo.git",
+			withKey:   true,
+		},
+		{
+			name:      "SCP-like URL with a key",
+			remoteUrl: "git@github.com:org/repo.git",
+			withKey:   true,
+			wantAuth:  true,
+		},
+		{
+			name:      "SSH URL with a key",
+			remoteUrl: "ssh://git@github.com/org/repo.git",
+			withKey:   true,
+			wantAuth:  true,
+		},
+		{
+			name:      "SSH URL without key",
+			remoteUrl: "git@github.com:org/repo.git",
+		},
+		{
+			name:        "SSH URL with an SSH agent running",
+			remoteUrl:   "git@github.com:org/repo.git",
+			withKey:     true,
+			sshAuthSock: "/tmp/agent.sock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			t.Setenv("USERPROFILE", home)
+			t.Setenv("SSH_AUTH_SOCK", tt.sshAuthSock)
+			if tt.withKey {
+				writePrivateKey(t, filepath.Join(home, ".ssh", "id_rsa"))
+			}
+
+			got := getSSHAuth(tt.remoteUrl)
+			if (got != nil) != tt.wantAuth {
+				t.Fatalf("getSSHAuth() = %v, wantAuth %v", got, tt.wantAuth)
+			}
+			if got == nil {
+				return
+			}
+			keys, ok := got.(*ssh.PublicKeys)
+			if !ok {
+				t.Fatalf("getSSHAuth() returned %T, want *ssh.PublicKeys", got)
+			}
+			if keys.User != "git" {
+				t.Errorf("getSSHAuth() user = %q, want %q", keys.User, "git")
+			}
+		})
+	}
+}
+
+func Test_isAuthenticationError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: transport.ErrAuthenticationRequired, want: true},
+		{err: transport.ErrAuthorizationFailed, want: true},
+		{err: fmt.Errorf("cloning: %w", transport.ErrRepositoryNotFound), want: true},
+		{err: transport.ErrEmptyRemoteRepository, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			if got := isAuthenticationError(tt.err); got != tt.want {
+				t.Errorf("isAuthenticationError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func writePrivateKey(t *testing.T, path string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
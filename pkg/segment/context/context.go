@@ -38,6 +38,7 @@ const (
 	PlatformVersion         = "platformVersion"
 	PreferenceParameter     = "parameter"
 	PreferenceValue         = "value"
+	ReceivedSignal          = "receivedSignal"
 )
 
 const (
@@ -184,7 +185,7 @@ func SetTelemetryStatus(ctx context.Context, isEnabled bool) {
 }
 
 func SetSignal(ctx context.Context, signal os.Signal) {
-	setContextProperty(ctx, "receivedSignal", signal.String())
+	setContextProperty(ctx, ReceivedSignal, signal.String())
 }
 
 func SetDevfileName(ctx context.Context, devfileName string) {
@@ -0,0 +1,50 @@
+package segment
+
+import (
+	"github.com/redhat-developer/odo/pkg/api"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+const (
+	identifyEvent = "identify"
+	trackEvent    = "track"
+)
+
+// telemetryFields lists the fields sent by Upload. It must be kept in sync with Upload, addConfigTraits and the properties set by the segment context package.
+var telemetryFields = []api.TelemetryField{
+	{Name: "userId", Event: identifyEvent, Description: "Random anonymous ID, stored in " + GetTelemetryFilePath()},
+	{Name: "os", Event: identifyEvent, Description: "Operating system"},
+	{Name: "timezone", Event: identifyEvent, Description: "Time zone, relative to UTC"},
+	{Name: "locale", Event: identifyEvent, Description: "Locale"},
+	{Name: "event", Event: trackEvent, Description: "Command executed, without its arguments"},
+	{Name: "version", Event: trackEvent, Description: "Version of odo"},
+	{Name: "success", Event: trackEvent, Description: "Whether the command succeeded"},
+	{Name: "duration(ms)", Event: trackEvent, Description: "Duration of the command, in milliseconds"},
+	{Name: "duration-bucket", Event: trackEvent, Description: "Range of durations containing the duration of the command"},
+	{Name: "tty", Event: trackEvent, Description: "Whether the command was run from a terminal"},
+	{Name: "error", Event: trackEvent, Description: "Error message, with user names, file paths, URLs and executed commands replaced by " + Sanitizer},
+	{Name: "error-type", Event: trackEvent, Description: "Go type of the error"},
+	{Name: "error-code", Event: trackEvent, Description: "Code identifying the error, or " + ErrorCodeUnknown},
+	{Name: scontext.Caller, Event: trackEvent, Description: "Tool calling odo (vscode, intellij or jboss), empty if odo is run from the command line"},
+	{Name: scontext.PreviousTelemetryStatus, Event: trackEvent, Description: "Whether telemetry was enabled before the command was run"},
+	{Name: scontext.Flags, Event: trackEvent, Description: "Names of the flags passed to the command, without their values"},
+	{Name: scontext.ExperimentalMode, Event: trackEvent, Description: "Whether the experimental mode is enabled"},
+	{Name: scontext.InteractiveMode, Event: trackEvent, Description: "Whether the command was run in interactive mode"},
+	{Name: scontext.ComponentType, Event: trackEvent, Description: "Type of the component, as defined in the Devfile metadata"},
+	{Name: scontext.DevfileName, Event: trackEvent, Description: "Name of the component"},
+	{Name: scontext.Language, Event: trackEvent, Description: "Language of the Devfile stack"},
+	{Name: scontext.ProjectType, Event: trackEvent, Description: "Project type of the Devfile stack"},
+	{Name: scontext.Platform, Event: trackEvent, Description: "Platform used (cluster or podman)"},
+	{Name: scontext.PlatformVersion, Event: trackEvent, Description: "Version of the platform"},
+	{Name: scontext.ClusterType, Event: trackEvent, Description: "Type of the cluster (kubernetes, openshift3 or openshift4)"},
+	{Name: scontext.PreferenceParameter, Event: trackEvent, Description: "Name of the preference set or unset"},
+	{Name: scontext.PreferenceValue, Event: trackEvent, Description: "Value of the preference set, anonymized except for non-sensitive preferences"},
+	{Name: scontext.ReceivedSignal, Event: trackEvent, Description: "Signal which interrupted the command"},
+}
+
+// GetTelemetryFields returns the fields which can be sent to telemetry, when telemetry is enabled
+func GetTelemetryFields() []api.TelemetryField {
+	result := make([]api.TelemetryField, len(telemetryFields))
+	copy(result, telemetryFields)
+	return result
+}
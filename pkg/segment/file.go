@@ -0,0 +1,68 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/segmentio/analytics-go.v3"
+)
+
+// fileClient is an analytics.Client appending the messages to a local file, one JSON document per line,
+// for organizations auditing the telemetry data instead of sending it to Segment
+type fileClient struct {
+	path string
+	now  func() time.Time
+}
+
+var _ analytics.Client = (*fileClient)(nil)
+
+func newFileClient(path string) *fileClient {
+	return &fileClient{
+		path: path,
+		now:  time.Now,
+	}
+}
+
+// Enqueue appends the message to the file, setting its type and timestamp as the Segment client does
+func (o *fileClient) Enqueue(msg analytics.Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	switch m := msg.(type) {
+	case analytics.Identify:
+		m.Type = "identify"
+		m.Timestamp = o.now()
+		msg = m
+	case analytics.Track:
+		m.Type = "track"
+		m.Timestamp = o.now()
+		msg = m
+	default:
+		return fmt.Errorf("unsupported message type %T", msg)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(o.path), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(o.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// Close does nothing, as messages are written when they are enqueued
+func (o *fileClient) Close() error {
+	return nil
+}
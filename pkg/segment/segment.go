@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -52,13 +53,15 @@ const (
 )
 
 type TelemetryProperties struct {
-	Duration      int64                  `json:"duration"`
-	Error         string                 `json:"error"`
-	ErrorType     string                 `json:"errortype"`
-	Success       bool                   `json:"success"`
-	Tty           bool                   `json:"tty"`
-	Version       string                 `json:"version"`
-	CmdProperties map[string]interface{} `json:"cmdProperties"`
+	Duration       int64                  `json:"duration"`
+	DurationBucket string                 `json:"durationBucket"`
+	Error          string                 `json:"error"`
+	ErrorType      string                 `json:"errortype"`
+	ErrorCode      string                 `json:"errorCode"`
+	Success        bool                   `json:"success"`
+	Tty            bool                   `json:"tty"`
+	Version        string                 `json:"version"`
+	CmdProperties  map[string]interface{} `json:"cmdProperties"`
 }
 
 type TelemetryData struct {
@@ -73,11 +76,31 @@ type Client struct {
 	TelemetryFilePath string
 }
 
-// NewClient returns a Client created with the default args
-func NewClient() (*Client, error) {
+// NewClient returns a Client sending the data to endpoint, or to Segment if endpoint is empty.
+// The endpoint is either the URL of a Segment-compatible collector, or a file:// URL
+// of a local file to which the data is appended.
+func NewClient(endpoint string) (*Client, error) {
+	if endpoint == "" {
+		endpoint = analytics.DefaultEndpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "file" {
+		path := u.Path
+		if runtime.GOOS == "windows" && len(path) > 2 && path[0] == '/' && path[2] == ':' {
+			// file:///C:/path/to/file
+			path = path[1:]
+		}
+		return &Client{
+			SegmentClient:     newFileClient(filepath.FromSlash(path)),
+			TelemetryFilePath: GetTelemetryFilePath(),
+		}, nil
+	}
 	return newCustomClient(
 		GetTelemetryFilePath(),
-		analytics.DefaultEndpoint,
+		endpoint,
 	)
 }
 
@@ -147,10 +170,13 @@ func (c *Client) Upload(ctx context.Context, data TelemetryData) error {
 	properties = properties.Set("version", data.Properties.Version).
 		Set("success", data.Properties.Success).
 		Set("duration(ms)", data.Properties.Duration).
+		Set("duration-bucket", data.Properties.DurationBucket).
 		Set("tty", data.Properties.Tty)
 	// in case the command executed unsuccessfully, add information about the error in the data
 	if data.Properties.Error != "" {
-		properties = properties.Set("error", data.Properties.Error).Set("error-type", data.Properties.ErrorType)
+		properties = properties.Set("error", data.Properties.Error).
+			Set("error-type", data.Properties.ErrorType).
+			Set("error-code", data.Properties.ErrorCode)
 	}
 
 	// send the Identify message data that helps identify the user on segment
@@ -254,6 +280,51 @@ func ErrorType(err error) string {
 	return fmt.Sprintf("%T", err)
 }
 
+// ErrorCoder is implemented by errors providing a stable code identifying them,
+// independently of the message and of the wrapping of the error
+type ErrorCoder interface {
+	ErrorCode() string
+}
+
+// ErrorCodeUnknown is the code of errors not implementing ErrorCoder
+const ErrorCodeUnknown = "unknown"
+
+// ErrorCode returns the code of the first error in the chain implementing ErrorCoder,
+// or ErrorCodeUnknown if there is none
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	var coder ErrorCoder
+	if errors.As(err, &coder) {
+		return coder.ErrorCode()
+	}
+	return ErrorCodeUnknown
+}
+
+// durationBuckets are the upper bounds of the buckets in which the durations of the commands are recorded
+var durationBuckets = []struct {
+	max   time.Duration
+	label string
+}{
+	{time.Second, "<1s"},
+	{5 * time.Second, "1s-5s"},
+	{30 * time.Second, "5s-30s"},
+	{time.Minute, "30s-1m"},
+	{5 * time.Minute, "1m-5m"},
+	{30 * time.Minute, "5m-30m"},
+}
+
+// DurationBucket returns the label of the bucket containing the duration, so that durations can be aggregated in histograms
+func DurationBucket(d time.Duration) string {
+	for _, bucket := range durationBuckets {
+		if d < bucket.max {
+			return bucket.label
+		}
+	}
+	return ">30m"
+}
+
 // RunningInTerminal checks if odo was run from a terminal
 func RunningInTerminal() bool {
 	return term.IsTerminal(int(os.Stdin.Fd()))
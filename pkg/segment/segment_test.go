@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"os/user"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -36,12 +37,14 @@ type segmentResponse struct {
 			OS string `json:"os"`
 		} `json:"traits"`
 		Properties struct {
-			Error         string `json:"error"`
-			ErrorType     string `json:"error-type"`
-			Success       bool   `json:"success"`
-			Version       string `json:"version"`
-			ComponentType string `json:"componentType"`
-			ClusterType   string `json:"clusterType"`
+			Error          string `json:"error"`
+			ErrorType      string `json:"error-type"`
+			ErrorCode      string `json:"error-code"`
+			DurationBucket string `json:"duration-bucket"`
+			Success        bool   `json:"success"`
+			Version        string `json:"version"`
+			ComponentType  string `json:"componentType"`
+			ClusterType    string `json:"clusterType"`
 		} `json:"properties"`
 		Type string `json:"type"`
 	} `json:"batch"`
@@ -104,6 +107,7 @@ func TestClientUploadWithConsent(t *testing.T) {
 		err      error
 		success  bool
 		errType  string
+		errCode  string
 		version  string
 	}{
 		{
@@ -118,6 +122,15 @@ func TestClientUploadWithConsent(t *testing.T) {
 			err:      errors.New("some error occurred"),
 			success:  false,
 			errType:  "*errors.errorString",
+			errCode:  ErrorCodeUnknown,
+			version:  version.VERSION,
+		},
+		{
+			testName: "command failed with a coded error",
+			err:      fmt.Errorf("wrapped: %w", codedError{}),
+			success:  false,
+			errType:  "segment.codedError",
+			errCode:  "coded-error",
 			version:  version.VERSION,
 		},
 	}
@@ -175,6 +188,12 @@ func TestClientUploadWithConsent(t *testing.T) {
 				if s.Batch[1].Properties.ErrorType != tt.errType {
 					t.Error("Error Type does not match")
 				}
+				if s.Batch[1].Properties.ErrorCode != tt.errCode {
+					t.Errorf("Error Code does not match: expected %q, got %q", tt.errCode, s.Batch[1].Properties.ErrorCode)
+				}
+				if s.Batch[1].Properties.DurationBucket != "1s-5s" {
+					t.Errorf("Duration bucket does not match: got %q", s.Batch[1].Properties.DurationBucket)
+				}
 				if !strings.Contains(s.Batch[1].Properties.Version, version.VERSION) {
 					t.Error("Odo version does not match")
 				}
@@ -477,6 +496,149 @@ func Test_sanitizeUserInfo(t *testing.T) {
 }
 
 // createConfigDir creates a mock filesystem
+func TestClientUploadToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "telemetry.jsonl")
+	c, err := NewClient("file://" + filepath.ToSlash(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.TelemetryFilePath = createConfigDir(t)
+
+	ctx := scontext.NewContext(context.Background())
+	scontext.SetTelemetryStatus(ctx, true)
+	for _, cmd := range []string{"odo init", "odo dev"} {
+		if err = c.Upload(ctx, fakeTelemetryData(cmd, nil, ctx)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	// each Upload writes an identify and a track message
+	wantTypes := []string{"identify", "track", "identify", "track"}
+	if len(lines) != len(wantTypes) {
+		t.Fatalf("expected %d lines, got %d: %s", len(wantTypes), len(lines), content)
+	}
+	for i, line := range lines {
+		var msg struct {
+			Type       string                 `json:"type"`
+			Event      string                 `json:"event"`
+			Properties map[string]interface{} `json:"properties"`
+		}
+		if err = json.Unmarshal([]byte(line), &msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Type != wantTypes[i] {
+			t.Errorf("line %d: expected type %q, got %q", i, wantTypes[i], msg.Type)
+		}
+		if msg.Type == "track" && msg.Properties["version"] != version.VERSION {
+			t.Errorf("line %d: expected version %q, got %v", i, version.VERSION, msg.Properties["version"])
+		}
+	}
+}
+
+func TestNewClientToCollector(t *testing.T) {
+	body, server := mockServer()
+	defer server.Close()
+	defer close(body)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.TelemetryFilePath = createConfigDir(t)
+
+	ctx := scontext.NewContext(context.Background())
+	scontext.SetTelemetryStatus(ctx, true)
+	if err = c.Upload(ctx, fakeTelemetryData("odo init", nil, ctx)); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-body:
+	default:
+		t.Error("collector should receive data")
+	}
+}
+
+type codedError struct{}
+
+func (codedError) Error() string {
+	return "coded error"
+}
+
+func (codedError) ErrorCode() string {
+	return "coded-error"
+}
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "no error",
+			err:  nil,
+			want: "",
+		},
+		{
+			name: "error without code",
+			err:  errors.New("an error"),
+			want: ErrorCodeUnknown,
+		},
+		{
+			name: "error with code",
+			err:  codedError{},
+			want: "coded-error",
+		},
+		{
+			name: "wrapped error with code",
+			err:  fmt.Errorf("first: %w", fmt.Errorf("second: %w", codedError{})),
+			want: "coded-error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCode(tt.err); got != tt.want {
+				t.Errorf("ErrorCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationBucket(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: 0, want: "<1s"},
+		{d: 999 * time.Millisecond, want: "<1s"},
+		{d: time.Second, want: "1s-5s"},
+		{d: 10 * time.Second, want: "5s-30s"},
+		{d: 45 * time.Second, want: "30s-1m"},
+		{d: 2 * time.Minute, want: "1m-5m"},
+		{d: 10 * time.Minute, want: "5m-30m"},
+		{d: 30 * time.Minute, want: ">30m"},
+		{d: 3 * time.Hour, want: ">30m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.d.String(), func(t *testing.T) {
+			if got := DurationBucket(tt.d); got != tt.want {
+				t.Errorf("DurationBucket() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func createConfigDir(t *testing.T) string {
 	fs := filesystem.NewFakeFs()
 	configDir, err := fs.TempDir(os.TempDir(), "telemetry")
@@ -491,13 +653,15 @@ func fakeTelemetryData(cmd string, err error, ctx context.Context) TelemetryData
 	return TelemetryData{
 		Event: cmd,
 		Properties: TelemetryProperties{
-			Duration:      time.Second.Milliseconds(),
-			Error:         SetError(err),
-			ErrorType:     ErrorType(err),
-			Success:       err == nil,
-			Tty:           RunningInTerminal(),
-			Version:       version.VERSION,
-			CmdProperties: scontext.GetContextProperties(ctx),
+			Duration:       time.Second.Milliseconds(),
+			DurationBucket: DurationBucket(time.Second),
+			Error:          SetError(err),
+			ErrorType:      ErrorType(err),
+			ErrorCode:      ErrorCode(err),
+			Success:        err == nil,
+			Tty:            RunningInTerminal(),
+			Version:        version.VERSION,
+			CmdProperties:  scontext.GetContextProperties(ctx),
 		},
 	}
 }
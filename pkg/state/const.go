@@ -3,3 +3,4 @@ package state
 const _dirpath = "./.odo"
 const _filepath = "./.odo/devstate.json"
 const _filepathPid = "./.odo/devstate.%d.json"
+const _filepathPreferredPorts = "./.odo/ports.json"
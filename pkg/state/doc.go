@@ -2,4 +2,6 @@
 // The state of an instance is stored in a file .odo/devstate.${PID}.json.
 // For compatibility with previous versions of odo, the `devstate.json` file contains
 // the state of the first instance of odo.
+// The local ports forwarded to the endpoints of the components are stored in a file .odo/ports.json,
+// kept across sessions so the next sessions forward the same local ports.
 package state
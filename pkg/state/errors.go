@@ -17,3 +17,8 @@ func NewErrAlreadyRunningOnPlatform(platform string, pid int) ErrAlreadyRunningO
 func (e ErrAlreadyRunningOnPlatform) Error() string {
 	return fmt.Sprintf("a session with PID %d is already running on platform %q", e.pid, e.platform)
 }
+
+// ErrorCode returns the code identifying the error in telemetry
+func (e ErrAlreadyRunningOnPlatform) ErrorCode() string {
+	return "already-running"
+}
@@ -16,6 +16,48 @@ type Client interface {
 	// GetForwardedPorts returns the ports forwarded by the current odo dev session
 	GetForwardedPorts(ctx context.Context) ([]api.ForwardedPort, error)
 
-	// SaveExit resets the state file to indicate odo is not running
+	// GetPreferredPorts returns the local ports forwarded to the ports of the containers of the component by previous sessions
+	GetPreferredPorts(ctx context.Context, componentName string) (PreferredPorts, error)
+
+	// SetPreferredPorts records the local ports forwarded to the ports of the containers of the component,
+	// so the next sessions forward the same local ports. The ports previously recorded for other container ports are kept.
+	SetPreferredPorts(ctx context.Context, componentName string, fwPorts []api.ForwardedPort) error
+
+	// RecordBuild records the result of the execution of the build command in the state file
+	RecordBuild(ctx context.Context, buildErr error) error
+
+	// RecordTests records the result of an execution of the test command in the state file
+	RecordTests(ctx context.Context, testsErr error) error
+
+	// RecordPush records a push of the sources in the state file, with the number of synced and deleted files
+	// and the error returned by the push, if any
+	RecordPush(ctx context.Context, syncedFiles int, deletedFiles int, pushErr error) error
+
+	// SetWatchHealth records the health of the files watcher in the state file
+	SetWatchHealth(ctx context.Context, watching bool, watchErr error) error
+
+	// SetResourceUsage records the last resource usage of the containers of the component in the state file
+	SetResourceUsage(ctx context.Context, usage api.ResourceUsage) error
+
+	// GetSessions returns the status of the odo dev sessions running from the current directory, for each platform
+	GetSessions(ctx context.Context) ([]api.DevSessionStatus, error)
+
+	// GetLastSession returns the status of the last ended odo dev session started from the current directory,
+	// on the platform, or nil if none
+	GetLastSession(ctx context.Context) (*api.DevSessionStatus, error)
+
+	// SaveExit resets the state file to indicate odo is not running. The status of the session is kept
+	// as the last session, so it can still be displayed after the session ends.
+	// Only the first call to SaveExit or RecordDirtyShutdown records the final state of the session,
+	// the state is not modified anymore after
 	SaveExit(ctx context.Context) error
+
+	// RecordDirtyShutdown records in the state file that the session ends without having completed
+	// the cleanup of its resources, so the next session on the same platform can reconcile them.
+	// It does nothing if the final state of the session has already been recorded by SaveExit
+	RecordDirtyShutdown(ctx context.Context, reason error) error
+
+	// PopDirtyShutdown returns the previous session on the current platform which ended without completing
+	// the cleanup of its resources, if any, and removes the marker from the state file
+	PopDirtyShutdown(ctx context.Context) (*DirtyShutdown, error)
 }
@@ -9,6 +9,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -18,8 +21,14 @@ import (
 )
 
 type State struct {
+	// mu protects content and the writes of the state files, as the state is updated
+	// concurrently by the watch loop and the port forwarding
+	mu      sync.Mutex
 	content Content
 	fs      filesystem.Filesystem
+	// ended is true once the final state of the session has been recorded by SaveExit or RecordDirtyShutdown,
+	// the state is not modified anymore after
+	ended bool
 }
 
 var _ Client = (*State)(nil)
@@ -31,6 +40,8 @@ func NewStateClient(fs filesystem.Filesystem) *State {
 }
 
 func (o *State) Init(ctx context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	var (
 		pid      = odocontext.GetPID(ctx)
 		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
@@ -46,6 +57,8 @@ func (o *State) SetForwardedPorts(ctx context.Context, fwPorts []api.ForwardedPo
 		pid      = odocontext.GetPID(ctx)
 		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	// TODO(feloy) When other data is persisted into the state file, it will be needed to read the file first
 	o.content.ForwardedPorts = fwPorts
 	o.content.PID = pid
@@ -78,13 +91,202 @@ func (o *State) GetForwardedPorts(ctx context.Context) ([]api.ForwardedPort, err
 	return result, nil
 }
 
+func (o *State) GetPreferredPorts(ctx context.Context, componentName string) (PreferredPorts, error) {
+	content, err := o.readPreferredPorts()
+	if err != nil {
+		return nil, err
+	}
+	return content[componentName], nil
+}
+
+func (o *State) SetPreferredPorts(ctx context.Context, componentName string, fwPorts []api.ForwardedPort) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	content, err := o.readPreferredPorts()
+	if err != nil {
+		return err
+	}
+	ports := content[componentName]
+	for _, fwPort := range fwPorts {
+		preferred := PreferredPort{
+			ContainerName: fwPort.ContainerName,
+			ContainerPort: fwPort.ContainerPort,
+			LocalPort:     fwPort.LocalPort,
+		}
+		found := false
+		for i := range ports {
+			if ports[i].ContainerName == preferred.ContainerName && ports[i].ContainerPort == preferred.ContainerPort {
+				ports[i] = preferred
+				found = true
+				break
+			}
+		}
+		if !found {
+			ports = append(ports, preferred)
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].ContainerName != ports[j].ContainerName {
+			return ports[i].ContainerName < ports[j].ContainerName
+		}
+		return ports[i].ContainerPort < ports[j].ContainerPort
+	})
+	content[componentName] = ports
+
+	jsonContent, err := json.MarshalIndent(content, "", " ")
+	if err != nil {
+		return err
+	}
+	err = o.fs.MkdirAll(_dirpath, 0750)
+	if err != nil {
+		return err
+	}
+	return o.fs.WriteFile(_filepathPreferredPorts, jsonContent, 0644)
+}
+
+// readPreferredPorts returns the content of the .odo/ports.json file, or an empty content if the file does not exist
+func (o *State) readPreferredPorts() (preferredPortsContent, error) {
+	content := preferredPortsContent{}
+	jsonContent, err := o.fs.ReadFile(_filepathPreferredPorts)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return content, nil
+		}
+		return nil, err
+	}
+	// Ignore error, to handle empty or corrupted file
+	if err = json.Unmarshal(jsonContent, &content); err != nil || content == nil {
+		return preferredPortsContent{}, nil
+	}
+	return content, nil
+}
+
+func (o *State) RecordBuild(ctx context.Context, buildErr error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	result := api.CommandResult{
+		Time:    time.Now(),
+		Success: buildErr == nil,
+	}
+	if buildErr != nil {
+		result.Error = buildErr.Error()
+	}
+	o.content.Status.LastBuild = &result
+	return o.saveStatus(ctx)
+}
+
+func (o *State) RecordTests(ctx context.Context, testsErr error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	result := api.CommandResult{
+		Time:    time.Now(),
+		Success: testsErr == nil,
+	}
+	if testsErr != nil {
+		result.Error = testsErr.Error()
+		o.content.Status.FailedTestRuns++
+	}
+	o.content.Status.LastTests = &result
+	o.content.Status.TestRuns++
+	return o.saveStatus(ctx)
+}
+
+func (o *State) RecordPush(ctx context.Context, syncedFiles int, deletedFiles int, pushErr error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := time.Now()
+	o.content.Status.LastPush = &now
+	o.content.Status.Pushes++
+	o.content.Status.LastPushError = ""
+	if pushErr != nil {
+		o.content.Status.FailedPushes++
+		o.content.Status.LastPushError = pushErr.Error()
+	}
+	o.content.Status.SyncedFiles += syncedFiles
+	o.content.Status.DeletedFiles += deletedFiles
+	return o.saveStatus(ctx)
+}
+
+func (o *State) SetWatchHealth(ctx context.Context, watching bool, watchErr error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.content.Status.Watch = api.WatchHealth{
+		Watching: watching,
+	}
+	if watchErr != nil {
+		o.content.Status.Watch.Error = watchErr.Error()
+	}
+	return o.saveStatus(ctx)
+}
+
+func (o *State) SetResourceUsage(ctx context.Context, usage api.ResourceUsage) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.content.Status.ResourceUsage = &usage
+	return o.saveStatus(ctx)
+}
+
+// saveStatus saves the content, after the status has been modified. The caller must hold o.mu
+func (o *State) saveStatus(ctx context.Context) error {
+	var (
+		pid      = odocontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+	o.content.PID = pid
+	o.content.Platform = platform
+	return o.save(ctx, pid)
+}
+
+func (o *State) GetSessions(ctx context.Context) ([]api.DevSessionStatus, error) {
+	var (
+		result   []api.DevSessionStatus
+		platform = fcontext.GetPlatform(ctx, "")
+	)
+
+	contents, err := o.readAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, content := range contents {
+		if content.Platform == "" || (platform != "" && content.Platform != platform) {
+			continue
+		}
+		if content.PID != 0 {
+			exists, err := pidExists(content.PID)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				// The process ended without resetting its state file (e.g. killed), the session is not running
+				continue
+			}
+		}
+		result = append(result, api.DevSessionStatus{
+			Platform:       content.Platform,
+			PID:            content.PID,
+			ForwardedPorts: content.ForwardedPorts,
+			DevStatus:      content.Status,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Platform < result[j].Platform
+	})
+	return result, nil
+}
+
 func (o *State) SaveExit(ctx context.Context) error {
 	var (
 		pid = odocontext.GetPID(ctx)
 	)
-	o.content.ForwardedPorts = nil
-	o.content.PID = 0
-	o.content.Platform = ""
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.ended {
+		return nil
+	}
+	o.ended = true
+	o.content = Content{
+		LastSession: o.lastSession(ctx),
+	}
 	err := o.delete(pid)
 	if err != nil {
 		return err
@@ -92,8 +294,116 @@ func (o *State) SaveExit(ctx context.Context) error {
 	return o.saveCommonIfOwner(pid)
 }
 
-// save writes the content structure in json format in file
+func (o *State) RecordDirtyShutdown(ctx context.Context, reason error) error {
+	var (
+		pid      = odocontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.ended {
+		return nil
+	}
+	o.ended = true
+	// The session is not running anymore, as after SaveExit
+	o.content = Content{
+		LastSession: o.lastSession(ctx),
+	}
+	err := o.saveCommonIfOwner(pid)
+	if err != nil {
+		return err
+	}
+
+	// The file of the process is kept after it exits, to be found by the next session
+	o.content.PID = pid
+	o.content.Platform = platform
+	o.content.DirtyShutdown = &DirtyShutdown{
+		PID:    pid,
+		Time:   time.Now(),
+		Reason: reason.Error(),
+	}
+	return o.writeStateFile(getFilename(pid))
+}
+
+// lastSession returns the status of the session, to be recorded when it ends. The caller must hold o.mu
+func (o *State) lastSession(ctx context.Context) *api.DevSessionStatus {
+	return &api.DevSessionStatus{
+		Platform:  fcontext.GetPlatform(ctx, commonflags.PlatformCluster),
+		PID:       odocontext.GetPID(ctx),
+		DevStatus: o.content.Status,
+	}
+}
+
+func (o *State) GetLastSession(ctx context.Context) (*api.DevSessionStatus, error) {
+	platform := fcontext.GetPlatform(ctx, "")
+	jsonContent, err := o.fs.ReadFile(_filepath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var content Content
+	// Ignore error, to handle empty file
+	_ = json.Unmarshal(jsonContent, &content)
+	if content.LastSession == nil || (platform != "" && content.LastSession.Platform != platform) {
+		return nil, nil
+	}
+	return content.LastSession, nil
+}
+
+func (o *State) PopDirtyShutdown(ctx context.Context) (*DirtyShutdown, error) {
+	var (
+		pid      = odocontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+	entries, err := o.fs.ReadDir(_dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var result *DirtyShutdown
+	re := regexp.MustCompile(`^devstate\.[0-9]*\.json$`)
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		filename := filepath.Join(_dirpath, entry.Name())
+		jsonContent, err := o.fs.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+		if content.DirtyShutdown == nil || content.Platform != platform || content.PID == pid {
+			continue
+		}
+		exists, err := pidExists(content.PID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			// the process is still running, its cleanup may still complete
+			continue
+		}
+		if result == nil || content.DirtyShutdown.Time.After(result.Time) {
+			result = content.DirtyShutdown
+		}
+		if err = o.fs.Remove(filename); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// save writes the content structure in json format in file. The caller must hold o.mu
 func (o *State) save(ctx context.Context, pid int) error {
+	if o.ended {
+		return nil
+	}
 
 	err := o.checkFirstInPlatform(ctx)
 	if err != nil {
@@ -155,6 +465,33 @@ func (o *State) read(platform string) (Content, error) {
 	return content, nil
 }
 
+// readAll returns the contents of all the devstate.${PID}.json files
+func (o *State) readAll() ([]Content, error) {
+	entries, err := o.fs.ReadDir(_dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var result []Content
+	re := regexp.MustCompile(`^devstate\.[0-9]*\.json$`)
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		jsonContent, err := o.fs.ReadFile(filepath.Join(_dirpath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+		result = append(result, content)
+	}
+	return result, nil
+}
+
 func (o *State) delete(pid int) error {
 	return o.fs.Remove(getFilename(pid))
 }
@@ -254,3 +591,8 @@ func (o *State) checkFirstInPlatform(ctx context.Context) error {
 	}
 	return nil
 }
+
+// GetStateFilePath returns the path of the file containing the state of the Dev session running from workingDir
+func GetStateFilePath(workingDir string) string {
+	return filepath.Join(workingDir, _filepath)
+}
@@ -3,12 +3,17 @@ package state
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
@@ -153,6 +158,55 @@ func TestState_SaveExit(t *testing.T) {
 	}
 }
 
+func TestState_SaveExitKeepsLastSession(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := State{
+		fs: fs,
+	}
+	ctx := odocontext.WithPID(context.Background(), 1)
+	ctx = fcontext.WithPlatform(ctx, commonflags.PlatformCluster)
+	if err := o.RecordPush(ctx, 2, 0, nil); err != nil {
+		t.Fatalf("State.RecordPush() error = %v", err)
+	}
+	if err := o.SetWatchHealth(ctx, false, errors.New("too many open files")); err != nil {
+		t.Fatalf("State.SetWatchHealth() error = %v", err)
+	}
+	if err := o.SaveExit(ctx); err != nil {
+		t.Fatalf("State.SaveExit() error = %v", err)
+	}
+
+	next := State{fs: fs}
+	sessions, err := next.GetSessions(context.Background())
+	if err != nil {
+		t.Fatalf("State.GetSessions() error = %v", err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("expected no running session after exit, got %+v", sessions)
+	}
+
+	got, err := next.GetLastSession(context.Background())
+	if err != nil {
+		t.Fatalf("State.GetLastSession() error = %v", err)
+	}
+	if got == nil {
+		t.Fatal("expected the last session to be kept after exit")
+	}
+	if got.Platform != commonflags.PlatformCluster || got.PID != 1 || got.Pushes != 1 || got.SyncedFiles != 2 {
+		t.Errorf("unexpected last session: %+v", got)
+	}
+	if got.Watch.Error != "too many open files" {
+		t.Errorf("expected the watch error to be kept, got %q", got.Watch.Error)
+	}
+
+	got, err = next.GetLastSession(fcontext.WithPlatform(context.Background(), commonflags.PlatformPodman))
+	if err != nil {
+		t.Fatalf("State.GetLastSession() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected no last session on podman, got %+v", got)
+	}
+}
+
 func TestState_GetForwardedPorts(t *testing.T) {
 	contentPodman := Content{
 		Platform: "podman",
@@ -281,3 +335,333 @@ func TestState_GetForwardedPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestState_RecordPushAndGetSessions(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := State{
+		fs: fs,
+	}
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+
+	if err := o.SetWatchHealth(ctx, true, nil); err != nil {
+		t.Fatalf("State.SetWatchHealth() error = %v", err)
+	}
+	if err := o.RecordBuild(ctx, errors.New("build failed")); err != nil {
+		t.Fatalf("State.RecordBuild() error = %v", err)
+	}
+	if err := o.RecordTests(ctx, errors.New("tests failed")); err != nil {
+		t.Fatalf("State.RecordTests() error = %v", err)
+	}
+	if err := o.RecordTests(ctx, nil); err != nil {
+		t.Fatalf("State.RecordTests() error = %v", err)
+	}
+	if err := o.RecordPush(ctx, 3, 1, errors.New("push failed")); err != nil {
+		t.Fatalf("State.RecordPush() error = %v", err)
+	}
+	if err := o.RecordPush(ctx, 2, 0, nil); err != nil {
+		t.Fatalf("State.RecordPush() error = %v", err)
+	}
+	if err := o.SetResourceUsage(ctx, api.ResourceUsage{Containers: []api.ContainerResourceUsage{{Name: "runtime", CPU: "250m", Memory: "128Mi"}}}); err != nil {
+		t.Fatalf("State.SetResourceUsage() error = %v", err)
+	}
+
+	got, err := o.GetSessions(ctx)
+	if err != nil {
+		t.Fatalf("State.GetSessions() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("State.GetSessions() returned %d sessions, expected 1", len(got))
+	}
+	session := got[0]
+	if session.PID != 1 || session.Platform != "cluster" {
+		t.Errorf("unexpected session PID %d and platform %q", session.PID, session.Platform)
+	}
+	if session.Pushes != 2 || session.FailedPushes != 1 || session.LastPushError != "" || session.LastPush == nil {
+		t.Errorf("unexpected push status: %+v", session.DevStatus)
+	}
+	if session.SyncedFiles != 5 || session.DeletedFiles != 1 {
+		t.Errorf("unexpected sync counters: synced %d, deleted %d", session.SyncedFiles, session.DeletedFiles)
+	}
+	if session.LastBuild == nil || session.LastBuild.Success || session.LastBuild.Error != "build failed" {
+		t.Errorf("unexpected last build: %+v", session.LastBuild)
+	}
+	if session.LastTests == nil || !session.LastTests.Success || session.TestRuns != 2 || session.FailedTestRuns != 1 {
+		t.Errorf("unexpected tests status: %+v, runs %d, failed %d", session.LastTests, session.TestRuns, session.FailedTestRuns)
+	}
+	if !session.Watch.Watching {
+		t.Errorf("watch should be healthy")
+	}
+	if session.ResourceUsage == nil || len(session.ResourceUsage.Containers) != 1 || session.ResourceUsage.Containers[0].CPU != "250m" {
+		t.Errorf("unexpected resource usage: %+v", session.ResourceUsage)
+	}
+
+	if err := o.SaveExit(ctx); err != nil {
+		t.Fatalf("State.SaveExit() error = %v", err)
+	}
+	got, err = o.GetSessions(ctx)
+	if err != nil {
+		t.Fatalf("State.GetSessions() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no session after exit, got %+v", got)
+	}
+}
+
+func TestState_ConcurrentWrites(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := State{
+		fs: fs,
+	}
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+
+	// The watch loop and the port forwarding update the state at the same time
+	const n = 20
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			if err := o.RecordPush(ctx, 1, 0, nil); err != nil {
+				t.Errorf("State.RecordPush() error = %v", err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			if err := o.SetWatchHealth(ctx, true, nil); err != nil {
+				t.Errorf("State.SetWatchHealth() error = %v", err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			if err := o.SetForwardedPorts(ctx, []api.ForwardedPort{{ContainerName: "runtime", LocalPort: 20001 + i, ContainerPort: 3000}}); err != nil {
+				t.Errorf("State.SetForwardedPorts() error = %v", err)
+			}
+		}
+	}()
+	wg.Wait()
+
+	got, err := o.GetSessions(ctx)
+	if err != nil {
+		t.Fatalf("State.GetSessions() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("State.GetSessions() returned %d sessions, expected 1", len(got))
+	}
+	session := got[0]
+	if session.Pushes != n || session.SyncedFiles != n {
+		t.Errorf("expected %d pushes and synced files, got %d and %d", n, session.Pushes, session.SyncedFiles)
+	}
+	if len(session.ForwardedPorts) != 1 || session.ForwardedPorts[0].LocalPort != 20000+n {
+		t.Errorf("unexpected forwarded ports: %+v", session.ForwardedPorts)
+	}
+	if !session.Watch.Watching {
+		t.Errorf("watch should be healthy")
+	}
+}
+
+func TestState_GetSessionsIgnoresDeadProcesses(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := State{
+		fs: fs,
+	}
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+	ctx = fcontext.WithPlatform(ctx, commonflags.PlatformPodman)
+	if err := o.SetWatchHealth(ctx, true, nil); err != nil {
+		t.Fatalf("State.SetWatchHealth() error = %v", err)
+	}
+
+	// State file left by a process killed without cleaning up its state
+	const deadPID = 99999999
+	jsonContent, _ := json.Marshal(Content{
+		PID:      deadPID,
+		Platform: commonflags.PlatformCluster,
+		Status: api.DevStatus{
+			Watch: api.WatchHealth{Watching: true},
+		},
+	})
+	if err := fs.WriteFile(getFilename(deadPID), jsonContent, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := o.GetSessions(context.Background())
+	if err != nil {
+		t.Fatalf("State.GetSessions() error = %v", err)
+	}
+	if len(got) != 1 || got[0].PID != 1 || got[0].Platform != commonflags.PlatformPodman {
+		t.Errorf("expected only the session of the running process, got %+v", got)
+	}
+}
+
+func TestState_PreferredPorts(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := NewStateClient(fs)
+	ctx := context.Background()
+
+	got, err := o.GetPreferredPorts(ctx, "my-component")
+	if err != nil || len(got) != 0 {
+		t.Fatalf("State.GetPreferredPorts() = %v, %v, expected no port", got, err)
+	}
+
+	err = o.SetPreferredPorts(ctx, "my-component", []api.ForwardedPort{
+		{ContainerName: "runtime", ContainerPort: 8080, LocalPort: 20001},
+		{ContainerName: "runtime", ContainerPort: 5858, LocalPort: 20002, IsDebug: true},
+	})
+	if err != nil {
+		t.Fatalf("State.SetPreferredPorts() error = %v", err)
+	}
+	err = o.SetPreferredPorts(ctx, "other-component", []api.ForwardedPort{
+		{ContainerName: "runtime", ContainerPort: 8080, LocalPort: 20003},
+	})
+	if err != nil {
+		t.Fatalf("State.SetPreferredPorts() error = %v", err)
+	}
+	// The ports of the endpoints not forwarded by this session (the debug one) are kept
+	err = o.SetPreferredPorts(ctx, "my-component", []api.ForwardedPort{
+		{ContainerName: "runtime", ContainerPort: 8080, LocalPort: 20005},
+	})
+	if err != nil {
+		t.Fatalf("State.SetPreferredPorts() error = %v", err)
+	}
+
+	got, err = o.GetPreferredPorts(ctx, "my-component")
+	if err != nil {
+		t.Fatalf("State.GetPreferredPorts() error = %v", err)
+	}
+	want := PreferredPorts{
+		{ContainerName: "runtime", ContainerPort: 5858, LocalPort: 20002},
+		{ContainerName: "runtime", ContainerPort: 8080, LocalPort: 20005},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("State.GetPreferredPorts() mismatch (-want +got):\n%s", diff)
+	}
+	if got.LocalPort("runtime", 8080) != 20005 || got.LocalPort("runtime", 3000) != 0 {
+		t.Errorf("unexpected local ports for %v", got)
+	}
+	if !got.IsPreferred(20002) || got.IsPreferred(20003) {
+		t.Errorf("unexpected preferred ports for %v", got)
+	}
+
+	got, err = o.GetPreferredPorts(ctx, "other-component")
+	if err != nil || got.LocalPort("runtime", 8080) != 20003 {
+		t.Errorf("State.GetPreferredPorts() = %v, %v, expected port 20003 for other-component", got, err)
+	}
+}
+
+func TestState_DirtyShutdown(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := State{
+		fs: fs,
+	}
+	const deadPID = 99999999
+	deadCtx := odocontext.WithPID(context.Background(), deadPID)
+	if err := o.SetForwardedPorts(deadCtx, []api.ForwardedPort{{ContainerName: "runtime", LocalPort: 20001, ContainerPort: 3000}}); err != nil {
+		t.Fatalf("State.SetForwardedPorts() error = %v", err)
+	}
+	if err := o.RecordDirtyShutdown(deadCtx, errors.New("cleanup timed out")); err != nil {
+		t.Fatalf("State.RecordDirtyShutdown() error = %v", err)
+	}
+
+	ports, err := o.GetForwardedPorts(context.Background())
+	if err != nil {
+		t.Fatalf("State.GetForwardedPorts() error = %v", err)
+	}
+	if len(ports) != 0 {
+		t.Errorf("expected no forwarded ports after a dirty shutdown, got %+v", ports)
+	}
+
+	// a session on another platform does not reconcile the resources
+	next := State{fs: fs}
+	podmanCtx := fcontext.WithPlatform(odocontext.WithPID(context.Background(), 1), commonflags.PlatformPodman)
+	got, err := next.PopDirtyShutdown(podmanCtx)
+	if err != nil {
+		t.Fatalf("State.PopDirtyShutdown() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected no dirty shutdown on podman, got %+v", got)
+	}
+
+	ctx := odocontext.WithPID(context.Background(), 1)
+	got, err = next.PopDirtyShutdown(ctx)
+	if err != nil {
+		t.Fatalf("State.PopDirtyShutdown() error = %v", err)
+	}
+	if got == nil || got.PID != deadPID || got.Reason != "cleanup timed out" {
+		t.Fatalf("unexpected dirty shutdown: %+v", got)
+	}
+
+	// the marker is removed once returned
+	got, err = next.PopDirtyShutdown(ctx)
+	if err != nil {
+		t.Fatalf("State.PopDirtyShutdown() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected the dirty shutdown to be returned only once, got %+v", got)
+	}
+}
+
+func TestState_FinalStateRecordedOnce(t *testing.T) {
+	const deadPID = 99999999
+	ctx := odocontext.WithPID(context.Background(), deadPID)
+	for _, tt := range []struct {
+		name      string
+		record    func(o *State) error
+		wantDirty bool
+	}{
+		{
+			name: "dirty shutdown recorded before the end of the cleanup",
+			record: func(o *State) error {
+				if err := o.RecordDirtyShutdown(ctx, errors.New("cleanup timed out")); err != nil {
+					return err
+				}
+				return o.SaveExit(ctx)
+			},
+			wantDirty: true,
+		},
+		{
+			name: "cleanup completed before the timeout",
+			record: func(o *State) error {
+				if err := o.SaveExit(ctx); err != nil {
+					return err
+				}
+				return o.RecordDirtyShutdown(ctx, errors.New("cleanup timed out"))
+			},
+			wantDirty: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			o := State{fs: fs}
+			if err := o.SetForwardedPorts(ctx, []api.ForwardedPort{{ContainerName: "runtime", LocalPort: 20001, ContainerPort: 3000}}); err != nil {
+				t.Fatalf("State.SetForwardedPorts() error = %v", err)
+			}
+			if err := tt.record(&o); err != nil {
+				t.Fatalf("unexpected error = %v", err)
+			}
+			// the state is not modified anymore once the final state is recorded
+			if err := o.RecordPush(ctx, 1, 0, nil); err != nil {
+				t.Fatalf("State.RecordPush() error = %v", err)
+			}
+
+			next := State{fs: fs}
+			got, err := next.PopDirtyShutdown(odocontext.WithPID(context.Background(), 1))
+			if err != nil {
+				t.Fatalf("State.PopDirtyShutdown() error = %v", err)
+			}
+			if (got != nil) != tt.wantDirty {
+				t.Errorf("expected dirty shutdown %v, got %+v", tt.wantDirty, got)
+			}
+			if !tt.wantDirty {
+				if _, err = fs.Stat(getFilename(deadPID)); !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("expected no state file after the end of the session, got %v", err)
+				}
+			}
+		})
+	}
+}
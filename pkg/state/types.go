@@ -1,6 +1,8 @@
 package state
 
 import (
+	"time"
+
 	"github.com/redhat-developer/odo/pkg/api"
 )
 
@@ -11,4 +13,53 @@ type Content struct {
 	Platform string `json:"platform"`
 	// ForwardedPorts are the ports forwarded during odo dev session
 	ForwardedPorts []api.ForwardedPort `json:"forwardedPorts"`
+	// Status is the history of the session
+	Status api.DevStatus `json:"status"`
+	// DirtyShutdown is set when the session ended without completing the cleanup of its resources
+	DirtyShutdown *DirtyShutdown `json:"dirtyShutdown,omitempty"`
+	// LastSession is the status of the last session, recorded when it ends
+	LastSession *api.DevSessionStatus `json:"lastSession,omitempty"`
+}
+
+// DirtyShutdown describes a session which ended without completing the cleanup of its resources
+type DirtyShutdown struct {
+	// PID is the ID of the process of the session
+	PID int `json:"pid"`
+	// Time is the time at which the session ended
+	Time time.Time `json:"time"`
+	// Reason explains why the cleanup could not complete
+	Reason string `json:"reason"`
+}
+
+// PreferredPort is a local port forwarded to a port of a container by a previous session
+type PreferredPort struct {
+	ContainerName string `json:"containerName"`
+	ContainerPort int    `json:"containerPort"`
+	LocalPort     int    `json:"localPort"`
 }
+
+// PreferredPorts are the local ports forwarded to the ports of the containers of a component by previous sessions
+type PreferredPorts []PreferredPort
+
+// LocalPort returns the local port forwarded to the containerPort of the container by a previous session, or 0 if none
+func (o PreferredPorts) LocalPort(containerName string, containerPort int) int {
+	for _, p := range o {
+		if p.ContainerName == containerName && p.ContainerPort == containerPort {
+			return p.LocalPort
+		}
+	}
+	return 0
+}
+
+// IsPreferred returns true if port is the local port forwarded to a port of a container by a previous session
+func (o PreferredPorts) IsPreferred(port int) bool {
+	for _, p := range o {
+		if p.LocalPort == port {
+			return true
+		}
+	}
+	return false
+}
+
+// preferredPortsContent is the content of the .odo/ports.json file, indexed by component name
+type preferredPortsContent map[string]PreferredPorts
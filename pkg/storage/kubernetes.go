@@ -35,34 +35,44 @@ func (k kubernetesClient) Create(storage Storage) error {
 		return fmt.Errorf("the component name and the app name should be provided")
 	}
 
-	pvcName, err := generatePVCName(storage.Name, k.componentName, k.appName)
+	pvc, err := GetPVC(storage, k.componentName, k.appName, k.runtime, k.client.GetCurrentNamespace())
 	if err != nil {
 		return err
 	}
+	pvc.Labels = odolabels.AddExtra(pvc.Labels, k.extraLabels)
+	pvc.Annotations = odolabels.AddExtra(pvc.Annotations, k.extraAnnotations)
 
-	labels := odolabels.GetLabels(k.componentName, k.appName, k.runtime, odolabels.ComponentDevMode, false)
+	// Create PVC
+	klog.V(2).Infof("Creating a PVC with name %v and labels %v", pvc.Name, pvc.Labels)
+	_, err = k.client.CreatePVC(*pvc)
+	if err != nil {
+		return fmt.Errorf("unable to create PVC: %w", err)
+	}
+	return nil
+}
+
+// GetPVC returns the PVC created in the namespace for the given Storage of the component
+func GetPVC(storage Storage, componentName, appName, runtime, namespace string) (*corev1.PersistentVolumeClaim, error) {
+	pvcName, err := generatePVCName(storage.Name, componentName, appName)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := odolabels.GetLabels(componentName, appName, runtime, odolabels.ComponentDevMode, false)
 	odolabels.AddStorageInfo(labels, storage.Name, strings.Contains(storage.Name, OdoSourceVolume))
 
-	objectMeta := generator.GetObjectMeta(pvcName, k.client.GetCurrentNamespace(), labels, nil)
+	objectMeta := generator.GetObjectMeta(pvcName, namespace, labels, nil)
 
 	quantity, err := resource.ParseQuantity(storage.Spec.Size)
 	if err != nil {
-		return fmt.Errorf("unable to parse size: %v: %w", storage.Spec.Size, err)
+		return nil, fmt.Errorf("unable to parse size: %v: %w", storage.Spec.Size, err)
 	}
 
 	pvcParams := generator.PVCParams{
 		ObjectMeta: objectMeta,
 		Quantity:   quantity,
 	}
-	pvc := generator.GetPVC(pvcParams)
-
-	// Create PVC
-	klog.V(2).Infof("Creating a PVC with name %v and labels %v", pvcName, labels)
-	_, err = k.client.CreatePVC(*pvc)
-	if err != nil {
-		return fmt.Errorf("unable to create PVC: %w", err)
-	}
-	return nil
+	return generator.GetPVC(pvcParams), nil
 }
 
 // Delete deletes the pvc belonging to the given Storage
@@ -26,15 +26,20 @@ const (
 
 // generic contains information required for all the Storage clients
 type generic struct {
-	appName       string
-	componentName string
-	runtime       string
+	appName          string
+	componentName    string
+	runtime          string
+	extraLabels      map[string]string
+	extraAnnotations map[string]string
 }
 
 type ClientOptions struct {
 	Client     kclient.ClientInterface
 	Deployment *v1.Deployment
 	Runtime    string
+	// ExtraLabels and ExtraAnnotations are added to the created PVCs
+	ExtraLabels      map[string]string
+	ExtraAnnotations map[string]string
 }
 
 type Client interface {
@@ -50,6 +55,8 @@ func NewClient(componentName string, appName string, options ClientOptions) Clie
 	genericInfo.componentName = componentName
 	genericInfo.appName = appName
 	genericInfo.runtime = options.Runtime
+	genericInfo.extraLabels = options.ExtraLabels
+	genericInfo.extraAnnotations = options.ExtraAnnotations
 
 	return kubernetesClient{
 		generic:    genericInfo,
@@ -26,7 +26,8 @@ import (
 // During copying binary components, localPath represent base directory path to binary and copyFiles contains path of binary
 // During copying local source components, localPath represent base directory path whereas copyFiles is empty
 // During `odo watch`, localPath represent base directory path whereas copyFiles contains list of changed Files
-func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, ret util.IndexerRet) error {
+// The CRLF line endings of the files matching textExtensions are converted to LF.
+func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, ret util.IndexerRet, textExtensions []string) error {
 
 	// Destination is set to "ToSlash" as all containers being ran within OpenShift / S2I are all
 	// Linux based and thus: "\opt\app-root\src" would not work correctly.
@@ -39,7 +40,7 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 	go func() {
 		defer writer.Close()
 
-		err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, filesystem.DefaultFs{})
+		err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, textExtensions, filesystem.DefaultFs{})
 		if err != nil {
 			log.Errorf("Error while creating tar: %#v", err)
 			os.Exit(1)
@@ -83,7 +84,7 @@ func checkFileExistWithFS(fileName string, fs filesystem.Filesystem) bool {
 
 // makeTar function is copied from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L309
 // srcPath is ignored if files is set
-func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExps []string, ret util.IndexerRet, fs filesystem.Filesystem) error {
+func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExps []string, ret util.IndexerRet, textExtensions []string, fs filesystem.Filesystem) error {
 	// TODO: use compression here?
 	tarWriter := taro.NewWriter(writer)
 	defer tarWriter.Close()
@@ -107,7 +108,7 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 
 			if checkFileExistWithFS(fileName, fs) {
 
-				rel, err := filepath.Rel(srcPath, fileName)
+				rel, err := util.RelativePath(srcPath, fileName)
 				if err != nil {
 					return err
 				}
@@ -130,7 +131,7 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 
 				// We use "FromSlash" to make this OS-based (Windows uses \, Linux & macOS use /)
 				// we get the relative path by joining the two
-				destFile, err := filepath.Rel(filepath.FromSlash(srcPath), filepath.FromSlash(fileAbsolutePath))
+				destFile, err := util.RelativePath(filepath.FromSlash(srcPath), filepath.FromSlash(fileAbsolutePath))
 				if err != nil {
 					return err
 				}
@@ -146,7 +147,7 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 				klog.V(4).Infof("makeTar destFile: %s", destFile)
 
 				// The file could be a regular file or even a folder, so use recursiveTar which handles symlinks, regular files and folders
-				err = linearTar(filepath.Dir(srcPath), srcFile, filepath.Dir(destPath), destFile, tarWriter, fs)
+				err = linearTar(filepath.Dir(srcPath), srcFile, filepath.Dir(destPath), destFile, tarWriter, textExtensions, fs)
 				if err != nil {
 					return err
 				}
@@ -158,7 +159,7 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 }
 
 // linearTar function is a modified version of https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L319
-func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, fs filesystem.Filesystem) error {
+func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, textExtensions []string, fs filesystem.Filesystem) error {
 	if destFile == "" {
 		return fmt.Errorf("linear Tar error, destFile cannot be empty")
 	}
@@ -205,6 +206,26 @@ func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, fs
 		if err := tw.WriteHeader(hdr); err != nil {
 			return err
 		}
+	} else if stat.Mode().IsRegular() && isTextFile(destFile, textExtensions) {
+		// case text file, whose line endings are converted
+		content, err := fs.ReadFile(joinedPath)
+		if err != nil {
+			return err
+		}
+		content = normalizeLineEndings(content)
+
+		hdr, err := taro.FileInfoHeader(stat, joinedPath)
+		if err != nil {
+			return err
+		}
+		hdr.Name = destFile
+		hdr.Size = int64(len(content))
+
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
 	} else {
 		// case regular file or other file type like pipe
 		hdr, err := taro.FileInfoHeader(stat, joinedPath)
@@ -130,7 +130,7 @@ func Test_linearTar(t *testing.T) {
 
 			go func() {
 				defer tarWriter.Close()
-				if err := linearTar(tt.args.srcBase, tt.args.srcFile, tt.args.destBase, tt.args.destFile, tarWriter, fs); (err != nil) != tt.wantErr {
+				if err := linearTar(tt.args.srcBase, tt.args.srcFile, tt.args.destBase, tt.args.destFile, tarWriter, nil, fs); (err != nil) != tt.wantErr {
 					t.Errorf("linearTar() error = %v, wantErr %v", err, tt.wantErr)
 				}
 			}()
@@ -152,6 +152,75 @@ func Test_linearTar(t *testing.T) {
 	}
 }
 
+func Test_linearTar_normalizeLineEndings(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	srcBase := filepath.Join("tmp", "dir1")
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "text file by extension",
+			file:     "main.GO",
+			content:  "package main\r\n\r\nfunc main() {}\r\n",
+			expected: "package main\n\nfunc main() {}\n",
+		},
+		{
+			name:     "text file by name",
+			file:     "Dockerfile",
+			content:  "FROM alpine\r\nRUN true\r\n",
+			expected: "FROM alpine\nRUN true\n",
+		},
+		{
+			name:     "file not matching the text extensions",
+			file:     "image.png",
+			content:  "\x89PNG\r\n",
+			expected: "\x89PNG\r\n",
+		},
+		{
+			name:     "binary file with a text extension",
+			file:     "data.txt",
+			content:  "a\x00b\r\n",
+			expected: "a\x00b\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := fs.WriteFile(filepath.Join(srcBase, tt.file), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			tarWriter := taro.NewWriter(&buf)
+			if err := linearTar(srcBase, tt.file, "", tt.file, tarWriter, DefaultTextExtensions, fs); err != nil {
+				t.Fatalf("linearTar() unexpected error: %v", err)
+			}
+			if err := tarWriter.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tarReader := taro.NewReader(&buf)
+			hdr, err := tarReader.Next()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := io.ReadAll(tarReader)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("content = %q, want %q", got, tt.expected)
+			}
+			if hdr.Size != int64(len(tt.expected)) {
+				t.Errorf("size = %d, want %d", hdr.Size, len(tt.expected))
+			}
+		})
+	}
+}
+
 func Test_makeTar(t *testing.T) {
 	fs := filesystem.NewFakeFs()
 
@@ -336,7 +405,7 @@ func Test_makeTar(t *testing.T) {
 			go func() {
 				defer tarWriter.Close()
 				wantErr := tt.wantErr
-				if err := makeTar(tt.args.srcPath, tt.args.destPath, writer, tt.args.files, tt.args.globExps, tt.args.ret, fs); (err != nil) != wantErr {
+				if err := makeTar(tt.args.srcPath, tt.args.destPath, writer, tt.args.files, tt.args.globExps, tt.args.ret, nil, fs); (err != nil) != wantErr {
 					t.Errorf("makeTar() error = %v, wantErr %v", err, tt.wantErr)
 					return
 				}
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"fmt"
 	"io"
 )
 
@@ -9,10 +10,22 @@ import (
 type ComponentInfo struct {
 	ComponentName string
 	PodName       string
+	// PodUID is the UID of the pod, if known. It is used to detect if the files recorded in the index
+	// have already been synced to the same pod, for example when restarting odo dev on an existing component
+	PodUID        string
 	ContainerName string
 	SyncFolder    string
 }
 
+// syncTarget returns an identifier of the container the files are synced to, to be recorded in the index,
+// or an empty string if the pod cannot be identified
+func (o ComponentInfo) syncTarget() string {
+	if o.PodUID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s:%s", o.PodUID, o.ContainerName, o.SyncFolder)
+}
+
 type SyncExtracter func(ComponentInfo, string, io.Reader) error
 
 // SyncParameters is a struct containing the parameters to be used when syncing a devfile component
@@ -25,6 +38,9 @@ type SyncParameters struct {
 	ForcePush                bool
 	CompInfo                 ComponentInfo
 	Files                    map[string]string
+	// TextExtensions are the extensions, or names, of the text files whose CRLF line endings are converted to LF
+	// when synced to the Linux containers. Line endings are not converted if empty.
+	TextExtensions []string
 }
 
 type Client interface {
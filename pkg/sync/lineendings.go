@@ -0,0 +1,42 @@
+package sync
+
+import (
+	"bytes"
+	"path"
+	"strings"
+)
+
+// DefaultTextExtensions are the extensions, or names, of the files considered as text files
+// whose line endings are converted when syncing files with --normalize-line-endings
+var DefaultTextExtensions = []string{
+	".bat", ".c", ".cfg", ".conf", ".cpp", ".cs", ".css", ".env", ".go", ".gradle", ".groovy", ".h", ".html",
+	".ini", ".java", ".js", ".json", ".jsx", ".kt", ".md", ".mjs", ".php", ".properties", ".py", ".rb", ".rs",
+	".sh", ".sql", ".toml", ".ts", ".tsx", ".txt", ".vue", ".xml", ".yaml", ".yml",
+	"Dockerfile", "Makefile",
+}
+
+// isTextFile returns true if the file matches one of the textExtensions. An entry starting with a dot
+// is compared case-insensitively with the extension of the file, other entries with the name of the file.
+func isTextFile(filename string, textExtensions []string) bool {
+	base := path.Base(filename)
+	ext := path.Ext(base)
+	for _, e := range textExtensions {
+		if strings.HasPrefix(e, ".") {
+			if strings.EqualFold(e, ext) {
+				return true
+			}
+		} else if e == base {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeLineEndings returns the content with the CRLF line endings converted to LF.
+// The content is returned unchanged if it contains a NUL byte, as it is a binary file despite its extension.
+func normalizeLineEndings(content []byte) []byte {
+	if bytes.IndexByte(content, 0) != -1 {
+		return content
+	}
+	return bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+}
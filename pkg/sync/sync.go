@@ -70,6 +70,9 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 	// thus the indexer doesn't need to run), false otherwise
 	indexRegeneratedByWatch := false
 
+	// True if all the files need to be synced
+	isForcePush := syncParameters.ForcePush
+
 	// If watch files are specified _and_ this is not the first call (by this process) to SyncFiles by the watch command, then insert the
 	// changed files into the existing file index, and delete removed files from the index
 	if isWatch && !syncParameters.DevfileScanIndexForWatch {
@@ -82,7 +85,7 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 
 		changedFiles = syncParameters.WatchFiles
 		deletedFiles = syncParameters.WatchDeletedFiles
-		deletedFiles, err = dfutil.RemoveRelativePathFromFiles(deletedFiles, syncParameters.Path)
+		deletedFiles, err = removeRelativePathFromFiles(deletedFiles, syncParameters.Path)
 		if err != nil {
 			return false, fmt.Errorf("unable to remove relative path from list of changed/deleted files: %w", err)
 		}
@@ -104,10 +107,19 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 			}
 		}
 
+		forcePush, targetChanged, err := checkSyncTarget(syncParameters)
+		if err != nil {
+			return false, err
+		}
+		if targetChanged {
+			// record the new target in the index, even if no file changed
+			forceWrite = true
+		}
+
 		// If the pod changed, reset the index, which will cause the indexer to walk the directory
 		// tree and resync all local files.
 		// If it is a new component, reset index to make sure any previously existing file is cleaned up
-		if syncParameters.ForcePush {
+		if forcePush {
 			err := util.DeleteIndexFile(syncParameters.Path)
 			if err != nil {
 				return false, fmt.Errorf("unable to reset the index file: %w", err)
@@ -115,7 +127,6 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 		}
 
 		// Run the indexer and find the modified/added/deleted/renamed files
-		var err error
 		ret, err = util.RunIndexerWithRemote(syncParameters.Path, syncParameters.IgnoredFiles, syncParameters.Files)
 
 		if err != nil {
@@ -139,21 +150,22 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 		changedFiles = filesChangedFiltered
 		klog.V(4).Infof("List of files changed: +%v", changedFiles)
 
-		if len(filesChangedFiltered) == 0 && len(filesDeletedFiltered) == 0 && !syncParameters.ForcePush {
+		if len(filesChangedFiltered) == 0 && len(filesDeletedFiltered) == 0 && !forcePush {
 			return false, nil
 		}
 
-		if syncParameters.ForcePush {
+		if forcePush {
 			deletedFiles = append(deletedFiles, "*")
 		}
+		isForcePush = forcePush
 	}
 
-	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret)
+	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, isForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret, syncParameters.TextExtensions)
 	if err != nil {
 		return false, fmt.Errorf("failed to sync to component with name %s: %w", syncParameters.CompInfo.ComponentName, err)
 	}
 	if forceWrite {
-		err = util.WriteFile(ret.NewFileMap, ret.ResolvedPath)
+		err = util.WriteFileWithSyncTarget(ret.NewFileMap, syncParameters.CompInfo.syncTarget(), ret.ResolvedPath)
 		if err != nil {
 			return false, fmt.Errorf("failed to write file: %w", err)
 		}
@@ -162,13 +174,44 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 	return true, nil
 }
 
+// checkSyncTarget compares the container the files are synced to with the one recorded in the index, and returns
+// whether all the files need to be synced, and whether the target is different from the one recorded in the index.
+//
+// When the index records that the files have been synced to the same pod, for example when odo dev is restarted
+// on an existing component, only the files changed since the last sync are pushed, even if a full sync is requested.
+// When the pod is different, for example if the pod has been recreated while odo dev was not running, all the files are synced.
+func checkSyncTarget(syncParameters SyncParameters) (forcePush bool, targetChanged bool, err error) {
+	target := syncParameters.CompInfo.syncTarget()
+	if target == "" {
+		return syncParameters.ForcePush, false, nil
+	}
+
+	indexFilePath, err := util.ResolveIndexFilePath(syncParameters.Path)
+	if err != nil {
+		return false, false, fmt.Errorf("unable to resolve path: %s: %w", syncParameters.Path, err)
+	}
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		return false, false, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+	}
+
+	if fileIndex.SyncTarget != target {
+		klog.V(4).Infof("files of the index have been synced to %q, syncing all files to %q", fileIndex.SyncTarget, target)
+		return true, true, nil
+	}
+	if syncParameters.ForcePush {
+		klog.V(4).Infof("files of the index have already been synced to %q, syncing only changed files", target)
+	}
+	return false, false, nil
+}
+
 // filterIgnores applies the gitignore rules on the filesChanged and filesDeleted and filters them
 // returns the filtered results which match any of the gitignore rules
 func filterIgnores(path string, filesChanged, filesDeleted, absIgnoreRules []string) (filesChangedFiltered, filesDeletedFiltered []string, err error) {
 	ignoreMatcher := gitignore.CompileIgnoreLines(absIgnoreRules...)
 	for _, file := range filesChanged {
 		// filesChanged are absoute paths
-		rel, err := filepath.Rel(path, file)
+		rel, err := util.RelativePath(path, file)
 		if err != nil {
 			return nil, nil, fmt.Errorf("path=%q, file=%q, %w", path, file, err)
 		}
@@ -189,7 +232,7 @@ func filterIgnores(path string, filesChanged, filesDeleted, absIgnoreRules []str
 }
 
 // pushLocal syncs source code from the user's disk to the component
-func (a SyncClient) pushLocal(ctx context.Context, path string, files []string, delFiles []string, isForcePush bool, globExps []string, compInfo ComponentInfo, ret util.IndexerRet) error {
+func (a SyncClient) pushLocal(ctx context.Context, path string, files []string, delFiles []string, isForcePush bool, globExps []string, compInfo ComponentInfo, ret util.IndexerRet, textExtensions []string) error {
 	klog.V(4).Infof("Push: componentName: %s, path: %s, files: %s, delFiles: %s, isForcePush: %+v", compInfo.ComponentName, path, files, delFiles, isForcePush)
 
 	// Edge case: check to see that the path is NOT empty.
@@ -231,7 +274,7 @@ func (a SyncClient) pushLocal(ctx context.Context, path string, files []string,
 
 	if isForcePush || len(files) > 0 {
 		klog.V(4).Infof("Copying files %s to pod", strings.Join(files, " "))
-		err = a.CopyFile(ctx, path, compInfo, syncFolder, files, globExps, ret)
+		err = a.CopyFile(ctx, path, compInfo, syncFolder, files, globExps, ret, textExtensions)
 		if err != nil {
 			return fmt.Errorf("unable push files to pod: %w", err)
 		}
@@ -280,6 +323,15 @@ func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
 		}
 		delete(fileIndex.Files, relativePath)
 		klog.V(4).Infof("Removing watch deleted file from index: %s", relativePath)
+
+		// When a directory is deleted, only the directory is reported, the files it contained need to be removed too
+		dirPrefix := relativePath + string(filepath.Separator)
+		for indexedFile := range fileIndex.Files {
+			if strings.HasPrefix(indexedFile, dirPrefix) {
+				delete(fileIndex.Files, indexedFile)
+				klog.V(4).Infof("Removing file of watch deleted directory from index: %s", indexedFile)
+			}
+		}
 	}
 
 	// Add changed files to the existing index
@@ -295,8 +347,21 @@ func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
 	}
 
 	// Write the result
-	return util.WriteFile(fileIndex.Files, indexFilePath)
+	return util.WriteFileWithSyncTarget(fileIndex.Files, fileIndex.SyncTarget, indexFilePath)
+
+}
 
+// removeRelativePathFromFiles returns the paths of the files relative to path
+func removeRelativePathFromFiles(files []string, path string) ([]string, error) {
+	result := make([]string, 0, len(files))
+	for _, file := range files {
+		rel, err := util.RelativePath(path, file)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rel)
+	}
+	return result, nil
 }
 
 // getCmdToCreateSyncFolder returns the command used to create the remote sync folder on the running container
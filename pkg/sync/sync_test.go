@@ -167,6 +167,54 @@ func TestSyncFiles(t *testing.T) {
 			wantErr:            false,
 			wantIsPushRequired: true,
 		},
+		{
+			name: "Case 5: Pod is known, index does not record it",
+			syncParameters: SyncParameters{
+				Path:              directory,
+				WatchFiles:        []string{},
+				WatchDeletedFiles: []string{},
+				IgnoredFiles:      []string{},
+				CompInfo: ComponentInfo{
+					ContainerName: "abcd",
+					PodUID:        "uid-1",
+				},
+				ForcePush: false,
+			},
+			wantErr:            false,
+			wantIsPushRequired: true,
+		},
+		{
+			name: "Case 6: Forced push on the pod recorded in the index",
+			syncParameters: SyncParameters{
+				Path:              directory,
+				WatchFiles:        []string{},
+				WatchDeletedFiles: []string{},
+				IgnoredFiles:      []string{},
+				CompInfo: ComponentInfo{
+					ContainerName: "abcd",
+					PodUID:        "uid-1",
+				},
+				ForcePush: true,
+			},
+			wantErr:            false,
+			wantIsPushRequired: false,
+		},
+		{
+			name: "Case 7: Pod is different from the one recorded in the index",
+			syncParameters: SyncParameters{
+				Path:              directory,
+				WatchFiles:        []string{},
+				WatchDeletedFiles: []string{},
+				IgnoredFiles:      []string{},
+				CompInfo: ComponentInfo{
+					ContainerName: "abcd",
+					PodUID:        "uid-2",
+				},
+				ForcePush: false,
+			},
+			wantErr:            false,
+			wantIsPushRequired: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -304,7 +352,7 @@ func TestPushLocal(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			execClient := exec.NewExecClient(kc)
 			syncAdapter := NewSyncClient(kc, execClient)
-			err := syncAdapter.pushLocal(context.Background(), tt.path, tt.files, tt.delFiles, tt.isForcePush, []string{}, tt.compInfo, util.IndexerRet{})
+			err := syncAdapter.pushLocal(context.Background(), tt.path, tt.files, tt.delFiles, tt.isForcePush, []string{}, tt.compInfo, util.IndexerRet{}, nil)
 			if !tt.wantErr && err != nil {
 				t.Errorf("TestPushLocal error: error pushing files: %v", err)
 			}
@@ -339,6 +387,12 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 			initialFilesToCreate: []string{"file1"},
 			expectedFilesInIndex: []string{"file1"},
 		},
+		{
+			name:                 "Case 4 - Watch directory deleted should remove the files of the directory from index",
+			initialFilesToCreate: []string{"file1", filepath.Join("dir", "file2"), filepath.Join("dir", "sub", "file3"), filepath.Join("dir2", "file4")},
+			watchDeletedFiles:    []string{"dir"},
+			expectedFilesInIndex: []string{"file1", filepath.Join("dir2", "file4")},
+		},
 	}
 	for _, tt := range tests {
 
@@ -362,6 +416,9 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 			for _, fileToCreate := range tt.initialFilesToCreate {
 				filePath := filepath.Join(directory, fileToCreate)
 
+				if err := os.MkdirAll(filepath.Dir(filePath), 0750); err != nil {
+					t.Fatalf("TestUpdateIndexWithWatchChangesLocal error: unable to create directories for %s: %v", filePath, err)
+				}
 				if err := os.WriteFile(filePath, []byte("non-empty-string"), 0644); err != nil {
 					t.Fatalf("TestUpdateIndexWithWatchChangesLocal error: unable to write to index file path: %v", err)
 				}
@@ -387,7 +444,7 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 				deletedFilePath := filepath.Join(directory, deletedFile)
 				syncParams.WatchDeletedFiles = append(syncParams.WatchDeletedFiles, deletedFilePath)
 
-				if err := os.Remove(deletedFilePath); err != nil {
+				if err := os.RemoveAll(deletedFilePath); err != nil {
 					t.Fatalf("TestUpdateIndexWithWatchChangesLocal error: unable to delete file %s %v", deletedFilePath, err)
 				}
 			}
@@ -49,19 +49,31 @@ func GetFromFile(c interface{}, filename string) error {
 	return nil
 }
 
-// WriteToYAMLFile marshals a struct to a file
+// WriteToYAMLFile marshals a struct to a file.
+// The content is written to a temporary file renamed to the file, so that other processes never read a partially written file.
 func WriteToYAMLFile(c interface{}, filename string) error {
 	data, err := yaml.Marshal(c)
 	if err != nil {
 		return fmt.Errorf("unable to marshal odo config data: %w", err)
 	}
 
-	if err = CreateIfNotExists(filename); err != nil {
-		return err
+	if err = os.MkdirAll(filepath.Dir(filename), 0750); err != nil {
+		return fmt.Errorf("unable to create directory: %w", err)
 	}
-	err = os.WriteFile(filename, data, 0600)
+	tmp, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".tmp")
 	if err != nil {
-		return fmt.Errorf("unable to write config to file %v: %w", c, err)
+		return fmt.Errorf("unable to write config to file %v: %w", filename, err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filename)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write config to file %v: %w", filename, err)
 	}
 
 	return nil
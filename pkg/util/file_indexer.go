@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 
@@ -25,6 +26,8 @@ const DotGitIgnoreFile = ".gitignore"
 type FileIndex struct {
 	metav1.TypeMeta
 	Files map[string]FileData
+	// SyncTarget identifies the container the files of the index have been synced to, if known
+	SyncTarget string `json:"SyncTarget,omitempty"`
 }
 
 // NewFileIndex returns a fileIndex
@@ -157,15 +160,28 @@ type IndexerRet struct {
 // CalculateFileDataKeyFromPath converts an absolute path to relative (and converts to OS-specific paths) for use
 // as a map key in IndexerRet and FileIndex
 func CalculateFileDataKeyFromPath(absolutePath string, rootDirectory string) (string, error) {
+	return RelativePath(filepath.FromSlash(rootDirectory), absolutePath)
+}
 
-	rootDirectory = filepath.FromSlash(rootDirectory)
-
-	relativeFilename, err := filepath.Rel(rootDirectory, absolutePath)
-	if err != nil {
-		return "", err
+// caseInsensitivePaths is true when the paths of the local file system are case-insensitive
+var caseInsensitivePaths = runtime.GOOS == "windows"
+
+// RelativePath returns the path of file relative to base.
+//
+// On Windows, the paths are case-insensitive and the paths reported by the file watcher or the user may differ
+// in case from the path of the component (e.g. C:\Users\me\project\main.go for a component in c:\users\me\project).
+// The prefix of file matching base is then replaced with base before computing the relative path,
+// instead of returning a path going out of base, which would be synced to a wrong location in the Linux containers.
+func RelativePath(base string, file string) (string, error) {
+	if caseInsensitivePaths {
+		cleanBase, cleanFile := filepath.Clean(base), filepath.Clean(file)
+		if len(cleanFile) > len(cleanBase) &&
+			strings.EqualFold(cleanFile[:len(cleanBase)], cleanBase) &&
+			os.IsPathSeparator(cleanFile[len(cleanBase)]) {
+			file = cleanBase + cleanFile[len(cleanBase):]
+		}
 	}
-
-	return relativeFilename, nil
+	return filepath.Rel(base, file)
 }
 
 // GenerateNewFileDataEntry creates a new FileData entry for use by IndexerRet and/or FileIndex
@@ -202,8 +218,15 @@ func write(filePath string, fi *FileIndex) error {
 // WriteFile writes a file map to a file, the file map is given by
 // newFileMap param and the file location is resolvedPath param
 func WriteFile(newFileMap map[string]FileData, resolvedPath string) error {
+	return WriteFileWithSyncTarget(newFileMap, "", resolvedPath)
+}
+
+// WriteFileWithSyncTarget writes a file map to a file, as WriteFile, along with the identifier
+// of the container the files have been synced to
+func WriteFileWithSyncTarget(newFileMap map[string]FileData, syncTarget string, resolvedPath string) error {
 	newfi := NewFileIndex()
 	newfi.Files = newFileMap
+	newfi.SyncTarget = syncTarget
 	err := write(resolvedPath, newfi)
 
 	return err
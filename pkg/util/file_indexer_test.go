@@ -179,6 +179,57 @@ func TestCalculateFileDataKeyFromPath(t *testing.T) {
 	}
 }
 
+func TestRelativePath(t *testing.T) {
+	tests := []struct {
+		name            string
+		caseInsensitive bool
+		base            string
+		file            string
+		want            string
+	}{
+		{
+			name: "same case",
+			base: "/home/me/project",
+			file: "/home/me/project/src/main.go",
+			want: "src/main.go",
+		},
+		{
+			name: "different case with case-sensitive paths",
+			base: "/home/me/project",
+			file: "/Home/Me/Project/src/main.go",
+			want: "../../../Home/Me/Project/src/main.go",
+		},
+		{
+			name:            "different case with case-insensitive paths",
+			caseInsensitive: true,
+			base:            "/home/me/project",
+			file:            "/Home/Me/Project/src/Main.go",
+			want:            "src/Main.go",
+		},
+		{
+			name:            "file outside of a directory with the same prefix and case-insensitive paths",
+			caseInsensitive: true,
+			base:            "/home/me/project",
+			file:            "/Home/Me/Project2/main.go",
+			want:            "../../../Home/Me/Project2/main.go",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(v bool) { caseInsensitivePaths = v }(caseInsensitivePaths)
+			caseInsensitivePaths = tt.caseInsensitive
+
+			got, err := RelativePath(filepath.FromSlash(tt.base), filepath.FromSlash(tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != filepath.FromSlash(tt.want) {
+				t.Errorf("RelativePath() = %q, want %q", got, filepath.FromSlash(tt.want))
+			}
+		})
+	}
+}
+
 func TestGenerateNewFileDataEntry(t *testing.T) {
 
 	// create a temp dir for the fake component
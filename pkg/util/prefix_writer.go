@@ -0,0 +1,61 @@
+package util
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// linePrefixWritersLock serializes the writes of all the linePrefixWriters, so lines of different writers sharing
+// the same underlying writer are not mixed, even if the underlying writer does several writes for a line
+// (e.g. when copying the output to a log file)
+var linePrefixWritersLock sync.Mutex
+
+// linePrefixWriter is an io.WriteCloser writing each line to the underlying writer, prefixed with a fixed string.
+// Each line is written in a single call to the underlying writer.
+type linePrefixWriter struct {
+	lock   sync.Mutex
+	w      io.Writer
+	prefix []byte
+	buf    bytes.Buffer
+}
+
+// NewLinePrefixWriter returns a writer prefixing each line written to w with prefix.
+// The last line, if not terminated by a newline, is written when the writer is closed.
+func NewLinePrefixWriter(w io.Writer, prefix string) io.WriteCloser {
+	return &linePrefixWriter{
+		w:      w,
+		prefix: []byte(prefix),
+	}
+}
+
+func (o *linePrefixWriter) Write(p []byte) (int, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.buf.Write(p)
+	for {
+		i := bytes.IndexByte(o.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := o.writeLine(o.buf.Next(i + 1)); err != nil {
+			return len(p), err
+		}
+	}
+}
+
+func (o *linePrefixWriter) Close() error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.buf.Len() == 0 {
+		return nil
+	}
+	return o.writeLine(append(o.buf.Next(o.buf.Len()), '\n'))
+}
+
+func (o *linePrefixWriter) writeLine(line []byte) error {
+	linePrefixWritersLock.Lock()
+	defer linePrefixWritersLock.Unlock()
+	_, err := o.w.Write(append(append([]byte{}, o.prefix...), line...))
+	return err
+}
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestLinePrefixWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := NewLinePrefixWriter(&out, "[build] ")
+	for _, chunk := range []string{"first li", "ne\nsecond line\nthi", "rd line"} {
+		if _, err := io.WriteString(w, chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := out.String(), "[build] first line\n[build] second line\n"; got != want {
+		t.Errorf("before Close, got %q, want %q", got, want)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.String(), "[build] first line\n[build] second line\n[build] third line\n"; got != want {
+		t.Errorf("after Close, got %q, want %q", got, want)
+	}
+}
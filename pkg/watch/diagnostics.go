@@ -0,0 +1,153 @@
+package watch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// diagnosticsDirPrefix is the prefix of the directories created in the .odo directory to store the diagnostics
+const diagnosticsDirPrefix = "diagnostics-"
+
+// collectDiagnostics collects the description, events and container logs of the pods matching the selector
+// into a new diagnostics directory in the .odo directory of contextDir, and returns the path of this directory.
+// Errors getting the events or the logs of a pod are written in the files instead of the information.
+func (o *WatchClient) collectDiagnostics(contextDir string, selector string, now time.Time) (string, error) {
+	pods, err := o.kubeClient.GetPodsMatchingSelector(selector)
+	if err != nil {
+		return "", fmt.Errorf("unable to get pods: %w", err)
+	}
+
+	dir := filepath.Join(contextDir, util.DotOdoDirectory, diagnosticsDirPrefix+now.Format("20060102-150405"))
+	err = o.fs.MkdirAll(dir, 0750)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		events, eventsErr := o.kubeClient.ListPodEvents(pod.GetName())
+		err = o.fs.WriteFile(filepath.Join(dir, pod.GetName()+".describe.txt"), describePod(pod, events, eventsErr), 0640)
+		if err != nil {
+			return "", err
+		}
+
+		var manifest []byte
+		manifest, err = yaml.Marshal(pod)
+		if err != nil {
+			return "", err
+		}
+		err = o.fs.WriteFile(filepath.Join(dir, pod.GetName()+".yaml"), manifest, 0640)
+		if err != nil {
+			return "", err
+		}
+
+		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			err = o.fs.WriteFile(filepath.Join(dir, fmt.Sprintf("%s-%s.log", pod.GetName(), container.Name)),
+				o.getContainerLogs(pod.GetName(), container.Name), 0640)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	return dir, nil
+}
+
+func (o *WatchClient) getContainerLogs(podName, containerName string) []byte {
+	rd, err := o.kubeClient.GetPodLogs(podName, containerName, false)
+	if err != nil {
+		klog.V(4).Infof("unable to get logs of container %s of pod %s: %v", containerName, podName, err)
+		return []byte(fmt.Sprintf("unable to get logs: %v\n", err))
+	}
+	defer rd.Close()
+	logs, err := io.ReadAll(rd)
+	if err != nil {
+		return append(logs, []byte(fmt.Sprintf("\nunable to read logs: %v\n", err))...)
+	}
+	return logs
+}
+
+// describePod returns a human-readable description of the pod and its events, similar to the output of `kubectl describe pod`
+func describePod(pod *corev1.Pod, events []corev1.Event, eventsErr error) []byte {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Name:\t%s\n", pod.GetName())
+	fmt.Fprintf(w, "Namespace:\t%s\n", pod.GetNamespace())
+	fmt.Fprintf(w, "Node:\t%s\n", pod.Spec.NodeName)
+	fmt.Fprintf(w, "Phase:\t%s\n", pod.Status.Phase)
+	if pod.Status.Reason != "" {
+		fmt.Fprintf(w, "Reason:\t%s\n", pod.Status.Reason)
+	}
+	if pod.Status.Message != "" {
+		fmt.Fprintf(w, "Message:\t%s\n", pod.Status.Message)
+	}
+
+	fmt.Fprintln(w, "Conditions:")
+	fmt.Fprintln(w, "  Type\tStatus\tReason\tMessage")
+	for _, condition := range pod.Status.Conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+	}
+
+	statuses := make(map[string]corev1.ContainerStatus)
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		statuses[status.Name] = status
+	}
+	fmt.Fprintln(w, "Containers:")
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		status := statuses[container.Name]
+		fmt.Fprintf(w, "  %s:\n", container.Name)
+		fmt.Fprintf(w, "    Image:\t%s\n", container.Image)
+		fmt.Fprintf(w, "    State:\t%s\n", describeContainerState(status.State))
+		if status.LastTerminationState != (corev1.ContainerState{}) {
+			fmt.Fprintf(w, "    Last State:\t%s\n", describeContainerState(status.LastTerminationState))
+		}
+		fmt.Fprintf(w, "    Ready:\t%v\n", status.Ready)
+		fmt.Fprintf(w, "    Restart Count:\t%d\n", status.RestartCount)
+		if limits := container.Resources.Limits; len(limits) != 0 {
+			fmt.Fprintf(w, "    Limits:\tcpu=%s memory=%s\n", limits.Cpu(), limits.Memory())
+		}
+	}
+
+	fmt.Fprintln(w, "Events:")
+	switch {
+	case eventsErr != nil:
+		fmt.Fprintf(w, "  unable to get events: %v\n", eventsErr)
+	case len(events) == 0:
+		fmt.Fprintln(w, "  <none>")
+	default:
+		fmt.Fprintln(w, "  Type\tReason\tLast Seen\tCount\tFrom\tMessage")
+		for _, event := range events {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\t%s\n", event.Type, event.Reason,
+				event.LastTimestamp.UTC().Format(time.RFC3339), event.Count, event.Source.Component, strings.TrimSpace(event.Message))
+		}
+	}
+
+	_ = w.Flush()
+	return buf.Bytes()
+}
+
+func describeContainerState(state corev1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return fmt.Sprintf("Running (started at %s)", state.Running.StartedAt.UTC().Format(time.RFC3339))
+	case state.Waiting != nil:
+		return strings.TrimSpace(fmt.Sprintf("Waiting (%s) %s", state.Waiting.Reason, state.Waiting.Message))
+	case state.Terminated != nil:
+		return strings.TrimSpace(fmt.Sprintf("Terminated (%s, exit code %d) %s",
+			state.Terminated.Reason, state.Terminated.ExitCode, state.Terminated.Message))
+	default:
+		return "Unknown"
+	}
+}
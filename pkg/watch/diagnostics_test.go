@@ -0,0 +1,91 @@
+package watch
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestWatchClient_collectDiagnostics(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-ns"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", Image: "init-image"}},
+			Containers:     []corev1.Container{{Name: "runtime", Image: "my-image"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "runtime",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetPodsMatchingSelector("my-selector").Return(&corev1.PodList{Items: []corev1.Pod{pod}}, nil)
+	kubeClient.EXPECT().ListPodEvents("my-pod").Return([]corev1.Event{{
+		Type:    corev1.EventTypeWarning,
+		Reason:  "Failed",
+		Message: `Failed to pull image "my-image"`,
+		Count:   3,
+	}}, nil)
+	kubeClient.EXPECT().GetPodLogs("my-pod", "init", false).Return(io.NopCloser(strings.NewReader("init logs")), nil)
+	kubeClient.EXPECT().GetPodLogs("my-pod", "runtime", false).Return(nil, errors.New("container is waiting to start"))
+
+	fs := filesystem.NewFakeFs()
+	o := WatchClient{kubeClient: kubeClient, fs: fs}
+
+	dir, err := o.collectDiagnostics("/path/to/component", "my-selector", time.Date(2023, 7, 14, 10, 11, 12, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantDir := filepath.Join("/path/to/component", ".odo", "diagnostics-20230714-101112")
+	if dir != wantDir {
+		t.Errorf("collectDiagnostics() = %q, want %q", dir, wantDir)
+	}
+
+	files, err := fs.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	sort.Strings(names)
+	want := []string{"my-pod-init.log", "my-pod-runtime.log", "my-pod.describe.txt", "my-pod.yaml"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("files mismatch (-want +got):\n%s", diff)
+	}
+
+	for file, wantContent := range map[string][]string{
+		"my-pod.describe.txt": {"Phase:", "Pending", "Waiting (ImagePullBackOff)", `Failed to pull image "my-image"`},
+		"my-pod-init.log":     {"init logs"},
+		"my-pod-runtime.log":  {"unable to get logs: container is waiting to start"},
+		"my-pod.yaml":         {"name: my-pod"},
+	} {
+		content, err := fs.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, w := range wantContent {
+			if !strings.Contains(string(content), w) {
+				t.Errorf("expected %s to contain %q, got:\n%s", file, w, content)
+			}
+		}
+	}
+}
@@ -6,24 +6,28 @@ import (
 	"path/filepath"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
-	"github.com/fsnotify/fsnotify"
 	"github.com/redhat-developer/odo/pkg/util"
 	gitignore "github.com/sabhiram/go-gitignore"
 	"k8s.io/klog"
 )
 
-func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher, error) {
+func getFullSourcesWatcher(path string, fileIgnores []string, watchMode string) (sourcesWatcher, error) {
 	absIgnorePaths := dfutil.GetAbsGlobExps(path, fileIgnores)
 
-	watcher, err := fsnotify.NewWatcher()
+	watcher, err := newSourcesWatcher(watchMode, fileIgnores)
 	if err != nil {
-		return nil, fmt.Errorf("error setting up filesystem watcher: %v", err)
+		return nil, err
 	}
 
-	// adding watch on the root folder and the sub folders recursively
-	// so directory and the path in addRecursiveWatch() are the same
-	err = addRecursiveWatch(watcher, path, path, absIgnorePaths)
+	if watcher.Recursive() {
+		err = watcher.Add(path)
+	} else {
+		// adding watch on the root folder and the sub folders recursively
+		// so directory and the path in addRecursiveWatch() are the same
+		err = addRecursiveWatch(watcher, path, path, absIgnorePaths)
+	}
 	if err != nil {
+		_ = watcher.Close()
 		return nil, fmt.Errorf("error watching source path %s: %v", path, err)
 	}
 	return watcher, nil
@@ -36,7 +40,7 @@ func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher
 // rootPath is the root path of the file or directory,
 // path is the recursive path of the file or the directory,
 // ignores contains the glob rules for matching
-func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string, ignores []string) error {
+func addRecursiveWatch(watcher sourcesWatcher, rootPath string, path string, ignores []string) error {
 
 	file, err := os.Stat(path)
 	if err != nil {
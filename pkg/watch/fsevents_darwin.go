@@ -0,0 +1,86 @@
+//go:build darwin && cgo
+
+package watch
+
+/*
+#cgo LDFLAGS: -framework CoreServices
+#include <CoreServices/CoreServices.h>
+#include <dispatch/dispatch.h>
+#include <stdint.h>
+#include <stdlib.h>
+
+// odoFSEventsCallback is exported from recursive_watcher_darwin.go
+extern void odoFSEventsCallback(uintptr_t info, size_t numEvents, char **paths, uint32_t *flags);
+
+static void odoFSEventsTrampoline(ConstFSEventStreamRef stream, void *info, size_t numEvents, void *eventPaths,
+	const FSEventStreamEventFlags eventFlags[], const FSEventStreamEventId eventIds[]) {
+	odoFSEventsCallback((uintptr_t)info, numEvents, (char **)eventPaths, (uint32_t *)eventFlags);
+}
+
+// odoFSEventsStart creates and starts a stream reporting the file events under path on a dedicated dispatch queue.
+// It returns NULL if the stream cannot be started.
+static FSEventStreamRef odoFSEventsStart(uintptr_t info, const char *path, double latency, dispatch_queue_t *queue) {
+	CFStringRef cfPath = CFStringCreateWithCString(NULL, path, kCFStringEncodingUTF8);
+	CFArrayRef paths = CFArrayCreate(NULL, (const void **)&cfPath, 1, &kCFTypeArrayCallBacks);
+	FSEventStreamContext context = {0, (void *)info, NULL, NULL, NULL};
+	FSEventStreamRef stream = FSEventStreamCreate(NULL, odoFSEventsTrampoline, &context, paths, kFSEventStreamEventIdSinceNow, latency,
+		kFSEventStreamCreateFlagFileEvents | kFSEventStreamCreateFlagNoDefer | kFSEventStreamCreateFlagWatchRoot);
+	CFRelease(paths);
+	CFRelease(cfPath);
+	if (stream == NULL) {
+		return NULL;
+	}
+	*queue = dispatch_queue_create("dev.odo.watch", DISPATCH_QUEUE_SERIAL);
+	FSEventStreamSetDispatchQueue(stream, *queue);
+	if (!FSEventStreamStart(stream)) {
+		FSEventStreamInvalidate(stream);
+		FSEventStreamRelease(stream);
+		dispatch_release(*queue);
+		return NULL;
+	}
+	return stream;
+}
+
+static void odoFSEventsStop(FSEventStreamRef stream, dispatch_queue_t queue) {
+	FSEventStreamStop(stream);
+	FSEventStreamInvalidate(stream);
+	FSEventStreamRelease(stream);
+	dispatch_release(queue);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+// fsEventsLatency is the time FSEvents waits before reporting events, to group them
+const fsEventsLatency = 50 * time.Millisecond
+
+// fsEventsStream is a running FSEvents stream
+type fsEventsStream struct {
+	stream C.FSEventStreamRef
+	queue  C.dispatch_queue_t
+}
+
+// startFSEventsStream starts a stream reporting the events under path to the watcher registered with id
+func startFSEventsStream(id uintptr, path string) (*fsEventsStream, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var queue C.dispatch_queue_t
+	stream := C.odoFSEventsStart(C.uintptr_t(id), cPath, C.double(fsEventsLatency.Seconds()), &queue)
+	if stream == nil {
+		return nil, fmt.Errorf("unable to start FSEvents stream on %s", path)
+	}
+	return &fsEventsStream{
+		stream: stream,
+		queue:  queue,
+	}, nil
+}
+
+func (o *fsEventsStream) stop() {
+	C.odoFSEventsStop(o.stream, o.queue)
+}
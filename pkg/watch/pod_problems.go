@@ -0,0 +1,119 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// podStatusEventReasons are the reasons of the Warning Events already reported by PodProblems, from the status of the pod
+var podStatusEventReasons = map[string]struct{}{
+	"FailedScheduling": {},
+	"Failed":           {},
+	"BackOff":          {},
+}
+
+// PodProblems keeps track of the problems reported for each pod, so a problem is displayed only once
+// while it persists
+type PodProblems map[types.UID]map[string]struct{}
+
+func NewPodProblems() PodProblems {
+	return map[types.UID]map[string]struct{}{}
+}
+
+// Add displays the problems of the pod not already displayed
+func (o PodProblems) Add(out io.Writer, pod *corev1.Pod) {
+	previous := o[pod.GetUID()]
+	current := make(map[string]struct{})
+	for _, problem := range getPodProblems(pod) {
+		current[problem] = struct{}{}
+		if _, reported := previous[problem]; !reported {
+			log.Fwarning(out, problem)
+		}
+	}
+	o[pod.GetUID()] = current
+}
+
+func (o PodProblems) Delete(pod *corev1.Pod) {
+	delete(o, pod.GetUID())
+}
+
+// getPodProblems returns a description of the problems preventing the pod from running, found in its status
+func getPodProblems(pod *corev1.Pod) []string {
+	if pod.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	var problems []string
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Reason == corev1.PodReasonUnschedulable {
+			problems = append(problems, fmt.Sprintf("Pod %s cannot be scheduled: %s", pod.GetName(), condition.Message))
+		}
+	}
+
+	images := make(map[string]string, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		images[container.Name] = container.Image
+	}
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if problem := getContainerProblem(status, images[status.Name]); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+// getContainerProblem returns a description of the problem preventing the container from running, if any
+func getContainerProblem(status corev1.ContainerStatus, image string) string {
+	if status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.Reason == "OOMKilled" {
+		return fmt.Sprintf("Container %q has been killed because it exceeded its memory limit (OOMKilled)", status.Name)
+	}
+
+	waiting := status.State.Waiting
+	if waiting == nil {
+		return ""
+	}
+	switch waiting.Reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+		if image == "" {
+			image = status.Image
+		}
+		return fmt.Sprintf("Container %q cannot pull image %q (%s): %s", status.Name, image, waiting.Reason, waiting.Message)
+	case "CrashLoopBackOff":
+		msg := fmt.Sprintf("Container %q keeps crashing (CrashLoopBackOff)", status.Name)
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			msg += fmt.Sprintf("; last exit code: %d", terminated.ExitCode)
+			if terminated.Reason != "" {
+				msg += fmt.Sprintf(" (%s)", terminated.Reason)
+			}
+		}
+		return msg + ". Running `odo logs` might help in identifying the problem."
+	case "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+		return fmt.Sprintf("Container %q cannot be started (%s): %s", status.Name, waiting.Reason, waiting.Message)
+	}
+	return ""
+}
+
+// isCrashLooping returns true if a container of the pod keeps crashing
+func isCrashLooping(pod *corev1.Pod) bool {
+	if pod.GetDeletionTimestamp() != nil {
+		return false
+	}
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+			return true
+		}
+	}
+	return false
+}
+
+// isReportedFromPodStatus returns true if the problem reported by the Warning Event is already reported
+// from the status of the pod
+func isReportedFromPodStatus(event *corev1.Event) bool {
+	_, found := podStatusEventReasons[event.Reason]
+	return found
+}
@@ -0,0 +1,125 @@
+package watch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getPodProblems(t *testing.T) {
+	newPod := func(statuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pod", UID: "uid"},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "runtime", Image: "quay.io/my/image:1.0"}},
+			},
+			Status: corev1.PodStatus{ContainerStatuses: statuses},
+		}
+	}
+
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want []string
+	}{
+		{
+			name: "running pod",
+			pod: newPod(corev1.ContainerStatus{
+				Name:  "runtime",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}),
+		},
+		{
+			name: "image pull back-off",
+			pod: newPod(corev1.ContainerStatus{
+				Name: "runtime",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "ImagePullBackOff",
+					Message: `Back-off pulling image "quay.io/my/image:1.0"`,
+				}},
+			}),
+			want: []string{`Container "runtime" cannot pull image "quay.io/my/image:1.0" (ImagePullBackOff): Back-off pulling image "quay.io/my/image:1.0"`},
+		},
+		{
+			name: "OOMKilled",
+			pod: newPod(corev1.ContainerStatus{
+				Name:                 "runtime",
+				State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			}),
+			want: []string{`Container "runtime" has been killed because it exceeded its memory limit (OOMKilled)`},
+		},
+		{
+			name: "crash loop",
+			pod: newPod(corev1.ContainerStatus{
+				Name:                 "runtime",
+				State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+			}),
+			want: []string{"Container \"runtime\" keeps crashing (CrashLoopBackOff); last exit code: 1 (Error). Running `odo logs` might help in identifying the problem."},
+		},
+		{
+			name: "unschedulable",
+			pod: func() *corev1.Pod {
+				pod := newPod()
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  corev1.PodReasonUnschedulable,
+					Message: "0/3 nodes are available: 3 Insufficient memory.",
+				}}
+				return pod
+			}(),
+			want: []string{"Pod my-pod cannot be scheduled: 0/3 nodes are available: 3 Insufficient memory."},
+		},
+		{
+			name: "terminating pod",
+			pod: func() *corev1.Pod {
+				pod := newPod(corev1.ContainerStatus{
+					Name:  "runtime",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+				})
+				pod.SetDeletionTimestamp(&metav1.Time{})
+				return pod
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, getPodProblems(tt.pod)); diff != "" {
+				t.Errorf("getPodProblems() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPodProblems_Add(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", UID: "uid"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "runtime", Image: "my-image"}},
+		},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "runtime",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+		}}},
+	}
+	out := &bytes.Buffer{}
+	problems := NewPodProblems()
+
+	problems.Add(out, pod)
+	problems.Add(out, pod)
+	if got := strings.Count(out.String(), `cannot pull image "my-image"`); got != 1 {
+		t.Errorf("expected the problem to be displayed once, got %d times in %q", got, out.String())
+	}
+
+	problems.Delete(pod)
+	problems.Add(out, pod)
+	if got := strings.Count(out.String(), `cannot pull image "my-image"`); got != 2 {
+		t.Errorf("expected the problem to be displayed again for a new pod, got %d times in %q", got, out.String())
+	}
+}
@@ -0,0 +1,211 @@
+package watch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	gitignore "github.com/sabhiram/go-gitignore"
+	"k8s.io/klog"
+)
+
+// fileState is the state of a file, as seen by the polling watcher during a scan
+type fileState struct {
+	modTime time.Time
+	size    int64
+	mode    os.FileMode
+}
+
+// pollingWatcher is a sourcesWatcher periodically scanning the watched directories and comparing
+// the results of successive scans. It does not rely on filesystem notifications, so it works
+// on network filesystems, and it does not consume any watch resource of the system.
+type pollingWatcher struct {
+	interval      time.Duration
+	ignoreMatcher *gitignore.GitIgnore
+
+	events chan fsnotify.Event
+	errors chan error
+	done   chan struct{}
+
+	mu        sync.Mutex
+	roots     map[string]map[string]fileState
+	started   bool
+	closeOnce sync.Once
+}
+
+var _ sourcesWatcher = (*pollingWatcher)(nil)
+
+func newPollingWatcher(interval time.Duration, fileIgnores []string) *pollingWatcher {
+	return &pollingWatcher{
+		interval:      interval,
+		ignoreMatcher: gitignore.CompileIgnoreLines(fileIgnores...),
+		events:        make(chan fsnotify.Event),
+		errors:        make(chan error),
+		done:          make(chan struct{}),
+		roots:         make(map[string]map[string]fileState),
+	}
+}
+
+func (o *pollingWatcher) Events() <-chan fsnotify.Event {
+	return o.events
+}
+
+func (o *pollingWatcher) Errors() <-chan error {
+	return o.errors
+}
+
+// Add starts watching the tree under path, if path is not already part of a watched tree
+func (o *pollingWatcher) Add(path string) error {
+	path = filepath.Clean(path)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for root := range o.roots {
+		if isInTree(root, path) {
+			return nil
+		}
+	}
+
+	snapshot, err := o.scan(path)
+	if err != nil {
+		return err
+	}
+	o.roots[path] = snapshot
+
+	if !o.started {
+		o.started = true
+		go o.run()
+	}
+	return nil
+}
+
+// Remove stops watching path, if path has been added before. The files of the watched trees
+// are not watched individually, so removing them is a no-op
+func (o *pollingWatcher) Remove(path string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.roots, filepath.Clean(path))
+	return nil
+}
+
+func (o *pollingWatcher) Close() error {
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+	return nil
+}
+
+func (o *pollingWatcher) Recursive() bool {
+	return true
+}
+
+func (o *pollingWatcher) run() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.done:
+			return
+		case <-ticker.C:
+			for _, event := range o.poll() {
+				select {
+				case o.events <- event:
+				case <-o.done:
+					return
+				}
+			}
+		}
+	}
+}
+
+// poll scans all the watched trees and returns the events for the differences with the previous scans
+func (o *pollingWatcher) poll() []fsnotify.Event {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var events []fsnotify.Event
+	for root, previous := range o.roots {
+		current, err := o.scan(root)
+		if err != nil {
+			// the filesystem can be temporarily unavailable (e.g. network filesystems), retry on next tick
+			klog.V(4).Infof("error scanning %s: %v", root, err)
+			continue
+		}
+		events = append(events, diffSnapshots(previous, current)...)
+		o.roots[root] = current
+	}
+	return events
+}
+
+// scan returns the state of all the files and directories under root, except the ignored ones
+func (o *pollingWatcher) scan(root string) (map[string]fileState, error) {
+	snapshot := make(map[string]fileState)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path != root {
+				// the file has been deleted during the scan
+				return nil
+			}
+			return fmt.Errorf("unable to walk path: %s: %w", path, err)
+		}
+		if path != root {
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			if o.ignoreMatcher.MatchesPath(rel) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+		snapshot[path] = fileState{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+			mode:    info.Mode(),
+		}
+		return nil
+	})
+	return snapshot, err
+}
+
+// diffSnapshots returns the events to go from the previous to the current snapshot, sorted by path
+func diffSnapshots(previous, current map[string]fileState) []fsnotify.Event {
+	var events []fsnotify.Event
+	for path, state := range current {
+		prev, found := previous[path]
+		switch {
+		case !found:
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Create})
+		case state.mode != prev.mode:
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Chmod})
+		case !state.mode.IsDir() && (state.size != prev.size || !state.modTime.Equal(prev.modTime)):
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Write})
+		}
+	}
+	for path := range previous {
+		if _, found := current[path]; !found {
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Remove})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Name < events[j].Name
+	})
+	return events
+}
+
+// isInTree returns true if path is root or is a descendant of root
+func isInTree(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
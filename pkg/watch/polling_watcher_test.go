@@ -0,0 +1,114 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_diffSnapshots(t *testing.T) {
+	t0 := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Second)
+
+	previous := map[string]fileState{
+		"/src":             {modTime: t0, mode: os.ModeDir | 0755},
+		"/src/unchanged":   {modTime: t0, size: 10, mode: 0644},
+		"/src/modified":    {modTime: t0, size: 10, mode: 0644},
+		"/src/resized":     {modTime: t0, size: 10, mode: 0644},
+		"/src/chmoded":     {modTime: t0, size: 10, mode: 0644},
+		"/src/deleted":     {modTime: t0, size: 10, mode: 0644},
+		"/src/dir":         {modTime: t0, mode: os.ModeDir | 0755},
+		"/src/dir/deleted": {modTime: t0, size: 10, mode: 0644},
+	}
+	current := map[string]fileState{
+		"/src":           {modTime: t1, mode: os.ModeDir | 0755},
+		"/src/unchanged": {modTime: t0, size: 10, mode: 0644},
+		"/src/modified":  {modTime: t1, size: 10, mode: 0644},
+		"/src/resized":   {modTime: t0, size: 20, mode: 0644},
+		"/src/chmoded":   {modTime: t0, size: 10, mode: 0755},
+		"/src/created":   {modTime: t1, size: 10, mode: 0644},
+		"/src/dir":       {modTime: t1, mode: os.ModeDir | 0755},
+	}
+
+	want := []fsnotify.Event{
+		{Name: "/src/chmoded", Op: fsnotify.Chmod},
+		{Name: "/src/created", Op: fsnotify.Create},
+		{Name: "/src/deleted", Op: fsnotify.Remove},
+		{Name: "/src/dir/deleted", Op: fsnotify.Remove},
+		{Name: "/src/modified", Op: fsnotify.Write},
+		{Name: "/src/resized", Op: fsnotify.Write},
+	}
+	got := diffSnapshots(previous, current)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("diffSnapshots() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_pollingWatcher(t *testing.T) {
+	dir := t.TempDir()
+	for _, d := range []string{"src", "node_modules"} {
+		if err := os.Mkdir(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	watcher := newPollingWatcher(10*time.Millisecond, []string{"node_modules"})
+	defer watcher.Close()
+
+	if !watcher.Recursive() {
+		t.Error("polling watcher should be recursive")
+	}
+	if err := watcher.Add(dir); err != nil {
+		t.Fatalf("unexpected error adding %s: %v", dir, err)
+	}
+	// Adding a directory of a watched tree is a no-op
+	if err := watcher.Add(filepath.Join(dir, "src")); err != nil {
+		t.Fatalf("unexpected error adding sub-directory: %v", err)
+	}
+
+	// Files in ignored directories must not be reported
+	if err := os.WriteFile(filepath.Join(dir, "node_modules", "ignored.js"), []byte("ignored"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	created := filepath.Join(dir, "src", "main.go")
+	if err := os.WriteFile(created, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-watcher.Events():
+		want := fsnotify.Event{Name: created, Op: fsnotify.Create}
+		if event != want {
+			t.Errorf("got event %v, want %v", event, want)
+		}
+	case err := <-watcher.Errors():
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+}
+
+func Test_isInTree(t *testing.T) {
+	root := filepath.Join("path", "to", "root")
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: root, want: true},
+		{path: filepath.Join(root, "sub", "dir"), want: true},
+		{path: filepath.Join(root, "..", "root2"), want: false},
+		{path: filepath.Join("path", "to"), want: false},
+		{path: filepath.Join(root, "..data"), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := isInTree(root, tt.path); got != tt.want {
+				t.Errorf("isInTree(%q, %q) = %v, want %v", root, tt.path, got, tt.want)
+			}
+		})
+	}
+}
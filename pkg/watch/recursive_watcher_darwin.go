@@ -0,0 +1,201 @@
+//go:build darwin && cgo
+
+package watch
+
+/*
+#include <stddef.h>
+#include <stdint.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// Flags of the FSEvents events, from FSEvents.h
+const (
+	fsEventsMustScanSubDirs  = 0x00000001
+	fsEventsUserDropped      = 0x00000002
+	fsEventsKernelDropped    = 0x00000004
+	fsEventsItemCreated      = 0x00000100
+	fsEventsItemRemoved      = 0x00000200
+	fsEventsItemInodeMetaMod = 0x00000400
+	fsEventsItemRenamed      = 0x00000800
+	fsEventsItemModified     = 0x00001000
+	fsEventsItemChangeOwner  = 0x00004000
+	fsEventsItemXattrMod     = 0x00008000
+)
+
+var (
+	// recursiveWatchers contains the running watchers, indexed by the identifier passed to the FSEvents callback.
+	// The callback can be called after a watcher is closed, it ignores the events for unknown identifiers
+	recursiveWatchers       = map[uintptr]*recursiveWatcher{}
+	recursiveWatchersMu     sync.Mutex
+	recursiveWatchersLastID uintptr
+)
+
+// recursiveWatcher is a sourcesWatcher using FSEvents to watch a whole tree with a single stream
+type recursiveWatcher struct {
+	id     uintptr
+	events chan fsnotify.Event
+	errors chan error
+
+	root string
+	// realRoot is root with symlinks evaluated, as reported by FSEvents (e.g. /private/var/... for /var/...)
+	realRoot string
+	stream   *fsEventsStream
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+var _ sourcesWatcher = (*recursiveWatcher)(nil)
+
+func newRecursiveWatcher() (sourcesWatcher, error) {
+	recursiveWatchersMu.Lock()
+	defer recursiveWatchersMu.Unlock()
+	recursiveWatchersLastID++
+	w := &recursiveWatcher{
+		id:      recursiveWatchersLastID,
+		events:  make(chan fsnotify.Event),
+		errors:  make(chan error),
+		closing: make(chan struct{}),
+	}
+	recursiveWatchers[w.id] = w
+	return w, nil
+}
+
+func (o *recursiveWatcher) Events() <-chan fsnotify.Event {
+	return o.events
+}
+
+func (o *recursiveWatcher) Errors() <-chan error {
+	return o.errors
+}
+
+// Add starts watching the tree under path. Only one tree can be watched, adding paths inside this tree is a no-op
+func (o *recursiveWatcher) Add(path string) error {
+	path = filepath.Clean(path)
+	if o.root != "" {
+		if isInTree(o.root, path) {
+			return nil
+		}
+		return fmt.Errorf("unable to watch %s, already watching %s", path, o.root)
+	}
+	realRoot, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return err
+	}
+	stream, err := startFSEventsStream(o.id, realRoot)
+	if err != nil {
+		return err
+	}
+	o.root = path
+	o.realRoot = realRoot
+	o.stream = stream
+	return nil
+}
+
+// Remove is a no-op, as the paths of the tree are not watched individually
+func (o *recursiveWatcher) Remove(path string) error {
+	return nil
+}
+
+func (o *recursiveWatcher) Close() error {
+	o.closeOnce.Do(func() {
+		recursiveWatchersMu.Lock()
+		delete(recursiveWatchers, o.id)
+		recursiveWatchersMu.Unlock()
+		close(o.closing)
+		if o.stream != nil {
+			o.stream.stop()
+		}
+	})
+	return nil
+}
+
+func (o *recursiveWatcher) Recursive() bool {
+	return true
+}
+
+// handleEvent sends the events corresponding to the FSEvents flags received for path
+func (o *recursiveWatcher) handleEvent(path string, flags uint32) {
+	if flags&(fsEventsMustScanSubDirs|fsEventsUserDropped|fsEventsKernelDropped) != 0 {
+		// events have been coalesced or dropped, the whole sub-tree needs to be considered as changed
+		klog.V(4).Infof("events dropped under %s, considering the whole tree as changed", path)
+		o.send(fsnotify.Event{Name: o.fromRealPath(path), Op: fsnotify.Create})
+		return
+	}
+
+	name := o.fromRealPath(path)
+	var op fsnotify.Op
+	if flags&fsEventsItemCreated != 0 {
+		op |= fsnotify.Create
+	}
+	if flags&fsEventsItemModified != 0 {
+		op |= fsnotify.Write
+	}
+	if flags&(fsEventsItemInodeMetaMod|fsEventsItemChangeOwner|fsEventsItemXattrMod) != 0 {
+		op |= fsnotify.Chmod
+	}
+	if flags&fsEventsItemRenamed != 0 {
+		// FSEvents reports a rename on both the old and the new paths
+		if util.CheckPathExists(name) {
+			op |= fsnotify.Create
+		} else {
+			op |= fsnotify.Rename
+		}
+	}
+	if flags&fsEventsItemRemoved != 0 && !util.CheckPathExists(name) {
+		// events are coalesced, the path can have been re-created after its removal
+		op = fsnotify.Remove
+	}
+	if op == 0 {
+		return
+	}
+	o.send(fsnotify.Event{Name: name, Op: op})
+}
+
+// fromRealPath converts a path reported by FSEvents to a path under the watched root
+func (o *recursiveWatcher) fromRealPath(path string) string {
+	if o.realRoot == o.root {
+		return path
+	}
+	rel, err := filepath.Rel(o.realRoot, path)
+	if err != nil {
+		return path
+	}
+	return filepath.Join(o.root, rel)
+}
+
+func (o *recursiveWatcher) send(event fsnotify.Event) {
+	select {
+	case o.events <- event:
+	case <-o.closing:
+	}
+}
+
+//export odoFSEventsCallback
+func odoFSEventsCallback(info C.uintptr_t, numEvents C.size_t, paths **C.char, flags *C.uint32_t) {
+	recursiveWatchersMu.Lock()
+	w, ok := recursiveWatchers[uintptr(info)]
+	recursiveWatchersMu.Unlock()
+	if !ok {
+		return
+	}
+
+	n := int(numEvents)
+	cPaths := unsafe.Slice(paths, n)
+	cFlags := unsafe.Slice(flags, n)
+	for i := 0; i < n; i++ {
+		w.handleEvent(C.GoString(cPaths[i]), uint32(cFlags[i]))
+	}
+}
@@ -0,0 +1,8 @@
+//go:build darwin && !cgo
+
+package watch
+
+// newRecursiveWatcher returns errRecursiveNotBuiltIn, as the FSEvents watcher requires cgo
+func newRecursiveWatcher() (sourcesWatcher, error) {
+	return nil, errRecursiveNotBuiltIn
+}
@@ -0,0 +1,84 @@
+//go:build darwin && cgo
+
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_recursiveWatcher_handleEvent(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.go")
+	if err := os.WriteFile(existing, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.go")
+
+	tests := []struct {
+		name  string
+		path  string
+		flags uint32
+		want  []fsnotify.Event
+	}{
+		{
+			name:  "dropped events report the tree as created",
+			path:  dir,
+			flags: fsEventsMustScanSubDirs | fsEventsKernelDropped,
+			want:  []fsnotify.Event{{Name: dir, Op: fsnotify.Create}},
+		},
+		{
+			name:  "created and modified file",
+			path:  existing,
+			flags: fsEventsItemCreated | fsEventsItemModified,
+			want:  []fsnotify.Event{{Name: existing, Op: fsnotify.Create | fsnotify.Write}},
+		},
+		{
+			name:  "renamed to an existing path",
+			path:  existing,
+			flags: fsEventsItemRenamed,
+			want:  []fsnotify.Event{{Name: existing, Op: fsnotify.Create}},
+		},
+		{
+			name:  "renamed from a missing path",
+			path:  missing,
+			flags: fsEventsItemRenamed,
+			want:  []fsnotify.Event{{Name: missing, Op: fsnotify.Rename}},
+		},
+		{
+			name:  "created then removed file",
+			path:  missing,
+			flags: fsEventsItemCreated | fsEventsItemRemoved,
+			want:  []fsnotify.Event{{Name: missing, Op: fsnotify.Remove}},
+		},
+		{
+			name:  "removed then re-created file",
+			path:  existing,
+			flags: fsEventsItemRemoved | fsEventsItemCreated,
+			want:  []fsnotify.Event{{Name: existing, Op: fsnotify.Create}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &recursiveWatcher{
+				root:     dir,
+				realRoot: dir,
+				events:   make(chan fsnotify.Event, 10),
+				closing:  make(chan struct{}),
+			}
+			w.handleEvent(tt.path, tt.flags)
+			close(w.events)
+			var got []fsnotify.Event
+			for event := range w.events {
+				got = append(got, event)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("handleEvent() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
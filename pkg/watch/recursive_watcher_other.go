@@ -0,0 +1,9 @@
+//go:build !windows && !darwin
+
+package watch
+
+// newRecursiveWatcher returns errRecursiveNotSupported, as no native recursive watcher
+// is available for this platform: watching relies on fsnotify, with a watch per directory
+func newRecursiveWatcher() (sourcesWatcher, error) {
+	return nil, errRecursiveNotSupported
+}
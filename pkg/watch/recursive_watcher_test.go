@@ -0,0 +1,80 @@
+//go:build windows || (darwin && cgo)
+
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// waitForEvent waits for an event on name including op, ignoring the other events
+func waitForEvent(t *testing.T, watcher sourcesWatcher, name string, op fsnotify.Op) {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-watcher.Events():
+			if event.Name == name && event.Op&op == op {
+				return
+			}
+		case err := <-watcher.Errors():
+			t.Fatalf("unexpected error waiting for %s on %s: %v", op, name, err)
+		case <-timeout:
+			t.Fatalf("timeout waiting for %s on %s", op, name)
+		}
+	}
+}
+
+func Test_recursiveWatcher(t *testing.T) {
+	dir := t.TempDir()
+	subDir := filepath.Join(dir, "sub", "dir")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := newRecursiveWatcher()
+	if err != nil {
+		t.Fatalf("unexpected error creating watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	if !watcher.Recursive() {
+		t.Error("native watcher should be recursive")
+	}
+	if err = watcher.Add(dir); err != nil {
+		t.Fatalf("unexpected error adding %s: %v", dir, err)
+	}
+	// Adding a directory of the watched tree is a no-op
+	if err = watcher.Add(subDir); err != nil {
+		t.Fatalf("unexpected error adding sub-directory: %v", err)
+	}
+	// Only one tree can be watched
+	if err = watcher.Add(t.TempDir()); err == nil {
+		t.Error("expected error adding a second tree")
+	}
+
+	// Changes deep in the tree are reported without adding the sub-directories
+	file := filepath.Join(subDir, "main.go")
+	if err = os.WriteFile(file, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvent(t, watcher, file, fsnotify.Create)
+
+	if err = os.WriteFile(file, []byte("package main\n\nfunc main() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvent(t, watcher, file, fsnotify.Write)
+
+	if err = os.Remove(file); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvent(t, watcher, file, fsnotify.Remove)
+
+	if err = watcher.Close(); err != nil {
+		t.Errorf("unexpected error closing watcher: %v", err)
+	}
+}
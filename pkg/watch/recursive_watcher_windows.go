@@ -0,0 +1,214 @@
+package watch
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/sys/windows"
+	"k8s.io/klog"
+)
+
+const (
+	// readDirectoryChangesBufferSize is the size of the buffer receiving the changes; when more changes
+	// than the buffer can hold happen between two reads, the whole tree is reported as changed
+	readDirectoryChangesBufferSize = 64 * 1024
+
+	readDirectoryChangesFilter = windows.FILE_NOTIFY_CHANGE_FILE_NAME |
+		windows.FILE_NOTIFY_CHANGE_DIR_NAME |
+		windows.FILE_NOTIFY_CHANGE_ATTRIBUTES |
+		windows.FILE_NOTIFY_CHANGE_SIZE |
+		windows.FILE_NOTIFY_CHANGE_LAST_WRITE |
+		windows.FILE_NOTIFY_CHANGE_CREATION
+)
+
+// recursiveWatcher is a sourcesWatcher using ReadDirectoryChangesW to watch a whole tree with a single handle
+type recursiveWatcher struct {
+	events chan fsnotify.Event
+	errors chan error
+
+	root string
+	// dir is the handle of the watched directory
+	dir windows.Handle
+	// quit is the event signaled to stop watching
+	quit windows.Handle
+
+	// closing is closed when the watcher is closed, done is closed when the watching goroutine exits
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ sourcesWatcher = (*recursiveWatcher)(nil)
+
+func newRecursiveWatcher() (sourcesWatcher, error) {
+	return &recursiveWatcher{
+		events:  make(chan fsnotify.Event),
+		errors:  make(chan error),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}, nil
+}
+
+func (o *recursiveWatcher) Events() <-chan fsnotify.Event {
+	return o.events
+}
+
+func (o *recursiveWatcher) Errors() <-chan error {
+	return o.errors
+}
+
+// Add starts watching the tree under path. Only one tree can be watched, adding paths inside this tree is a no-op
+func (o *recursiveWatcher) Add(path string) error {
+	path = filepath.Clean(path)
+	if o.root != "" {
+		if isInTree(o.root, path) {
+			return nil
+		}
+		return fmt.Errorf("unable to watch %s, already watching %s", path, o.root)
+	}
+
+	p, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	dir, err := windows.CreateFile(p,
+		windows.FILE_LIST_DIRECTORY,
+		windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE,
+		nil,
+		windows.OPEN_EXISTING,
+		windows.FILE_FLAG_BACKUP_SEMANTICS|windows.FILE_FLAG_OVERLAPPED,
+		0)
+	if err != nil {
+		return fmt.Errorf("unable to open directory %s: %w", path, err)
+	}
+	quit, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		_ = windows.CloseHandle(dir)
+		return err
+	}
+
+	o.root = path
+	o.dir = dir
+	o.quit = quit
+	go o.run()
+	return nil
+}
+
+// Remove is a no-op, as the paths of the tree are not watched individually
+func (o *recursiveWatcher) Remove(path string) error {
+	return nil
+}
+
+func (o *recursiveWatcher) Close() error {
+	o.closeOnce.Do(func() {
+		close(o.closing)
+		if o.root == "" {
+			return
+		}
+		_ = windows.SetEvent(o.quit)
+		<-o.done
+		_ = windows.CloseHandle(o.dir)
+		_ = windows.CloseHandle(o.quit)
+	})
+	return nil
+}
+
+func (o *recursiveWatcher) Recursive() bool {
+	return true
+}
+
+func (o *recursiveWatcher) run() {
+	defer close(o.done)
+
+	ready, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		o.sendError(err)
+		return
+	}
+	defer func() { _ = windows.CloseHandle(ready) }()
+
+	buf := make([]byte, readDirectoryChangesBufferSize)
+	for {
+		overlapped := windows.Overlapped{HEvent: ready}
+		err = windows.ReadDirectoryChanges(o.dir, &buf[0], uint32(len(buf)), true, readDirectoryChangesFilter, nil, &overlapped, 0)
+		if err != nil {
+			o.sendError(fmt.Errorf("error watching %s: %w", o.root, err))
+			return
+		}
+
+		idx, err := windows.WaitForMultipleObjects([]windows.Handle{ready, o.quit}, false, windows.INFINITE)
+		if err != nil || idx != windows.WAIT_OBJECT_0 {
+			// stop requested: cancel the pending read and wait for its completion before the buffer is released
+			var n uint32
+			_ = windows.CancelIoEx(o.dir, &overlapped)
+			_ = windows.GetOverlappedResult(o.dir, &overlapped, &n, true)
+			return
+		}
+
+		var n uint32
+		err = windows.GetOverlappedResult(o.dir, &overlapped, &n, false)
+		if err != nil {
+			o.sendError(fmt.Errorf("error watching %s: %w", o.root, err))
+			return
+		}
+		_ = windows.ResetEvent(ready)
+
+		for _, event := range parseFileNotifyInformation(o.root, buf[:n]) {
+			if !o.send(event) {
+				return
+			}
+		}
+	}
+}
+
+// parseFileNotifyInformation returns the events described by the FILE_NOTIFY_INFORMATION entries contained in buf.
+// An empty buf means that the buffer overflowed and changes are lost, the whole tree is then reported as created
+func parseFileNotifyInformation(root string, buf []byte) []fsnotify.Event {
+	if len(buf) == 0 {
+		klog.V(4).Infof("too many changes under %s, considering the whole tree as changed", root)
+		return []fsnotify.Event{{Name: root, Op: fsnotify.Create}}
+	}
+	var events []fsnotify.Event
+	var offset uint32
+	for {
+		raw := (*windows.FileNotifyInformation)(unsafe.Pointer(&buf[offset]))
+		name := windows.UTF16ToString(unsafe.Slice(&raw.FileName, raw.FileNameLength/2))
+		event := fsnotify.Event{Name: filepath.Join(root, name)}
+		switch raw.Action {
+		case windows.FILE_ACTION_ADDED, windows.FILE_ACTION_RENAMED_NEW_NAME:
+			event.Op = fsnotify.Create
+		case windows.FILE_ACTION_REMOVED:
+			event.Op = fsnotify.Remove
+		case windows.FILE_ACTION_MODIFIED:
+			event.Op = fsnotify.Write
+		case windows.FILE_ACTION_RENAMED_OLD_NAME:
+			event.Op = fsnotify.Rename
+		}
+		if event.Op != 0 {
+			events = append(events, event)
+		}
+		if raw.NextEntryOffset == 0 {
+			return events
+		}
+		offset += raw.NextEntryOffset
+	}
+}
+
+func (o *recursiveWatcher) send(event fsnotify.Event) bool {
+	select {
+	case o.events <- event:
+		return true
+	case <-o.closing:
+		return false
+	}
+}
+
+func (o *recursiveWatcher) sendError(err error) {
+	select {
+	case o.errors <- err:
+	case <-o.closing:
+	}
+}
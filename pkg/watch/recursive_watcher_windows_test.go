@@ -0,0 +1,84 @@
+package watch
+
+import (
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sys/windows"
+)
+
+type fileNotifyEntry struct {
+	name   string
+	action uint32
+}
+
+// fileNotifyInformation encodes the entries as FILE_NOTIFY_INFORMATION structures, as returned by ReadDirectoryChangesW
+func fileNotifyInformation(entries []fileNotifyEntry) []byte {
+	var buf []byte
+	for i, e := range entries {
+		encoded := utf16.Encode([]rune(e.name))
+		size := 12 + 2*len(encoded)
+		// entries are aligned on 4 bytes
+		size += (4 - size%4) % 4
+		entry := make([]byte, size)
+		if i < len(entries)-1 {
+			binary.LittleEndian.PutUint32(entry[0:], uint32(size))
+		}
+		binary.LittleEndian.PutUint32(entry[4:], e.action)
+		binary.LittleEndian.PutUint32(entry[8:], uint32(2*len(encoded)))
+		for j, c := range encoded {
+			binary.LittleEndian.PutUint16(entry[12+2*j:], c)
+		}
+		buf = append(buf, entry...)
+	}
+	return buf
+}
+
+func Test_parseFileNotifyInformation(t *testing.T) {
+	root := `C:\src`
+	entries := []fileNotifyEntry{
+		{name: "added.go", action: windows.FILE_ACTION_ADDED},
+		{name: `dir\removed.go`, action: windows.FILE_ACTION_REMOVED},
+		{name: "modified.go", action: windows.FILE_ACTION_MODIFIED},
+		{name: "old.go", action: windows.FILE_ACTION_RENAMED_OLD_NAME},
+		{name: "new.go", action: windows.FILE_ACTION_RENAMED_NEW_NAME},
+		{name: "unknown", action: 42},
+	}
+
+	tests := []struct {
+		name string
+		buf  []byte
+		want []fsnotify.Event
+	}{
+		{
+			name: "entries are converted to events",
+			buf:  fileNotifyInformation(entries),
+			want: []fsnotify.Event{
+				{Name: filepath.Join(root, "added.go"), Op: fsnotify.Create},
+				{Name: filepath.Join(root, "dir", "removed.go"), Op: fsnotify.Remove},
+				{Name: filepath.Join(root, "modified.go"), Op: fsnotify.Write},
+				{Name: filepath.Join(root, "old.go"), Op: fsnotify.Rename},
+				{Name: filepath.Join(root, "new.go"), Op: fsnotify.Create},
+			},
+		},
+		{
+			name: "buffer overflow reports the whole tree as created",
+			buf:  nil,
+			want: []fsnotify.Event{
+				{Name: root, Op: fsnotify.Create},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseFileNotifyInformation(root, tt.buf)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseFileNotifyInformation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
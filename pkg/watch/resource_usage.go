@@ -0,0 +1,141 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// resourceUsageWarningPercent is the percentage of a limit from which a container is reported as close to this limit
+const resourceUsageWarningPercent = 90
+
+// isMetricsAPISupported returns true if the metrics API, providing the resource usage of the pods, is served by the cluster
+func (o *WatchClient) isMetricsAPISupported() bool {
+	gvr := kclient.PodMetricsGVR
+	supported, err := o.kubeClient.IsResourceSupported(gvr.Group, gvr.Version, gvr.Resource)
+	if err != nil {
+		klog.V(4).Infof("unable to check if the metrics API is supported: %v", err)
+		return false
+	}
+	return supported
+}
+
+// displayResourceUsage displays the resource usage of the containers of the pod, and records it in the state.
+// Errors are only logged, as the metrics of a pod are not available during the first minute after it started.
+func (o *WatchClient) displayResourceUsage(ctx context.Context, out io.Writer, pod *corev1.Pod, warnings ResourceUsageWarnings) {
+	metrics, err := o.kubeClient.GetPodMetrics(ctx, pod.GetName())
+	if err != nil {
+		klog.V(4).Infof("unable to get the metrics of pod %s: %v", pod.GetName(), err)
+		return
+	}
+	usage := getResourceUsage(pod, metrics, time.Now())
+	if len(usage.Containers) == 0 {
+		return
+	}
+	warnings.Display(out, usage)
+	if err = o.stateClient.SetResourceUsage(ctx, usage); err != nil {
+		klog.V(4).Infof("unable to record the resource usage in the state: %v", err)
+	}
+}
+
+// ResourceUsageWarnings keeps track of the containers reported as close to their limits, so a warning is displayed only once
+// while the usage stays above the threshold
+type ResourceUsageWarnings map[string]struct{}
+
+func NewResourceUsageWarnings() ResourceUsageWarnings {
+	return map[string]struct{}{}
+}
+
+// Display displays the resource usage of the containers, and a warning for each container newly close to one of its limits
+func (o ResourceUsageWarnings) Display(out io.Writer, usage api.ResourceUsage) {
+	fmt.Fprintln(out, formatResourceUsage(usage))
+
+	current := make(map[string]struct{})
+	for _, container := range usage.Containers {
+		if isAboveThreshold(container.CPULimitPercent) {
+			key := container.Name + "/cpu"
+			current[key] = struct{}{}
+			if _, reported := o[key]; !reported {
+				log.Fwarning(out, fmt.Sprintf("Container %q is using %d%% of its CPU limit (%s) and may be throttled", container.Name, *container.CPULimitPercent, container.CPULimit))
+			}
+		}
+		if isAboveThreshold(container.MemoryLimitPercent) {
+			key := container.Name + "/memory"
+			current[key] = struct{}{}
+			if _, reported := o[key]; !reported {
+				log.Fwarning(out, fmt.Sprintf("Container %q is using %d%% of its memory limit (%s) and may be killed (OOMKilled)", container.Name, *container.MemoryLimitPercent, container.MemoryLimit))
+			}
+		}
+	}
+	for key := range o {
+		delete(o, key)
+	}
+	for key := range current {
+		o[key] = struct{}{}
+	}
+}
+
+func isAboveThreshold(percent *int64) bool {
+	return percent != nil && *percent >= resourceUsageWarningPercent
+}
+
+// getResourceUsage returns the usage of the containers of the pod reported by the metrics API, compared to their limits,
+// in the order of the containers of the pod
+func getResourceUsage(pod *corev1.Pod, metrics map[string]corev1.ResourceList, now time.Time) api.ResourceUsage {
+	result := api.ResourceUsage{
+		Time: now,
+	}
+	for _, container := range pod.Spec.Containers {
+		usage, found := metrics[container.Name]
+		if !found {
+			continue
+		}
+		cpu := usage[corev1.ResourceCPU]
+		memory := usage[corev1.ResourceMemory]
+		containerUsage := api.ContainerResourceUsage{
+			Name:   container.Name,
+			CPU:    formatCPU(cpu),
+			Memory: formatMemory(memory),
+		}
+		if limit, ok := container.Resources.Limits[corev1.ResourceCPU]; ok && !limit.IsZero() {
+			containerUsage.CPULimit = limit.String()
+			containerUsage.CPULimitPercent = pointer.Int64(cpu.MilliValue() * 100 / limit.MilliValue())
+		}
+		if limit, ok := container.Resources.Limits[corev1.ResourceMemory]; ok && !limit.IsZero() {
+			containerUsage.MemoryLimit = limit.String()
+			containerUsage.MemoryLimitPercent = pointer.Int64(memory.Value() * 100 / limit.Value())
+		}
+		result.Containers = append(result.Containers, containerUsage)
+	}
+	return result
+}
+
+// formatCPU returns the CPU quantity in millicores
+func formatCPU(q resource.Quantity) string {
+	return fmt.Sprintf("%dm", q.MilliValue())
+}
+
+// formatMemory returns the memory quantity in mebibytes
+func formatMemory(q resource.Quantity) string {
+	return fmt.Sprintf("%dMi", q.Value()/(1024*1024))
+}
+
+// formatResourceUsage returns a single line describing the resource usage of the containers
+func formatResourceUsage(usage api.ResourceUsage) string {
+	containers := make([]string, 0, len(usage.Containers))
+	for _, container := range usage.Containers {
+		containers = append(containers, container.String())
+	}
+	return fmt.Sprintf("Resource usage at %s - %s", usage.Time.Format("15:04:05"), strings.Join(containers, "; "))
+}
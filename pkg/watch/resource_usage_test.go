@@ -0,0 +1,102 @@
+package watch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_getResourceUsage(t *testing.T) {
+	now := time.Date(2023, 3, 14, 10, 30, 0, 0, time.UTC)
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "runtime",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+				},
+				{Name: "tools"},
+				{Name: "no-metrics"},
+			},
+		},
+	}
+	metrics := map[string]corev1.ResourceList{
+		"tools": {
+			corev1.ResourceCPU:    resource.MustParse("1500000n"),
+			corev1.ResourceMemory: resource.MustParse("10240Ki"),
+		},
+		"runtime": {
+			corev1.ResourceCPU:    resource.MustParse("480m"),
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	}
+
+	got := getResourceUsage(pod, metrics, now)
+	want := api.ResourceUsage{
+		Time: now,
+		Containers: []api.ContainerResourceUsage{
+			{
+				Name:               "runtime",
+				CPU:                "480m",
+				CPULimit:           "500m",
+				CPULimitPercent:    pointer.Int64(96),
+				Memory:             "128Mi",
+				MemoryLimit:        "512Mi",
+				MemoryLimitPercent: pointer.Int64(25),
+			},
+			{
+				Name:   "tools",
+				CPU:    "2m",
+				Memory: "10Mi",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getResourceUsage() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantLine := "Resource usage at 10:30:00 - runtime: CPU 480m/500m (96%), memory 128Mi/512Mi (25%); tools: CPU 2m, memory 10Mi"
+	if line := formatResourceUsage(got); line != wantLine {
+		t.Errorf("formatResourceUsage() = %q, want %q", line, wantLine)
+	}
+}
+
+func TestResourceUsageWarnings_Display(t *testing.T) {
+	usage := func(cpuPercent int64) api.ResourceUsage {
+		return api.ResourceUsage{Containers: []api.ContainerResourceUsage{{
+			Name:            "runtime",
+			CPU:             "480m",
+			CPULimit:        "500m",
+			CPULimitPercent: pointer.Int64(cpuPercent),
+			Memory:          "128Mi",
+		}}}
+	}
+	const warning = `Container "runtime" is using`
+	out := &bytes.Buffer{}
+	warnings := NewResourceUsageWarnings()
+
+	warnings.Display(out, usage(96))
+	warnings.Display(out, usage(95))
+	if got := strings.Count(out.String(), warning); got != 1 {
+		t.Errorf("expected the warning to be displayed once, got %d times in %q", got, out.String())
+	}
+
+	warnings.Display(out, usage(50))
+	warnings.Display(out, usage(92))
+	if got := strings.Count(out.String(), warning); got != 2 {
+		t.Errorf("expected the warning to be displayed again after the usage went down, got %d times in %q", got, out.String())
+	}
+}
@@ -0,0 +1,111 @@
+package watch
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/preference"
+)
+
+// pollingInterval is the interval between two scans of the sources by the polling watcher
+const pollingInterval = 1 * time.Second
+
+var (
+	// errRecursiveNotSupported is returned when no native recursive watcher exists for the platform
+	errRecursiveNotSupported = errors.New("native recursive watching is not supported on this platform")
+	// errRecursiveNotBuiltIn is returned when the native recursive watcher of the platform is not part of the binary,
+	// e.g. FSEvents on macOS for binaries built without cgo
+	errRecursiveNotBuiltIn = errors.New("native recursive watching is not available in this build of odo")
+)
+
+// sourcesWatcher watches the sources of the component for changes
+type sourcesWatcher interface {
+	// Events returns the channel on which filesystem events are sent
+	Events() <-chan fsnotify.Event
+	// Errors returns the channel on which watching errors are sent
+	Errors() <-chan error
+	// Add starts watching path
+	Add(path string) error
+	// Remove stops watching path
+	Remove(path string) error
+	// Close stops watching all paths
+	Close() error
+	// Recursive returns true if the watcher reports changes on the whole tree of an added directory,
+	// meaning that sub-directories do not need to be added one by one
+	Recursive() bool
+}
+
+// newSourcesWatcher returns a watcher for the sources depending on the watch mode:
+// the polling mode returns a watcher periodically scanning the sources under root,
+// the native mode returns a recursive watcher using the notifications of the platform
+// (FSEvents on macOS, ReadDirectoryChangesW on Windows) when available, and a watcher
+// based on fsnotify otherwise.
+func newSourcesWatcher(mode string, fileIgnores []string) (sourcesWatcher, error) {
+	if mode == preference.WatchModePolling {
+		klog.V(4).Infof("watching sources by polling every %s", pollingInterval)
+		return newPollingWatcher(pollingInterval, fileIgnores), nil
+	}
+
+	watcher, err := newRecursiveWatcher()
+	if err == nil {
+		klog.V(4).Info("watching sources with native recursive watcher")
+		return watcher, nil
+	}
+	switch {
+	case errors.Is(err, errRecursiveNotSupported):
+		// expected on this platform, fsnotify is the native watcher
+	case errors.Is(err, errRecursiveNotBuiltIn):
+		log.Warningf("%v, falling back to watching each directory; on large projects, this can exhaust the system resources, "+
+			"consider running `odo preference set %s %s`", err, preference.WatchModeSetting, preference.WatchModePolling)
+	default:
+		klog.V(4).Infof("unable to create native recursive watcher, falling back to fsnotify: %v", err)
+	}
+
+	return newNotifyWatcher()
+}
+
+// notifyWatcher is a sourcesWatcher based on fsnotify, which needs a watch for each directory
+type notifyWatcher struct {
+	watcher *fsnotify.Watcher
+}
+
+var _ sourcesWatcher = (*notifyWatcher)(nil)
+
+func newNotifyWatcher() (*notifyWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("error setting up filesystem watcher: %v", err)
+	}
+	return &notifyWatcher{
+		watcher: watcher,
+	}, nil
+}
+
+func (o *notifyWatcher) Events() <-chan fsnotify.Event {
+	return o.watcher.Events
+}
+
+func (o *notifyWatcher) Errors() <-chan error {
+	return o.watcher.Errors
+}
+
+func (o *notifyWatcher) Add(path string) error {
+	return o.watcher.Add(path)
+}
+
+func (o *notifyWatcher) Remove(path string) error {
+	return o.watcher.Remove(path)
+}
+
+func (o *notifyWatcher) Close() error {
+	return o.watcher.Close()
+}
+
+func (o *notifyWatcher) Recursive() bool {
+	return false
+}
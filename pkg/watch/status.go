@@ -16,6 +16,8 @@ const (
 	//StateBuildCommandExecuted State = "BuildCommandExecuted"
 	//StateRunCommandRunning    State = "RunCommandRunning"
 	StateReady State = "Ready"
+	// StateSuspended indicates that the component has been scaled down after being idle, until the next change
+	StateSuspended State = "Suspended"
 )
 
 type ComponentStatus struct {
@@ -8,16 +8,22 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/devfile/profile"
 
+	"github.com/redhat-developer/odo/pkg/i18n"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 
 	"github.com/fsnotify/fsnotify"
 	gitignore "github.com/sabhiram/go-gitignore"
@@ -26,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog"
 )
@@ -33,12 +40,23 @@ import (
 const (
 	// PushErrorString is the string that is printed when an error occurs during watch's Push operation
 	PushErrorString = "Error occurred on Push"
+
+	// profilePromptMessage is added to the prompt message when profiles are defined in the Devfile
+	profilePromptMessage = `     [s] - Switch to the next profile defined in the Devfile
+`
+
+	// rollbackPromptMessage is added to the prompt message when the component can be rolled back
+	rollbackPromptMessage = `     [r] - Roll back the component to its last working state
+`
 )
 
 type WatchClient struct {
-	kubeClient kclient.ClientInterface
+	kubeClient       kclient.ClientInterface
+	stateClient      state.Client
+	preferenceClient preference.Client
+	fs               filesystem.Filesystem
 
-	sourcesWatcher    *fsnotify.Watcher
+	sourcesWatcher    sourcesWatcher
 	deploymentWatcher watch.Interface
 	devfileWatcher    *fsnotify.Watcher
 	podWatcher        watch.Interface
@@ -55,9 +73,12 @@ type WatchClient struct {
 
 var _ Client = (*WatchClient)(nil)
 
-func NewWatchClient(kubeClient kclient.ClientInterface) *WatchClient {
+func NewWatchClient(kubeClient kclient.ClientInterface, stateClient state.Client, preferenceClient preference.Client, fs filesystem.Filesystem) *WatchClient {
 	return &WatchClient{
-		kubeClient: kubeClient,
+		kubeClient:       kubeClient,
+		stateClient:      stateClient,
+		preferenceClient: preferenceClient,
+		fs:               fs,
 	}
 }
 
@@ -78,12 +99,20 @@ type WatchParameters struct {
 	WatchCluster bool
 	// PromptMessage
 	PromptMessage string
+
+	// SuspendHandler is called to scale down the component, when StartOptions.IdleTimeout is set and the session is idle
+	SuspendHandler func(context.Context) error
+	// LastConnectionTime returns the time of the last connection on a forwarded port, to consider the session as active
+	LastConnectionTime func() time.Time
+	// RollbackHandler prepares the next push to roll the component back to its last working state.
+	// It returns false if no working state different from the current one has been recorded.
+	RollbackHandler func() bool
 }
 
 // evaluateChangesFunc evaluates any file changes for the events by ignoring the files in fileIgnores slice and removes
 // any deleted paths from the watcher. It returns a slice of changed files (if any) and paths that are deleted (if any)
 // by the events
-type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) (changedFiles, deletedPaths []string)
+type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher sourcesWatcher) (changedFiles, deletedPaths []string)
 
 // processEventsFunc processes the events received on the watcher. It uses the WatchParameters to trigger watch handler and writes to out
 // It returns a Duration after which to recall in case of error
@@ -102,12 +131,12 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 
 	var err error
 	if parameters.StartOptions.WatchFiles {
-		o.sourcesWatcher, err = getFullSourcesWatcher(path, parameters.StartOptions.IgnorePaths)
+		o.sourcesWatcher, err = getFullSourcesWatcher(path, parameters.StartOptions.IgnorePaths, o.preferenceClient.GetWatchMode())
 		if err != nil {
 			return err
 		}
 	} else {
-		o.sourcesWatcher, err = fsnotify.NewWatcher()
+		o.sourcesWatcher, err = newNotifyWatcher()
 		if err != nil {
 			return err
 		}
@@ -148,6 +177,22 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 			}
 		}
 	}
+	// The directory containing the active profile file is watched, to switch to the profile selected with odo switch
+	activeProfileDir := filepath.Dir(profile.GetActiveFilePath(path))
+	if err = o.devfileWatcher.Add(activeProfileDir); err != nil {
+		klog.V(4).Infof("error adding watcher for path %s: %v", activeProfileDir, err)
+	}
+	// The directory containing the preference file is watched, to use the preferences changed during the session
+	preferenceDir := filepath.Dir(o.preferenceClient.GetPreferenceFile())
+	if err = o.devfileWatcher.Add(preferenceDir); err != nil {
+		klog.V(4).Infof("error adding watcher for path %s: %v", preferenceDir, err)
+	}
+	if profiles, _ := profile.List(*devfileObj); len(profiles) > 0 {
+		parameters.PromptMessage += i18n.T(profilePromptMessage)
+	}
+	if parameters.RollbackHandler != nil {
+		parameters.PromptMessage += i18n.T(rollbackPromptMessage)
+	}
 
 	if parameters.WatchCluster {
 		var isForbidden bool
@@ -164,6 +209,8 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 
 	o.keyWatcher = getKeyWatcher(ctx, parameters.StartOptions.Out)
 
+	o.recordWatchHealth(ctx, parameters.StartOptions.WatchFiles, nil)
+
 	err = o.processEvents(ctx, parameters, nil, nil, &componentStatus)
 	if err != nil {
 		return err
@@ -206,22 +253,162 @@ func (o *WatchClient) eventWatcher(
 	devfileTimer := time.NewTimer(time.Millisecond)
 	<-devfileTimer.C
 
+	// profileTimer has the same usage as sourcesTimer, for events on the active profile file coming from devfileWatcher
+	profileTimer := time.NewTimer(time.Millisecond)
+	<-profileTimer.C
+
+	// preferenceTimer has the same usage as sourcesTimer, for events on the preference file coming from devfileWatcher
+	preferenceTimer := time.NewTimer(time.Millisecond)
+	<-preferenceTimer.C
+
 	// deployTimer has the same usage as sourcesTimer, for events coming from watching Deployments, from deploymentWatcher
 	deployTimer := time.NewTimer(time.Millisecond)
 	<-deployTimer.C
 
+	// readyTimer fires when the component is not ready after the PushTimeout, to collect diagnostics,
+	// once until the component becomes ready again
+	readyTimer := time.NewTimer(time.Millisecond)
+	<-readyTimer.C
+	readyTimerArmed := false
+	diagnosticsCollected := false
+	armReadyTimer := func() {
+		if !parameters.WatchCluster {
+			return
+		}
+		if state := componentStatus.GetState(); state == StateReady || state == StateSuspended {
+			if readyTimerArmed && !readyTimer.Stop() {
+				<-readyTimer.C
+			}
+			readyTimerArmed = false
+			diagnosticsCollected = false
+			return
+		}
+		if !readyTimerArmed && !diagnosticsCollected {
+			readyTimer.Reset(o.preferenceClient.GetPushTimeout())
+			readyTimerArmed = true
+		}
+	}
+	armReadyTimer()
+
+	// idleTimer fires after the IdleTimeout, to suspend the component if no file has changed
+	// and no connection has been accepted on the forwarded ports since then
+	idleTimeout := parameters.StartOptions.IdleTimeout
+	lastChange := time.Now()
+	var idleTick <-chan time.Time
+	var idleTimer *time.Timer
+	if parameters.WatchCluster && idleTimeout > 0 && parameters.SuspendHandler != nil {
+		idleTimer = time.NewTimer(idleTimeout)
+		defer idleTimer.Stop()
+		idleTick = idleTimer.C
+	}
+	// resume prepares a suspended component to be scaled up by the next call to processEventsHandler
+	resume := func() {
+		if componentStatus.GetState() != StateSuspended {
+			return
+		}
+		fmt.Fprintf(out, "%s\n\n", i18n.T("Resuming the component..."))
+		componentStatus.SetState(StateWaitDeployment)
+		// the post-start events are executed in the new pod
+		componentStatus.PostStartEventsDone = false
+		lastChange = time.Now()
+		idleTimer.Reset(idleTimeout)
+	}
+
+	// switchProfile updates the component with the profile selected with a key or with odo switch
+	activeProfileFile := profile.GetActiveFilePath(path)
+	preferenceFile := o.preferenceClient.GetPreferenceFile()
+	switchProfile := func(name string) error {
+		if name == "" || name == parameters.StartOptions.Profile {
+			return nil
+		}
+		parameters.StartOptions.Profile = name
+		resume()
+		fmt.Fprintf(out, "%s\n\n", fmt.Sprintf(i18n.T("Switching to profile %q..."), name))
+		err := processEventsHandler(ctx, parameters, nil, nil, &componentStatus)
+		if err != nil {
+			return err
+		}
+		armReadyTimer()
+		return nil
+	}
+
+	// rollback rolls the component back to its last working state, with the r key or when a pod keeps crashing with RollbackOnFailure
+	rollback := func() error {
+		if parameters.RollbackHandler == nil {
+			return nil
+		}
+		if !parameters.RollbackHandler() {
+			log.Fwarning(out, i18n.T("No previous working state has been recorded, the component cannot be rolled back"))
+			return nil
+		}
+		resume()
+		fmt.Fprintf(out, "%s\n\n", i18n.T("Rolling back the component to its last working state..."))
+		err := processEventsHandler(ctx, parameters, nil, nil, &componentStatus)
+		if err != nil {
+			return err
+		}
+		armReadyTimer()
+		fmt.Fprintf(out, "%s\n\n", i18n.T("The component has been rolled back, it will be updated on the next change of the Devfile"))
+		return nil
+	}
+	// crashLoopsHandled are the pods whose crash loop has already been handled
+	crashLoopsHandled := make(map[types.UID]struct{})
+
 	podsPhases := NewPodPhases()
+	podsProblems := NewPodProblems()
+
+	// resourceUsageTick fires periodically to display the resource usage of the running pod, if enabled by the ResourceUsageInterval preference
+	var (
+		resourceUsageTick     <-chan time.Time
+		resourceUsageTicker   *time.Ticker
+		resourceUsageInterval time.Duration
+	)
+	// updateResourceUsageTicker starts, stops or changes the interval of resourceUsageTick when the ResourceUsageInterval preference changes
+	updateResourceUsageTicker := func() {
+		if !parameters.WatchCluster {
+			return
+		}
+		interval := o.preferenceClient.GetResourceUsageInterval()
+		if interval == resourceUsageInterval {
+			return
+		}
+		resourceUsageInterval = interval
+		if resourceUsageTicker != nil {
+			resourceUsageTicker.Stop()
+			resourceUsageTicker = nil
+			resourceUsageTick = nil
+		}
+		if interval <= 0 {
+			return
+		}
+		if !o.isMetricsAPISupported() {
+			log.Fwarning(out, "The metrics API is not available on the cluster, the resource usage of the component won't be displayed")
+			return
+		}
+		resourceUsageTicker = time.NewTicker(interval)
+		resourceUsageTick = resourceUsageTicker.C
+	}
+	updateResourceUsageTicker()
+	defer func() {
+		if resourceUsageTicker != nil {
+			resourceUsageTicker.Stop()
+		}
+	}()
+	var runningPod *corev1.Pod
+	resourceUsageWarnings := NewResourceUsageWarnings()
 
 	for {
 		select {
-		case event := <-o.sourcesWatcher.Events:
+		case event := <-o.sourcesWatcher.Events():
 			events = append(events, event)
+			lastChange = time.Now()
 			// We are waiting for more events in this interval
 			sourcesTimer.Reset(100 * time.Millisecond)
 
 		case <-sourcesTimer.C:
 			// timer has fired
-			if !componentCanSyncFile(componentStatus.GetState()) {
+			suspended := componentStatus.GetState() == StateSuspended
+			if !suspended && !componentCanSyncFile(componentStatus.GetState()) {
 				klog.V(4).Infof("State of component is %q, don't sync sources", componentStatus.GetState())
 				continue
 			}
@@ -236,25 +423,51 @@ func (o *WatchClient) eventWatcher(
 				}
 			}
 
-			componentStatus.SetState(StateSyncOutdated)
-			fmt.Fprintf(out, "Pushing files...\n\n")
+			if suspended {
+				// all the files are synchronized into the new pod
+				resume()
+				changedFiles, deletedPaths = nil, nil
+			} else {
+				componentStatus.SetState(StateSyncOutdated)
+				fmt.Fprintf(out, "%s\n\n", i18n.T("Pushing files..."))
+			}
 			err := processEventsHandler(ctx, parameters, changedFiles, deletedPaths, &componentStatus)
 			o.forceSync = false
 			if err != nil {
 				return err
 			}
+			armReadyTimer()
 			// empty the events to receive new events
 			if componentStatus.GetState() == StateReady {
 				events = []fsnotify.Event{} // empty the events slice to capture new events
 			}
 
-		case watchErr := <-o.sourcesWatcher.Errors:
+		case watchErr := <-o.sourcesWatcher.Errors():
+			o.recordWatchHealth(ctx, false, watchErr)
 			return watchErr
 
 		case key := <-o.keyWatcher:
-			if key == 'p' {
+			lastChange = time.Now()
+			switch key {
+			case 'p':
 				o.forceSync = true
 				sourcesTimer.Reset(100 * time.Millisecond)
+			case 's':
+				next, err := profile.Next(*odocontext.GetEffectiveDevfileObj(ctx), parameters.StartOptions.Profile)
+				if err != nil {
+					log.Fwarning(out, err.Error())
+					continue
+				}
+				if err = profile.SetActive(o.fs, path, next); err != nil {
+					klog.V(4).Infof("unable to save the active profile: %v", err)
+				}
+				if err = switchProfile(next); err != nil {
+					return err
+				}
+			case 'r':
+				if err := rollback(); err != nil {
+					return err
+				}
 			}
 
 		case ev := <-o.deploymentWatcher.ResultChan():
@@ -273,20 +486,79 @@ func (o *WatchClient) eventWatcher(
 			}
 
 		case <-deployTimer.C:
+			if componentStatus.GetState() == StateSuspended {
+				// the Deployment has been scaled down, it will be scaled up on the next change
+				continue
+			}
 			err := processEventsHandler(ctx, parameters, nil, nil, &componentStatus)
 			if err != nil {
 				return err
 			}
+			armReadyTimer()
+
+		case <-readyTimer.C:
+			readyTimerArmed = false
+			if componentStatus.GetState() == StateReady {
+				continue
+			}
+			diagnosticsCollected = true
+			selector := labels.GetSelector(componentName, appName, labels.ComponentDevMode, true)
+			dir, err := o.collectDiagnostics(path, selector, time.Now())
+			if err != nil {
+				log.Fwarning(out, fmt.Sprintf("The component is not ready after %s, and diagnostics could not be collected: %v",
+					o.preferenceClient.GetPushTimeout(), err))
+				continue
+			}
+			log.Fwarning(out, fmt.Sprintf("The component is not ready after %s. The description, events and logs of its pods have been collected in %s",
+				o.preferenceClient.GetPushTimeout(), dir))
 
-		case <-o.devfileWatcher.Events:
+		case ev := <-o.devfileWatcher.Events:
+			if ev.Name == activeProfileFile {
+				profileTimer.Reset(100 * time.Millisecond)
+				continue
+			}
+			if ev.Name == preferenceFile {
+				preferenceTimer.Reset(100 * time.Millisecond)
+				continue
+			}
+			if dir := filepath.Dir(ev.Name); dir == filepath.Dir(preferenceFile) && dir != path {
+				// other files of the directory of the preference file, as the lock file
+				continue
+			}
+			if filepath.Dir(ev.Name) == filepath.Dir(activeProfileFile) {
+				// other files of the .odo directory
+				continue
+			}
+			lastChange = time.Now()
 			devfileTimer.Reset(100 * time.Millisecond)
 
+		case <-profileTimer.C:
+			name, err := profile.GetActive(o.fs, path)
+			if err != nil {
+				klog.V(4).Infof("unable to read the active profile: %v", err)
+				continue
+			}
+			if err = switchProfile(name); err != nil {
+				return err
+			}
+
+		case <-preferenceTimer.C:
+			err := o.preferenceClient.Reload()
+			if err != nil {
+				log.Fwarning(out, fmt.Sprintf(i18n.T("Unable to reload the preferences: %v"), err))
+				continue
+			}
+			updateResourceUsageTicker()
+			fmt.Fprintf(out, "%s\n\n", i18n.T("Preferences have been reloaded, they will be used on the next update of the component"))
+
 		case <-devfileTimer.C:
-			fmt.Fprintf(out, "Updating Component...\n\n")
+			resume()
+			fmt.Fprintf(out, "%s\n\n", i18n.T("Updating Component..."))
 			err := processEventsHandler(ctx, parameters, nil, nil, &componentStatus)
 			if err != nil {
 				return err
 			}
+			armReadyTimer()
 
 		case ev := <-o.podWatcher.ResultChan():
 			switch ev.Type {
@@ -296,17 +568,72 @@ func (o *WatchClient) eventWatcher(
 					return errors.New("unable to decode watch event")
 				}
 				podsPhases.Delete(out, pod)
+				podsProblems.Delete(pod)
+				delete(crashLoopsHandled, pod.GetUID())
+				if runningPod != nil && runningPod.GetUID() == pod.GetUID() {
+					runningPod = nil
+				}
 			case watch.Added, watch.Modified:
 				pod, ok := ev.Object.(*corev1.Pod)
 				if !ok {
 					return errors.New("unable to decode watch event")
 				}
 				podsPhases.Add(out, pod.GetCreationTimestamp(), pod)
+				podsProblems.Add(out, pod)
+				if _, handled := crashLoopsHandled[pod.GetUID()]; !handled && parameters.RollbackHandler != nil && isCrashLooping(pod) {
+					crashLoopsHandled[pod.GetUID()] = struct{}{}
+					if parameters.StartOptions.RollbackOnFailure {
+						if err := rollback(); err != nil {
+							return err
+						}
+					} else {
+						fmt.Fprintf(out, "%s\n\n", i18n.T("Press 'r' to roll the component back to its last working state"))
+					}
+				}
+				if pod.Status.Phase == corev1.PodRunning && pod.GetDeletionTimestamp() == nil {
+					runningPod = pod
+				} else if runningPod != nil && runningPod.GetUID() == pod.GetUID() {
+					runningPod = nil
+				}
+			}
+
+		case <-idleTick:
+			if componentStatus.GetState() == StateSuspended {
+				continue
+			}
+			lastActivity := lastChange
+			if parameters.LastConnectionTime != nil {
+				if t := parameters.LastConnectionTime(); t.After(lastActivity) {
+					lastActivity = t
+				}
+			}
+			if idle := time.Since(lastActivity); idle < idleTimeout {
+				idleTimer.Reset(idleTimeout - idle)
+				continue
+			}
+			fmt.Fprintf(out, "%s\n\n", fmt.Sprintf(i18n.T("No activity for %s, suspending the component..."), idleTimeout))
+			err := parameters.SuspendHandler(ctx)
+			if err != nil {
+				log.Fwarning(out, fmt.Sprintf("Unable to suspend the component: %v", err))
+				idleTimer.Reset(idleTimeout)
+				continue
+			}
+			componentStatus.SetState(StateSuspended)
+			armReadyTimer()
+			fmt.Fprintf(out, "%s\n\n", i18n.T("The component is suspended, it will resume on the next change"))
+
+		case <-resourceUsageTick:
+			if runningPod != nil {
+				o.displayResourceUsage(ctx, out, runningPod, resourceUsageWarnings)
 			}
 
 		case ev := <-o.warningsWatcher.ResultChan():
 			switch kevent := ev.Object.(type) {
 			case *corev1.Event:
+				if isReportedFromPodStatus(kevent) {
+					klog.V(4).Infof("warning event already reported from pod status: %s", kevent.Message)
+					continue
+				}
 				podName := kevent.InvolvedObject.Name
 				selector := labels.GetSelector(componentName, appName, labels.ComponentDevMode, true)
 				matching, err := o.kubeClient.IsPodNameMatchingSelector(ctx, podName, selector)
@@ -319,6 +646,7 @@ func (o *WatchClient) eventWatcher(
 			}
 
 		case watchErr := <-o.devfileWatcher.Errors:
+			o.recordWatchHealth(ctx, false, watchErr)
 			return watchErr
 
 		case <-ctx.Done():
@@ -329,13 +657,13 @@ func (o *WatchClient) eventWatcher(
 
 // evaluateFileChanges evaluates any file changes for the events. It ignores the files in fileIgnores slice related to path, and removes
 // any deleted paths from the watcher
-func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher sourcesWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
 	ignoreMatcher := gitignore.CompileIgnoreLines(fileIgnores...)
 
-	for _, event := range events {
+	for _, event := range coalesceEvents(events) {
 		klog.V(4).Infof("filesystem watch event: %s", event)
 		isIgnoreEvent := shouldIgnoreEvent(event)
 
@@ -380,7 +708,7 @@ func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []str
 			if !alreadyInChangedFiles && !matched && event.Name != "" {
 				deletedPaths = append(deletedPaths, event.Name)
 			}
-		} else {
+		} else if !watcher.Recursive() {
 			// On other ops, recursively watch the resource (if applicable)
 			if e := addRecursiveWatch(watcher, path, event.Name, fileIgnores); e != nil && watchError == nil {
 				klog.V(4).Infof("Error occurred in addRecursiveWatch, setting watchError to %v", e)
@@ -388,7 +716,7 @@ func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []str
 			}
 		}
 	}
-	deletedPaths = removeDuplicates(deletedPaths)
+	deletedPaths = removeDeletedDescendants(removeDuplicates(deletedPaths))
 
 	return changedFiles, deletedPaths
 }
@@ -406,7 +734,7 @@ func (o *WatchClient) processEvents(
 	)
 
 	for _, file := range removeDuplicates(append(changedFiles, deletedPaths...)) {
-		fmt.Fprintf(out, "\nFile %s changed\n", file)
+		fmt.Fprintf(out, "\n%s\n", fmt.Sprintf(i18n.T("File %s changed"), file))
 	}
 
 	var hasFirstSuccessfulPushOccurred bool
@@ -421,6 +749,7 @@ func (o *WatchClient) processEvents(
 	}
 	oldStatus := *componentStatus
 	err := parameters.DevfileWatchHandler(ctx, pushParams, componentStatus)
+	o.recordPush(ctx, len(changedFiles), len(deletedPaths), err)
 	if err != nil {
 		if isFatal(err) {
 			return err
@@ -451,6 +780,91 @@ func (o *WatchClient) processEvents(
 	return nil
 }
 
+// recordPush records the push in the state, for `odo status`. Errors are not fatal.
+func (o *WatchClient) recordPush(ctx context.Context, syncedFiles, deletedFiles int, pushErr error) {
+	if o.stateClient == nil {
+		return
+	}
+	if err := o.stateClient.RecordPush(ctx, syncedFiles, deletedFiles, pushErr); err != nil {
+		klog.V(4).Infof("unable to record push in state: %v", err)
+	}
+}
+
+// recordWatchHealth records the health of the watcher in the state, for `odo status`. Errors are not fatal.
+func (o *WatchClient) recordWatchHealth(ctx context.Context, watching bool, watchErr error) {
+	if o.stateClient == nil {
+		return
+	}
+	if err := o.stateClient.SetWatchHealth(ctx, watching, watchErr); err != nil {
+		klog.V(4).Infof("unable to record watch health in state: %v", err)
+	}
+}
+
+// coalesceEvents merges the events received for a same path into a single event reflecting the final state of the path,
+// in the order the paths have been first reported.
+//
+// Some editors (vim, IntelliJ, ...) save a file by writing a temporary file, and renaming it over the original file,
+// or by renaming the original file to a backup file before writing the new content. This generates
+// CREATE, WRITE, RENAME and REMOVE events on the file and on temporary files, which would result in the file being
+// both synced and deleted, or in temporary files being synced:
+//   - a path renamed or removed, then created again, is reported as created, so its final content is synced once;
+//   - a path created, then renamed or removed, is a temporary file, and is not reported;
+//   - a path existing before and finally renamed or removed is reported as removed.
+func coalesceEvents(events []fsnotify.Event) []fsnotify.Event {
+	type pathEvents struct {
+		// created is true if the first event for the path is a creation, meaning the path did not exist before
+		created bool
+		// removed is true if the path has been renamed or removed at least once
+		removed bool
+		// op is the union of the operations received since the path has last been renamed or removed
+		op fsnotify.Op
+		// gone is true if the last event for the path is a rename or a removal
+		gone bool
+	}
+
+	var paths []string
+	byPath := map[string]*pathEvents{}
+	for _, event := range events {
+		if event.Name == "" {
+			// fsnotify can send a RENAME event without name when a file is deleted, followed by a REMOVE event with the name
+			continue
+		}
+		pe, found := byPath[event.Name]
+		if !found {
+			pe = &pathEvents{
+				created: event.Op&fsnotify.Create == fsnotify.Create,
+			}
+			byPath[event.Name] = pe
+			paths = append(paths, event.Name)
+		}
+		if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+			pe.removed = true
+			pe.gone = true
+			pe.op = 0
+			continue
+		}
+		pe.gone = false
+		pe.op |= event.Op
+	}
+
+	result := make([]fsnotify.Event, 0, len(paths))
+	for _, path := range paths {
+		pe := byPath[path]
+		switch {
+		case pe.gone && pe.created:
+			klog.V(4).Infof("ignoring temporary file %s", path)
+		case pe.gone:
+			result = append(result, fsnotify.Event{Name: path, Op: fsnotify.Remove})
+		case pe.removed:
+			// the path has been replaced, consider it as a new file
+			result = append(result, fsnotify.Event{Name: path, Op: fsnotify.Create})
+		default:
+			result = append(result, fsnotify.Event{Name: path, Op: pe.op})
+		}
+	}
+	return result
+}
+
 func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	if !(event.Op&fsnotify.Remove == fsnotify.Remove || event.Op&fsnotify.Rename == fsnotify.Rename) {
 		stat, err := os.Lstat(event.Name)
@@ -479,6 +893,34 @@ func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	return ignoreEvent
 }
 
+// removeDeletedDescendants removes from deletedPaths the paths contained in another deleted path, and returns the result sorted.
+// When a directory tree is deleted, an event is received for each file and directory of the tree: only the top-most directory
+// needs to be deleted in the container.
+func removeDeletedDescendants(deletedPaths []string) []string {
+	deleted := make(map[string]struct{}, len(deletedPaths))
+	for _, p := range deletedPaths {
+		deleted[p] = struct{}{}
+	}
+
+	result := make([]string, 0, len(deletedPaths))
+	for _, p := range deletedPaths {
+		if !hasDeletedAncestor(p, deleted) {
+			result = append(result, p)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func hasDeletedAncestor(path string, deleted map[string]struct{}) bool {
+	for parent := filepath.Dir(path); parent != path; path, parent = parent, filepath.Dir(parent) {
+		if _, found := deleted[parent]; found {
+			return true
+		}
+	}
+	return false
+}
+
 func removeDuplicates(input []string) []string {
 	valueMap := map[string]string{}
 	for _, str := range input {
@@ -493,19 +935,19 @@ func removeDuplicates(input []string) []string {
 }
 
 func PrintInfoMessage(out io.Writer, path string, watchFiles bool, promptMessage string) {
-	log.Sectionf("Dev mode")
+	log.Sectionf(i18n.T("Dev mode"))
 	if watchFiles {
 		fmt.Fprintf(
 			out,
-			" %s\n Watching for changes in the current directory %s\n\n",
-			log.Sbold("Status:"),
-			path,
+			" %s\n %s\n\n",
+			log.Sbold(i18n.T("Status:")),
+			fmt.Sprintf(i18n.T("Watching for changes in the current directory %s"), path),
 		)
 	}
 	fmt.Fprintf(
 		out,
 		" %s%s",
-		log.Sbold("Keyboard Commands:"),
+		log.Sbold(i18n.T("Keyboard Commands:")),
 		promptMessage,
 	)
 }
@@ -3,19 +3,28 @@ package watch
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/dev"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/preference"
 )
 
-func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher sourcesWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
@@ -115,6 +124,10 @@ func Test_eventWatcher(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetPreferenceFile().Return("/home/user/.odo/preference.yaml").AnyTimes()
+
 			watcher, _ := fsnotify.NewWatcher()
 			fileWatcher, _ := fsnotify.NewWatcher()
 			var cancel context.CancelFunc
@@ -140,7 +153,8 @@ func Test_eventWatcher(t *testing.T) {
 			componentStatus.SetState(StateReady)
 
 			o := WatchClient{
-				sourcesWatcher:    watcher,
+				preferenceClient:  prefClient,
+				sourcesWatcher:    &notifyWatcher{watcher: watcher},
 				deploymentWatcher: fakeWatcher{},
 				podWatcher:        fakeWatcher{},
 				warningsWatcher:   fakeWatcher{},
@@ -161,3 +175,444 @@ func Test_eventWatcher(t *testing.T) {
 		})
 	}
 }
+
+func Test_eventWatcher_idleTimeout(t *testing.T) {
+	tests := []struct {
+		name               string
+		lastConnectionTime func() time.Time
+		watcherEvents      []fsnotify.Event
+		wantOut            string
+		wantSuspended      int
+	}{
+		{
+			name:               "suspends after the idle timeout and resumes on the next change",
+			lastConnectionTime: func() time.Time { return time.Time{} },
+			watcherEvents:      []fsnotify.Event{{Name: "file1", Op: fsnotify.Write}},
+			wantOut: "No activity for 200ms, suspending the component...\n\n" +
+				"The component is suspended, it will resume on the next change\n\n" +
+				"Resuming the component...\n\n" +
+				"changedFiles [] deletedPaths []\n",
+			wantSuspended: 1,
+		},
+		{
+			name:               "does not suspend while connections are accepted on the forwarded ports",
+			lastConnectionTime: time.Now,
+			wantOut:            "",
+			wantSuspended:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetResourceUsageInterval().Return(time.Duration(0)).AnyTimes()
+			prefClient.EXPECT().GetPushTimeout().Return(time.Minute).AnyTimes()
+			prefClient.EXPECT().GetPreferenceFile().Return("/home/user/.odo/preference.yaml").AnyTimes()
+
+			watcher, _ := fsnotify.NewWatcher()
+			fileWatcher, _ := fsnotify.NewWatcher()
+			ctx, cancel := context.WithCancel(context.Background())
+			ctx = odocontext.WithDevfilePath(ctx, "/path/to/devfile")
+			ctx = odocontext.WithApplication(ctx, "odo")
+			ctx = odocontext.WithComponentName(ctx, "my-component")
+			out := &bytes.Buffer{}
+
+			go func() {
+				<-time.After(350 * time.Millisecond)
+				for _, event := range tt.watcherEvents {
+					watcher.Events <- event
+				}
+				<-time.After(200 * time.Millisecond)
+				cancel()
+			}()
+
+			componentStatus := ComponentStatus{}
+			componentStatus.SetState(StateReady)
+
+			o := WatchClient{
+				preferenceClient:  prefClient,
+				sourcesWatcher:    &notifyWatcher{watcher: watcher},
+				deploymentWatcher: fakeWatcher{},
+				podWatcher:        fakeWatcher{},
+				warningsWatcher:   fakeWatcher{},
+				devfileWatcher:    fileWatcher,
+				keyWatcher:        make(chan byte),
+			}
+			suspended := 0
+			parameters := WatchParameters{
+				StartOptions: dev.StartOptions{
+					IdleTimeout: 200 * time.Millisecond,
+					Out:         out,
+				},
+				WatchCluster: true,
+				SuspendHandler: func(context.Context) error {
+					suspended++
+					return nil
+				},
+				LastConnectionTime: tt.lastConnectionTime,
+			}
+
+			_ = o.eventWatcher(ctx, parameters, evaluateChangesHandler, processEventsHandler, componentStatus)
+
+			if suspended != tt.wantSuspended {
+				t.Errorf("eventWatcher() suspended %d times, want %d", suspended, tt.wantSuspended)
+			}
+			if gotOut := out.String(); gotOut != tt.wantOut {
+				t.Errorf("eventWatcher() gotOut = %q, want %q", gotOut, tt.wantOut)
+			}
+		})
+	}
+}
+
+func Test_eventWatcher_rollback(t *testing.T) {
+	crashLoopingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-component-abcde", UID: "uid1"},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "runtime",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name              string
+		rollbackOnFailure bool
+		canRollback       bool
+		keys              []byte
+		wantRollbacks     int
+		wantOut           []string
+		dontWantOut       []string
+	}{
+		{
+			name:              "rolls back automatically when the pod keeps crashing",
+			rollbackOnFailure: true,
+			canRollback:       true,
+			wantRollbacks:     1,
+			wantOut: []string{
+				"Rolling back the component to its last working state...",
+				"changedFiles [] deletedPaths []",
+				"The component has been rolled back, it will be updated on the next change of the Devfile",
+			},
+			dontWantOut: []string{"Press 'r'"},
+		},
+		{
+			name:          "displays a hint when the pod keeps crashing",
+			canRollback:   true,
+			wantRollbacks: 0,
+			wantOut:       []string{"Press 'r' to roll the component back to its last working state"},
+			dontWantOut:   []string{"Rolling back"},
+		},
+		{
+			name:          "rolls back with the r key",
+			canRollback:   true,
+			keys:          []byte{'r'},
+			wantRollbacks: 1,
+			wantOut: []string{
+				"Press 'r' to roll the component back to its last working state",
+				"Rolling back the component to its last working state...",
+			},
+		},
+		{
+			name:              "no working state recorded",
+			rollbackOnFailure: true,
+			canRollback:       false,
+			wantRollbacks:     1,
+			wantOut:           []string{"No previous working state has been recorded, the component cannot be rolled back"},
+			dontWantOut:       []string{"Rolling back"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetResourceUsageInterval().Return(time.Duration(0)).AnyTimes()
+			prefClient.EXPECT().GetPushTimeout().Return(time.Minute).AnyTimes()
+			prefClient.EXPECT().GetPreferenceFile().Return("/home/user/.odo/preference.yaml").AnyTimes()
+
+			watcher, _ := fsnotify.NewWatcher()
+			fileWatcher, _ := fsnotify.NewWatcher()
+			podWatcher := watch.NewFake()
+			keyWatcher := make(chan byte)
+			ctx, cancel := context.WithCancel(context.Background())
+			ctx = odocontext.WithDevfilePath(ctx, "/path/to/devfile")
+			ctx = odocontext.WithApplication(ctx, "odo")
+			ctx = odocontext.WithComponentName(ctx, "my-component")
+			out := &bytes.Buffer{}
+
+			go func() {
+				// the crash loop is handled once per pod
+				podWatcher.Modify(crashLoopingPod)
+				podWatcher.Modify(crashLoopingPod)
+				for _, key := range tt.keys {
+					keyWatcher <- key
+				}
+				<-time.After(100 * time.Millisecond)
+				cancel()
+			}()
+
+			componentStatus := ComponentStatus{}
+			componentStatus.SetState(StateWaitDeployment)
+
+			o := WatchClient{
+				preferenceClient:  prefClient,
+				sourcesWatcher:    &notifyWatcher{watcher: watcher},
+				deploymentWatcher: fakeWatcher{},
+				podWatcher:        podWatcher,
+				warningsWatcher:   fakeWatcher{},
+				devfileWatcher:    fileWatcher,
+				keyWatcher:        keyWatcher,
+			}
+			rollbacks := 0
+			parameters := WatchParameters{
+				StartOptions: dev.StartOptions{
+					RollbackOnFailure: tt.rollbackOnFailure,
+					Out:               out,
+				},
+				WatchCluster: true,
+				RollbackHandler: func() bool {
+					rollbacks++
+					return tt.canRollback
+				},
+			}
+
+			_ = o.eventWatcher(ctx, parameters, evaluateChangesHandler, processEventsHandler, componentStatus)
+
+			if rollbacks != tt.wantRollbacks {
+				t.Errorf("eventWatcher() rolled back %d times, want %d", rollbacks, tt.wantRollbacks)
+			}
+			gotOut := out.String()
+			for _, want := range tt.wantOut {
+				if !strings.Contains(gotOut, want) {
+					t.Errorf("eventWatcher() output %q should contain %q", gotOut, want)
+				}
+			}
+			for _, dontWant := range tt.dontWantOut {
+				if strings.Contains(gotOut, dontWant) {
+					t.Errorf("eventWatcher() output %q should not contain %q", gotOut, dontWant)
+				}
+			}
+		})
+	}
+}
+
+func Test_eventWatcher_reloadPreferences(t *testing.T) {
+	const preferenceFile = "/home/user/.odo/preference.yaml"
+	tests := []struct {
+		name        string
+		events      []fsnotify.Event
+		reloadErr   error
+		wantReloads int
+		wantOut     string
+	}{
+		{
+			name:        "preference file changed",
+			events:      []fsnotify.Event{{Name: preferenceFile, Op: fsnotify.Create}, {Name: preferenceFile, Op: fsnotify.Write}},
+			wantReloads: 1,
+			wantOut:     "Preferences have been reloaded, they will be used on the next update of the component\n\n",
+		},
+		{
+			name:    "lock file of the preference file changed",
+			events:  []fsnotify.Event{{Name: preferenceFile + ".lock", Op: fsnotify.Create}},
+			wantOut: "",
+		},
+		{
+			name:        "invalid preference file",
+			events:      []fsnotify.Event{{Name: preferenceFile, Op: fsnotify.Write}},
+			reloadErr:   errors.New("unable to unmarshal odo config file"),
+			wantReloads: 1,
+			wantOut:     "Unable to reload the preferences: unable to unmarshal odo config file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			reloads := 0
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetResourceUsageInterval().Return(time.Duration(0)).AnyTimes()
+			prefClient.EXPECT().GetPushTimeout().Return(time.Minute).AnyTimes()
+			prefClient.EXPECT().GetPreferenceFile().Return(preferenceFile).AnyTimes()
+			prefClient.EXPECT().Reload().DoAndReturn(func() error {
+				reloads++
+				return tt.reloadErr
+			}).AnyTimes()
+
+			watcher, _ := fsnotify.NewWatcher()
+			fileWatcher, _ := fsnotify.NewWatcher()
+			ctx, cancel := context.WithCancel(context.Background())
+			ctx = odocontext.WithDevfilePath(ctx, "/path/to/devfile")
+			ctx = odocontext.WithApplication(ctx, "odo")
+			ctx = odocontext.WithComponentName(ctx, "my-component")
+			out := &bytes.Buffer{}
+
+			go func() {
+				for _, event := range tt.events {
+					fileWatcher.Events <- event
+				}
+				<-time.After(300 * time.Millisecond)
+				cancel()
+			}()
+
+			componentStatus := ComponentStatus{}
+			componentStatus.SetState(StateReady)
+
+			o := WatchClient{
+				preferenceClient:  prefClient,
+				sourcesWatcher:    &notifyWatcher{watcher: watcher},
+				deploymentWatcher: fakeWatcher{},
+				podWatcher:        fakeWatcher{},
+				warningsWatcher:   fakeWatcher{},
+				devfileWatcher:    fileWatcher,
+				keyWatcher:        make(chan byte),
+			}
+			parameters := WatchParameters{
+				StartOptions: dev.StartOptions{
+					Out: out,
+				},
+			}
+
+			_ = o.eventWatcher(ctx, parameters, evaluateChangesHandler, processEventsHandler, componentStatus)
+
+			if reloads != tt.wantReloads {
+				t.Errorf("eventWatcher() reloaded the preferences %d times, want %d", reloads, tt.wantReloads)
+			}
+			if gotOut := out.String(); !strings.Contains(gotOut, tt.wantOut) || (tt.wantOut == "" && gotOut != "") {
+				t.Errorf("eventWatcher() gotOut = %q, want %q", gotOut, tt.wantOut)
+			}
+		})
+	}
+}
+
+func Test_coalesceEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []fsnotify.Event
+		want   []fsnotify.Event
+	}{
+		{
+			name: "simple write",
+			events: []fsnotify.Event{
+				{Name: "/src/main.go", Op: fsnotify.Write},
+				{Name: "/src/main.go", Op: fsnotify.Write},
+			},
+			want: []fsnotify.Event{
+				{Name: "/src/main.go", Op: fsnotify.Write},
+			},
+		},
+		{
+			name: "vim save, renaming the file to a backup file before writing it",
+			events: []fsnotify.Event{
+				{Name: "/src/4913", Op: fsnotify.Create},
+				{Name: "/src/4913", Op: fsnotify.Chmod},
+				{Name: "/src/4913", Op: fsnotify.Remove},
+				{Name: "/src/main.go", Op: fsnotify.Rename},
+				{Name: "/src/main.go~", Op: fsnotify.Create},
+				{Name: "/src/main.go", Op: fsnotify.Create},
+				{Name: "/src/main.go", Op: fsnotify.Write},
+				{Name: "/src/main.go", Op: fsnotify.Chmod},
+				{Name: "/src/main.go~", Op: fsnotify.Remove},
+			},
+			want: []fsnotify.Event{
+				{Name: "/src/main.go", Op: fsnotify.Create},
+			},
+		},
+		{
+			name: "IntelliJ save, renaming a temporary file over the file",
+			events: []fsnotify.Event{
+				{Name: "/src/main.go___jb_tmp___", Op: fsnotify.Create},
+				{Name: "/src/main.go___jb_tmp___", Op: fsnotify.Write},
+				{Name: "/src/main.go", Op: fsnotify.Rename},
+				{Name: "/src/main.go___jb_old___", Op: fsnotify.Create},
+				{Name: "/src/main.go___jb_tmp___", Op: fsnotify.Rename},
+				{Name: "/src/main.go", Op: fsnotify.Create},
+				{Name: "/src/main.go___jb_old___", Op: fsnotify.Remove},
+			},
+			want: []fsnotify.Event{
+				{Name: "/src/main.go", Op: fsnotify.Create},
+			},
+		},
+		{
+			name: "file moved",
+			events: []fsnotify.Event{
+				{Name: "/src/old.go", Op: fsnotify.Rename},
+				{Name: "", Op: fsnotify.Rename},
+				{Name: "/src/new.go", Op: fsnotify.Create},
+			},
+			want: []fsnotify.Event{
+				{Name: "/src/old.go", Op: fsnotify.Remove},
+				{Name: "/src/new.go", Op: fsnotify.Create},
+			},
+		},
+		{
+			name: "file written then deleted",
+			events: []fsnotify.Event{
+				{Name: "/src/main.go", Op: fsnotify.Write},
+				{Name: "/src/main.go", Op: fsnotify.Remove},
+			},
+			want: []fsnotify.Event{
+				{Name: "/src/main.go", Op: fsnotify.Remove},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := coalesceEvents(tt.events)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("coalesceEvents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_evaluateFileChanges_atomicRenameSave(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(file, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	watcher, err := newNotifyWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	events := []fsnotify.Event{
+		{Name: file, Op: fsnotify.Rename},
+		{Name: file + "~", Op: fsnotify.Create},
+		{Name: file, Op: fsnotify.Create},
+		{Name: file, Op: fsnotify.Write},
+		{Name: file + "~", Op: fsnotify.Remove},
+	}
+	changedFiles, deletedPaths := evaluateFileChanges(events, dir, nil, watcher)
+	if diff := cmp.Diff([]string{file}, changedFiles); diff != "" {
+		t.Errorf("changedFiles mismatch (-want +got):\n%s", diff)
+	}
+	if len(deletedPaths) != 0 {
+		t.Errorf("expected no deleted paths, got %v", deletedPaths)
+	}
+}
+
+func Test_removeDeletedDescendants(t *testing.T) {
+	src := filepath.Join(string(filepath.Separator), "src")
+	deletedPaths := []string{
+		filepath.Join(src, "dir", "sub", "file.go"),
+		filepath.Join(src, "dir-2"),
+		filepath.Join(src, "dir", "file.go"),
+		filepath.Join(src, "dir-2", "file.go"),
+		filepath.Join(src, "dir"),
+		filepath.Join(src, "dir", "sub"),
+		filepath.Join(src, "main.go"),
+	}
+	want := []string{
+		filepath.Join(src, "dir"),
+		filepath.Join(src, "dir-2"),
+		filepath.Join(src, "main.go"),
+	}
+	got := removeDeletedDescendants(deletedPaths)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("removeDeletedDescendants() mismatch (-want +got):\n%s", diff)
+	}
+}
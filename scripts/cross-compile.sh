@@ -8,10 +8,22 @@ if [[ -z "${*}" ]]; then
     exit 1
 fi
 
+# The native recursive file watcher on macOS (FSEvents) requires cgo, which is only available
+# when building the macOS binaries on a macOS host. Binaries built without cgo fall back
+# to watching each directory.
+DARWIN_CGO_ENABLED=0
+if [[ "$(uname -s)" == "Darwin" ]]; then
+  DARWIN_CGO_ENABLED=1
+else
+  echo "WARNING: not running on macOS, macOS binaries will be built without FSEvents support"
+fi
+
 for platform in linux-amd64 linux-arm64 linux-ppc64le linux-s390x darwin-amd64 darwin-arm64 windows-amd64 ; do
   echo "Cross compiling $platform and placing binary at dist/bin/$platform/"
   if [ $platform == "windows-amd64" ]; then
     GOARCH=amd64 GOOS=windows go build -o dist/bin/$platform/odo.exe "${@}" ./cmd/odo/
+  elif [[ $platform == darwin-* ]]; then
+    CGO_ENABLED=$DARWIN_CGO_ENABLED GOARCH=${platform#*-} GOOS=darwin go build -o dist/bin/$platform/odo "${@}" ./cmd/odo/
   else
     GOARCH=${platform#*-} GOOS=${platform%-*} go build -o dist/bin/$platform/odo "${@}" ./cmd/odo/
   fi
@@ -103,3 +103,11 @@ $mockgen -source=pkg/configAutomount/interface.go \
 $mockgen -source=pkg/platform/interface.go \
     -package platform \
     -destination pkg/platform/mock.go
+
+$mockgen -source=pkg/prompt/interface.go \
+    -package prompt \
+    -destination pkg/prompt/mock.go
+
+$mockgen -source=pkg/preflight/interface.go \
+    -package preflight \
+    -destination pkg/preflight/mock.go
@@ -214,6 +214,36 @@ var _ = Describe("odo describe component command tests", func() {
 			})
 		}
 
+		It("should display the resolved Devfile", Label(helper.LabelNoCluster), func() {
+			helper.CopyExampleDevFile(
+				filepath.Join("source", "devfiles", "nodejs", "devfile-variables.yaml"),
+				filepath.Join(commonVar.Context, "devfile.yaml"),
+				cmpName)
+
+			By("running with default output", func() {
+				res := helper.Cmd("odo", "describe", "component", "--devfile", "--var", "VALUE_TEST=baz").ShouldPass()
+				stdout := res.Out()
+				Expect(stdout).To(ContainSubstring("imageName: my-image-1:1.2.3-rc4"))
+				Expect(stdout).To(ContainSubstring("- name: FOO\n      value: baz"))
+				Expect(stdout).ToNot(ContainSubstring("{{"))
+			})
+
+			By("running with json output", func() {
+				res := helper.Cmd("odo", "describe", "component", "--devfile", "-o", "json").ShouldPass()
+				stdout, stderr := res.Out(), res.Err()
+				Expect(helper.IsJSON(stdout)).To(BeTrue())
+				Expect(stderr).To(BeEmpty())
+				helper.JsonPathContentIs(stdout, "metadata.name", cmpName)
+				helper.JsonPathContentIs(stdout, "components.2.container.env.0.name", "FOO")
+				helper.JsonPathContentIs(stdout, "components.2.container.env.0.value", "bar")
+			})
+
+			By("failing when used with --name", func() {
+				stderr := helper.Cmd("odo", "describe", "component", "--devfile", "--name", cmpName).ShouldFail().Err()
+				Expect(stderr).To(ContainSubstring("--devfile cannot be used with --name"))
+			})
+		})
+
 		It("should not describe the component from another directory, with default cluster mode", func() {
 			By("running with json output", func() {
 				err := os.Chdir("/")
@@ -4088,15 +4088,6 @@ CMD ["npm", "start"]
 				stderr := helper.Cmd("odo", args...).ShouldFail().Err()
 				Expect(stderr).Should(ContainSubstring("--ignore-localhost cannot be used when running in cluster mode"))
 			})
-
-			It("should error out if using --forward-localhost on any platform other than Podman", func() {
-				args := []string{"dev", "--forward-localhost", "--random-ports"}
-				if plt != "" {
-					args = append(args, "--platform", plt)
-				}
-				stderr := helper.Cmd("odo", args...).ShouldFail().Err()
-				Expect(stderr).Should(ContainSubstring("--forward-localhost cannot be used when running in cluster mode"))
-			})
 		}
 
 		When("running on default cluster platform", func() {
@@ -4144,6 +4135,40 @@ CMD ["npm", "start"]
 			})
 		})
 
+		When("running on default cluster platform with --forward-localhost", func() {
+			var devSession helper.DevSession
+
+			BeforeEach(func() {
+				var err error
+				devSession, _, _, _, err = helper.StartDevMode(helper.DevSessionOpts{
+					CmdlineArgs: []string{"--forward-localhost"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				devSession.Stop()
+				devSession.WaitEnd()
+			})
+
+			It("should reach both loopback and non-loopback ports on the IP of the pod", func() {
+				podName := commonVar.CliRunner.GetRunningPodNameByComponent(cmpName, commonVar.Project)
+				for port, body := range map[int]string{
+					// listening on 0.0.0.0, not relayed: the application must still be able to bind its port
+					3000: "Hello from Node.js Application!",
+					// listening on 127.0.0.1, relayed by the side container
+					3001: "Hello from Node.js Admin Application!",
+				} {
+					Eventually(func(g Gomega) {
+						out, _ := commonVar.CliRunner.Exec(podName, commonVar.Project, []string{
+							"-c", "runtime", "--", "sh", "-c", fmt.Sprintf("curl -s http://$(hostname -i):%d", port),
+						}, nil)
+						g.Expect(out).Should(ContainSubstring(body))
+					}).WithTimeout(60 * time.Second).WithPolling(3 * time.Second).Should(Succeed())
+				}
+			})
+		})
+
 		Context("running on Podman", Label(helper.LabelPodman), func() {
 
 			It("should error out if using both --ignore-localhost and --forward-localhost", func() {
@@ -395,6 +395,29 @@ CMD ["npm", "start"]
 				}
 			})
 		})
+
+		When("building images with the latest tag strategy", func() {
+			var stdout string
+
+			BeforeEach(func() {
+				stdout = helper.Cmd("odo", "build-images", "--tag-strategy", "latest").AddEnv("PODMAN_CMD=echo").ShouldPass().Out()
+			})
+
+			It("should replace the tags of all Image components", func() {
+				Expect(stdout).Should(ContainSubstring("Building Image: localhost:5000/odo-dev/node:latest"))
+				Expect(stdout).ShouldNot(ContainSubstring("Building Image: localhost:5000/odo-dev/node:autobuild"))
+			})
+		})
+
+		It("should fail with an invalid tag strategy", func() {
+			stderr := helper.Cmd("odo", "build-images", "--tag-strategy", "semver").ShouldFail().Err()
+			Expect(stderr).Should(ContainSubstring("invalid value \"semver\" for --tag-strategy"))
+		})
+
+		It("should fail when --digest-file is used without --push", func() {
+			stderr := helper.Cmd("odo", "build-images", "--digest-file", "digests.json").ShouldFail().Err()
+			Expect(stderr).Should(ContainSubstring("--digest-file can only be used with --push"))
+		})
 	})
 
 	When("using a Devfile with variable image names", func() {
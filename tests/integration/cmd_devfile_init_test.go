@@ -63,7 +63,7 @@ var _ = Describe("odo devfile init command tests", func() {
 					stdout, stderr := res.Out(), res.Err()
 					Expect(stdout).To(BeEmpty())
 					Expect(helper.IsJSON(stderr)).To(BeTrue())
-					helper.JsonPathContentContain(stderr, "message", "either --devfile or --devfile-path parameter should be specified")
+					helper.JsonPathContentContain(stderr, "message", "either --devfile, --devfile-path or --from-compose parameter should be specified")
 				})
 
 				By("keeping an empty directory when running odo init with wrong starter name", func() {
@@ -51,6 +51,7 @@ var _ = Describe("odo list with devfile", func() {
 				Expect(gjson.Get(output, "components.0.runningIn").String()).To(BeEmpty())
 				helper.JsonPathContentIs(output, "components.0.projectType", "Unknown")
 				helper.JsonPathContentIs(output, "components.0.managedBy", managedBy)
+				helper.JsonPathContentIs(output, "components.0.managerType", "other")
 			})
 		})
 		When("a non-odo managed component without the managed-by label is deployed", func() {
@@ -75,6 +76,7 @@ var _ = Describe("odo list with devfile", func() {
 				Expect(gjson.Get(output, "components.0.runningIn").String()).To(BeEmpty())
 				helper.JsonPathContentContain(output, "components.0.projectType", "Unknown")
 				helper.JsonPathContentContain(output, "components.0.managedBy", "")
+				helper.JsonPathContentIs(output, "components.0.managerType", "none")
 			})
 		})
 		When("an operator managed deployment(without instance and managed-by label) is deployed", func() {
@@ -186,6 +188,7 @@ var _ = Describe("odo list with devfile", func() {
 					By("checking the JSON output", func() {
 						stdout := helper.Cmd("odo", "list", "component", "-o", "json").ShouldPass().Out()
 						helper.JsonPathContentContain(stdout, "components.0.managedByVersion", version)
+						helper.JsonPathContentIs(stdout, "components.0.managerType", "odo-v3")
 					})
 				})
 			})
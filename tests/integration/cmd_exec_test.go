@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"path/filepath"
+
+	"github.com/onsi/gomega/gexec"
+
+	"github.com/redhat-developer/odo/tests/helper"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("odo exec command tests", func() {
+	var cmpName string
+	var commonVar helper.CommonVar
+
+	// This is run before every Spec (It)
+	var _ = BeforeEach(func() {
+		commonVar = helper.CommonBeforeEach()
+		cmpName = helper.RandString(6)
+		helper.Chdir(commonVar.Context)
+	})
+
+	// This is run after every Spec (It)
+	var _ = AfterEach(func() {
+		helper.CommonAfterEach(commonVar)
+	})
+
+	When("directory is empty", Label(helper.LabelNoCluster), func() {
+		It("should error", func() {
+			output := helper.Cmd("odo", "exec", "--", "ls").ShouldFail().Err()
+			Expect(output).To(ContainSubstring("The current directory does not represent an odo component"))
+		})
+	})
+
+	When("a component is bootstrapped", func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+			helper.Cmd("odo", "init", "--name", cmpName, "--devfile-path", helper.GetExamplePath("source", "devfiles", "nodejs", "devfile-for-run.yaml")).ShouldPass()
+		})
+
+		It("should fail if no command is passed", Label(helper.LabelNoCluster), func() {
+			output := helper.Cmd("odo", "exec").ShouldFail().Err()
+			Expect(output).To(ContainSubstring("requires at least 1 arg(s)"))
+		})
+
+		It("should fail if --tty is used without --stdin", Label(helper.LabelNoCluster), func() {
+			output := helper.Cmd("odo", "exec", "--tty", "--", "ls").ShouldFail().Err()
+			Expect(output).To(ContainSubstring("--tty can only be used with --stdin"))
+		})
+
+		It("should fail if odo dev is not running", func() {
+			output := helper.Cmd("odo", "exec", "--", "ls").ShouldFail().Err()
+			Expect(output).To(ContainSubstring(`unable to get pod for component`))
+			Expect(output).To(ContainSubstring(`Please check the command 'odo dev' is running`))
+		})
+
+		for _, podman := range []bool{false, true} {
+			podman := podman
+			When("odo dev is executed and ready", helper.LabelPodmanIf(podman, func() {
+
+				var devSession helper.DevSession
+				var platform string
+
+				BeforeEach(func() {
+					var err error
+					devSession, _, _, _, err = helper.StartDevMode(helper.DevSessionOpts{
+						RunOnPodman: podman,
+					})
+					Expect(err).ToNot(HaveOccurred())
+					platform = "cluster"
+					if podman {
+						platform = "podman"
+					}
+				})
+
+				AfterEach(func() {
+					devSession.Stop()
+					devSession.WaitEnd()
+				})
+
+				It("should fail if the container is not selected", func() {
+					output := helper.Cmd("odo", "exec", "--platform", platform, "--", "ls").ShouldFail().Err()
+					Expect(output).To(ContainSubstring("the component has several containers, please select one with --container"))
+				})
+
+				It("should execute the command and display its outputs separately", func() {
+					stdout, stderr := helper.Cmd("odo", "exec", "--platform", platform, "--container", "runtime", "--",
+						"sh", "-c", "echo to-stdout; echo to-stderr >&2").ShouldPass().OutAndErr()
+					Expect(stdout).To(ContainSubstring("to-stdout"))
+					Expect(stdout).ToNot(ContainSubstring("to-stderr"))
+					Expect(stderr).To(ContainSubstring("to-stderr"))
+				})
+
+				It("should exit with the exit code of the command", func() {
+					helper.Cmd("odo", "exec", "--platform", platform, "--container", "runtime", "--", "sh", "-c", "exit 3").Should(func(session *gexec.Session) {
+						Eventually(session).Should(gexec.Exit(3))
+					})
+				})
+			}))
+		}
+	})
+})
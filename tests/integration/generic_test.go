@@ -134,6 +134,20 @@ var _ = Describe("odo generic", func() {
 			reOdoVersion := `^odo\s*v[0-9]+.[0-9]+.[0-9]+(?:-\w+)?\s*\(\w+\)`
 			Expect(odoVersion).Should(MatchRegexp(reOdoVersion))
 		})
+
+		It("should report the connectivity to the cluster in JSON", func() {
+			stdout := helper.Cmd("odo", "version", "--check-connectivity", "-o", "json").ShouldPass().Out()
+			Expect(helper.IsJSON(stdout)).To(BeTrue())
+			helper.JsonPathContentIs(stdout, "cluster.reachable", "true")
+			helper.JsonPathContentIs(stdout, "cluster.serverURL", oc.GetCurrentServerURL())
+			helper.JsonPathExist(stdout, "podman.reachable")
+			helper.JsonPathExist(stdout, "registries.0.reachable")
+		})
+
+		It("should not accept --client with --check-connectivity", Label(helper.LabelNoCluster), func() {
+			stderr := helper.Cmd("odo", "version", "--client", "--check-connectivity").ShouldFail().Err()
+			Expect(stderr).To(ContainSubstring("--client and --check-connectivity cannot be used together"))
+		})
 	})
 
 	Describe("Experimental Mode", Label(helper.LabelNoCluster), func() {